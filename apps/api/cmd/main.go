@@ -3,12 +3,23 @@ package main
 import (
 	"context"
 	"fmt"
+	"gogym-api/internal/adapter/handler"
 	"gogym-api/internal/adapter/router"
+	"gogym-api/internal/adapter/validation"
 	"gogym-api/internal/configs"
 	"gogym-api/internal/di"
+	"gogym-api/internal/infra/captcha"
 	"gogym-api/internal/infra/db"
+	"gogym-api/internal/infra/discord"
+	"gogym-api/internal/infra/health"
+	"gogym-api/internal/infra/logging"
+	"gogym-api/internal/infra/metrics"
+	"gogym-api/internal/infra/notify"
 	"gogym-api/internal/infra/server"
 	"gogym-api/internal/infra/slack"
+	"gogym-api/internal/infra/tracing"
+	"gogym-api/internal/infra/webhook"
+	"gogym-api/internal/middleware"
 	"log/slog"
 	"net/http"
 	"os"
@@ -30,18 +41,42 @@ func init() {
 }
 
 func main() {
+	// gogym-api migrate <up|down N|status|force V>
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := runMigrate(os.Args[2:]); err != nil {
+			slog.Error("Migration failed", "error", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	config, err := configs.Load()
 	if err != nil {
 		slog.Error("Failed to load configuration", "error", err)
 		os.Exit(1)
 	}
 
-	e := server.NewEcho(config.HTTP)
+	// 本番は JSON で出力し、コンテキスト付きのログにはリクエストID・ユーザーID・ルートとトレースIDを付ける
+	// メールアドレス・IP アドレスの属性はマスクする
+	slog.SetDefault(slog.New(tracing.NewLogHandler(logging.NewHandler(os.Stderr, config.HTTP.Env))))
 
-	// renderヘルスチェック用エンドポイント
-	e.GET("/healthz", func(c echo.Context) error {
-		return c.NoContent(http.StatusOK)
-	})
+	shutdownTracing, err := tracing.Setup(context.Background(), config.Tracing, config.HTTP.Env)
+	if err != nil {
+		slog.Error("Failed to initialize tracing", "error", err)
+		os.Exit(1)
+	}
+
+	m := metrics.New()
+
+	e := server.NewEcho(config.HTTP, m)
+	// エラーレスポンスを problem+json に統一する
+	e.HTTPErrorHandler = handler.HTTPErrorHandler
+	// リクエストの DTO を validate タグの規則で検証する（メッセージはリクエストのロケール）
+	e.Validator = validation.New()
+
+	if config.Metrics.Enabled {
+		e.GET("/metrics", echo.WrapHandler(m.Handler()), middleware.MetricsTokenMiddleware(config.Metrics.Token))
+	}
 
 	database, err := db.NewDB(config.Database)
 	if err != nil {
@@ -49,14 +84,73 @@ func main() {
 		os.Exit(1)
 	}
 
+	sqlDB, err := database.DB()
+	if err != nil {
+		slog.Error("Failed to get database connection pool", "error", err)
+		os.Exit(1)
+	}
+
+	// 接続プールの統計を /metrics に公開する
+	if err := m.RegisterDB(sqlDB); err != nil {
+		slog.Error("Failed to register database metrics", "error", err)
+	}
+
+	// マイグレーション未適用のままリクエストを受けないようにする
+	if config.Database.CheckSchemaOnStartup {
+		if err := checkSchema(config.Database); err != nil {
+			slog.Error("Database schema check failed", "error", err)
+			os.Exit(1)
+		}
+	}
+
 	slackClient, err := slack.NewClient(config.Slack)
 	if err != nil {
 		slog.Error("Failed to initialize Slack client", "error", err)
 		os.Exit(1)
 	}
 
-	handlers := di.Initialize(database, slackClient, config.Auth.JWTSecret)
-	router.RegisterRoutes(e, handlers.Gym, handlers.User, handlers.Session, handlers.Workout, handlers.Contact, config.Auth.JWTSecret)
+	discordClient, err := discord.NewClient(config.Discord)
+	if err != nil {
+		slog.Error("Failed to initialize Discord client", "error", err)
+		os.Exit(1)
+	}
+
+	webhookClient, err := webhook.NewClient(config.Webhook)
+	if err != nil {
+		slog.Error("Failed to initialize webhook client", "error", err)
+		os.Exit(1)
+	}
+
+	// liveness はプロセスの応答のみ、readiness は DB・スキーマを確認し通知の設定状況も返す
+	// /healthz は既存の死活監視（Render・Dockerfile の HEALTHCHECK）向けの liveness
+	checker := health.NewChecker(sqlDB, func(ctx context.Context) (db.MigrationStatus, error) {
+		return db.SchemaStatus(ctx, sqlDB)
+	}, config.Health.CheckTimeout, slackClient, discordClient, webhookClient)
+	e.GET("/healthz", checker.Live)
+	e.GET("/livez", checker.Live)
+	e.GET("/readyz", checker.Ready)
+
+	// 通知は設定済みの送信先すべてに配信する（送信に失敗した通知は送信先ごとに数える）
+	notifier := notify.NewMulti(m.CountFailures(slackClient), m.CountFailures(discordClient), m.CountFailures(webhookClient))
+
+	captchaClient, err := captcha.NewClient(config.Captcha)
+	if err != nil {
+		slog.Error("Failed to initialize captcha client", "error", err)
+		os.Exit(1)
+	}
+
+	app := di.Initialize(database, notifier, captchaClient, m, config.Auth.JWTSecret, config.Outbox, config.Contact)
+	handlers := app.Handlers
+
+	// 埋め込みのプリセット種目カタログをDBに反映（失敗しても既存のプリセットで起動を続ける）
+	if err := app.Workout.SyncPresetCatalog(context.Background()); err != nil {
+		slog.Error("Failed to sync preset catalog", "error", err)
+	}
+
+	router.RegisterRoutes(e, handlers.Gym, handlers.User, handlers.Session, handlers.Workout, handlers.Template, handlers.Program, handlers.Contact, app.User, config.Auth.JWTSecret, config.Auth.AdminUserIDs)
+
+	// アウトボックスのディスパッチャ（Slack等への非同期通知）を起動
+	app.Dispatcher.Start(context.Background())
 
 	addr := fmt.Sprintf("%s:%d", config.HTTP.Host, config.HTTP.Port)
 	slog.Info("Starting server", "address", addr)
@@ -72,12 +166,26 @@ func main() {
 	select {
 	case <-sigCtx.Done():
 		slog.Info("shutdown signal received")
+		// readiness を先に失敗させ、ロードバランサーが切り離すのを待ってから HTTP を止める
+		checker.StartShutdown()
+		time.Sleep(config.Health.DrainDelay)
+
 		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 		defer cancel()
 		if err := e.Shutdown(ctx); err != nil {
 			slog.Error("graceful shutdown failed", "error", err)
 			os.Exit(1)
 		}
+		// HTTP停止後に積まれた通知を配信しきってから終了する
+		drainCtx, drainCancel := context.WithTimeout(context.Background(), config.Outbox.DrainTimeout)
+		defer drainCancel()
+		if err := app.Dispatcher.Shutdown(drainCtx); err != nil {
+			slog.Error("outbox drain incomplete", "error", err)
+		}
+		// 送信待ちのスパンを書き出してから終了する
+		if err := shutdownTracing(ctx); err != nil {
+			slog.Error("trace export incomplete", "error", err)
+		}
 		slog.Info("server shutdown complete")
 	case err := <-errCh:
 		// 起動直後にエラーで落ちた場合
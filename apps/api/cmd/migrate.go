@@ -0,0 +1,92 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"gogym-api/internal/configs"
+	"gogym-api/internal/infra/db"
+	"strconv"
+)
+
+const migrateUsage = `usage: gogym-api migrate <command>
+
+commands:
+  up          未適用のマイグレーションをすべて適用
+  down N      直近 N 件のマイグレーションを巻き戻す
+  status      適用済みバージョンと最新バージョンを表示
+  force V     dirty 状態を解除してバージョンを V に設定`
+
+// runMigrate は migrate サブコマンドを実行する
+func runMigrate(args []string) error {
+	if len(args) == 0 {
+		return errors.New(migrateUsage)
+	}
+	switch args[0] {
+	case "up", "down", "status", "force":
+	default:
+		return fmt.Errorf("unknown migrate command %q\n\n%s", args[0], migrateUsage)
+	}
+
+	cfg, err := configs.LoadDatabase()
+	if err != nil {
+		return fmt.Errorf("failed to load database configuration: %w", err)
+	}
+
+	migrator, err := db.NewMigrator(*cfg)
+	if err != nil {
+		return err
+	}
+	defer migrator.Close()
+
+	switch args[0] {
+	case "up":
+		if err := migrator.Up(); err != nil {
+			return err
+		}
+	case "down":
+		n, err := intArg(args, "down")
+		if err != nil {
+			return err
+		}
+		if err := migrator.Down(n); err != nil {
+			return err
+		}
+	case "force":
+		v, err := intArg(args, "force")
+		if err != nil {
+			return err
+		}
+		if err := migrator.Force(v); err != nil {
+			return err
+		}
+	}
+
+	status, err := migrator.Status()
+	if err != nil {
+		return err
+	}
+	fmt.Printf("version=%d dirty=%t latest=%d pending=%t\n", status.Version, status.Dirty, status.Latest, status.Pending())
+	return nil
+}
+
+func intArg(args []string, cmd string) (int, error) {
+	if len(args) < 2 {
+		return 0, fmt.Errorf("migrate %s requires a number\n\n%s", cmd, migrateUsage)
+	}
+	n, err := strconv.Atoi(args[1])
+	if err != nil {
+		return 0, fmt.Errorf("invalid number %q for migrate %s", args[1], cmd)
+	}
+	return n, nil
+}
+
+// checkSchema は起動時にスキーマがバイナリのマイグレーションに追いついているか確認する
+func checkSchema(cfg configs.DatabaseConfig) error {
+	migrator, err := db.NewMigrator(cfg)
+	if err != nil {
+		return err
+	}
+	defer migrator.Close()
+
+	return migrator.CheckSchema()
+}
@@ -0,0 +1,71 @@
+package dto
+
+import (
+	"time"
+
+	"gogym-api/internal/domain/entities/contact"
+)
+
+// ContactMessageResponse は管理画面向けの問い合わせ詳細
+type ContactMessageResponse struct {
+	ID                int64      `json:"id"`
+	Email             string     `json:"email"`
+	Message           string     `json:"message"`
+	UserID            *string    `json:"user_id,omitempty"`
+	IP                string     `json:"ip"`
+	UserAgent         string     `json:"user_agent"`
+	Status            string     `json:"status"`
+	SpamScore         int        `json:"spam_score"`
+	SpamReasons       []string   `json:"spam_reasons,omitempty"`
+	DeliveryStatus    string     `json:"delivery_status"`
+	DeliveryAttempts  int        `json:"delivery_attempts"`
+	LastDeliveryError *string    `json:"last_delivery_error,omitempty"`
+	DeliveredAt       *time.Time `json:"delivered_at,omitempty"`
+	ResolvedAt        *time.Time `json:"resolved_at,omitempty"`
+	ResolvedBy        *string    `json:"resolved_by,omitempty"`
+	CreatedAt         time.Time  `json:"created_at"`
+}
+
+// ContactMessageListResponse は問い合わせ一覧
+type ContactMessageListResponse struct {
+	Items []ContactMessageResponse `json:"items"`
+	Total int64                    `json:"total"`
+}
+
+// ContactFormTokenResponse はフォーム送信時に返送してもらうトークン
+type ContactFormTokenResponse struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func ContactMessageToResponse(m *contact.Message) ContactMessageResponse {
+	return ContactMessageResponse{
+		ID:                m.ID,
+		Email:             m.Email,
+		Message:           m.Body,
+		UserID:            m.UserID,
+		IP:                m.IP,
+		UserAgent:         m.UserAgent,
+		Status:            string(m.Status),
+		SpamScore:         m.SpamScore,
+		SpamReasons:       m.SpamReasons,
+		DeliveryStatus:    string(m.DeliveryStatus),
+		DeliveryAttempts:  m.DeliveryAttempts,
+		LastDeliveryError: m.LastDeliveryError,
+		DeliveredAt:       m.DeliveredAt,
+		ResolvedAt:        m.ResolvedAt,
+		ResolvedBy:        m.ResolvedBy,
+		CreatedAt:         m.CreatedAt,
+	}
+}
+
+func ContactMessagesToListResponse(messages []contact.Message, total int64) ContactMessageListResponse {
+	items := make([]ContactMessageResponse, 0, len(messages))
+	for i := range messages {
+		items = append(items, ContactMessageToResponse(&messages[i]))
+	}
+	return ContactMessageListResponse{
+		Items: items,
+		Total: total,
+	}
+}
@@ -0,0 +1,17 @@
+package dto
+
+// ProblemContentType は RFC 7807 のエラーレスポンスの Content-Type
+const ProblemContentType = "application/problem+json"
+
+// ProblemDTO は RFC 7807（problem+json）のエラーレスポンス
+// type は個別のドキュメントを用意していないため about:blank とし、種類は code で区別する
+type ProblemDTO struct {
+	Type      string `json:"type"`
+	Title     string `json:"title"`            // ステータスの説明（例: "Not Found"）
+	Status    int    `json:"status"`           // HTTP ステータス
+	Detail    string `json:"detail,omitempty"` // このエラーの説明（500 では内部のメッセージを返さない）
+	Instance  string `json:"instance,omitempty"`
+	Code      string `json:"code,omitempty"`       // 機械可読なコード（例: "workout_record_not_found"）
+	RequestID string `json:"request_id,omitempty"` // X-Request-Id と同じ値（問い合わせ・ログの照合用）
+	Errors    any    `json:"errors,omitempty"`     // 項目・種目ごとの検証エラー
+}
@@ -0,0 +1,276 @@
+package dto
+
+import (
+	"time"
+
+	dom "gogym-api/internal/domain/entities"
+	dp "gogym-api/internal/domain/entities/program"
+	"gogym-api/internal/domain/entities/workout"
+	"gogym-api/internal/util"
+)
+
+// ProgramDTO は複数週のプログラム
+type ProgramDTO struct {
+	ID    int64           `json:"id"`
+	Name  string          `json:"name"`
+	Note  *string         `json:"note,omitempty"`
+	Weeks int             `json:"weeks"`
+	Days  []ProgramDayDTO `json:"days"` // トレーニング日のみ（日程にない日は休養日）
+}
+
+// ProgramDayDTO はプログラムのトレーニング日
+type ProgramDayDTO struct {
+	Week          int               `json:"week" validate:"min=1,max=52"`
+	Day           int               `json:"day" validate:"min=1,max=7"` // 1〜7（開始日と同じ曜日が1）
+	TemplateID    int64             `json:"template_id" validate:"required"`
+	TemplateName  string            `json:"template_name,omitempty"` // レスポンスのみ
+	Prescriptions []PrescriptionDTO `json:"prescriptions" validate:"max=60,dive"`
+}
+
+// PrescriptionDTO はトレーニングマックスに対する割合で指定したセット（種目ごとのセット番号は配列の順）
+type PrescriptionDTO struct {
+	ExerciseID   int64   `json:"exercise_id" validate:"required"`
+	Name         string  `json:"name,omitempty"`       // レスポンスのみ
+	SetNumber    int     `json:"set_number,omitempty"` // レスポンスのみ
+	PercentOfMax float64 `json:"percent_of_max" validate:"gt=0,lte=120"`
+	Reps         int     `json:"reps" validate:"min=1,max=100"`
+	AMRAP        bool    `json:"amrap"`
+}
+
+// SaveProgramRequest はプログラムの作成・更新リクエスト（日程は全件置き換え）
+type SaveProgramRequest struct {
+	Name  string          `json:"name" validate:"required,max=100"`
+	Note  *string         `json:"note,omitempty" validate:"omitnil,max=2000"`
+	Weeks int             `json:"weeks" validate:"min=1,max=52"`
+	Days  []ProgramDayDTO `json:"days" validate:"min=1,dive"`
+}
+
+// EnrollProgramRequest はプログラムを開始するリクエスト
+type EnrollProgramRequest struct {
+	StartDate string `json:"start_date" validate:"required,datetime=2006-01-02"` // YYYY-MM-DD
+}
+
+// EnrollmentDTO は進行中のプログラム
+type EnrollmentDTO struct {
+	ID          int64  `json:"id"`
+	ProgramID   int64  `json:"program_id"`
+	ProgramName string `json:"program_name"`
+	Weeks       int    `json:"weeks"`
+	StartDate   string `json:"start_date"`
+	EndDate     string `json:"end_date"` // 最終日
+}
+
+// ProgramTodayDTO はある日のプログラムの予定
+type ProgramTodayDTO struct {
+	Date       string             `json:"date"`
+	Enrollment EnrollmentDTO      `json:"enrollment"`
+	Status     string             `json:"status"` // "not_started" | "training" | "rest" | "finished"
+	Week       *int               `json:"week"`
+	Day        *int               `json:"day"`
+	Workout    *PlannedWorkoutDTO `json:"workout"` // トレーニング日のみ
+	Units      UnitsDTO           `json:"units"`   // 処方の重量の表示単位と丸め幅
+}
+
+// PlannedWorkoutDTO はトレーニング日に行うテンプレートと処方の重量
+type PlannedWorkoutDTO struct {
+	Template      TemplateDTO             `json:"template"`
+	Prescriptions []PrescribedExerciseDTO `json:"prescriptions"`
+	RecordID      *int64                  `json:"record_id"` // 実施済みならそのセッション
+}
+
+// PrescribedExerciseDTO は種目ごとの処方
+type PrescribedExerciseDTO struct {
+	ExerciseID    int64              `json:"exercise_id"`
+	Name          string             `json:"name"`
+	TrainingMaxKg *float64           `json:"training_max_kg"` // 未設定は null
+	TrainingMax   *float64           `json:"training_max"`    // TrainingMaxKg の表示単位の値
+	Sets          []PrescribedSetDTO `json:"sets"`
+}
+
+type PrescribedSetDTO struct {
+	SetNumber    int      `json:"set_number"`
+	PercentOfMax float64  `json:"percent_of_max"`
+	Reps         int      `json:"reps"`
+	AMRAP        bool     `json:"amrap"`
+	WeightKg     *float64 `json:"weight_kg"` // トレーニングマックスが未設定なら null
+	Weight       *float64 `json:"weight"`    // WeightKg の表示単位の値
+}
+
+// AdherenceDTO はプログラムの予定に対する実施状況
+type AdherenceDTO struct {
+	AsOf       string            `json:"as_of"`
+	Enrollment EnrollmentDTO     `json:"enrollment"`
+	Planned    int               `json:"planned"`
+	Performed  int               `json:"performed"`
+	Rate       float64           `json:"rate"` // 0〜1
+	Days       []DayAdherenceDTO `json:"days"`
+}
+
+type DayAdherenceDTO struct {
+	Week         int    `json:"week"`
+	Day          int    `json:"day"`
+	Date         string `json:"date"`
+	TemplateID   int64  `json:"template_id"`
+	TemplateName string `json:"template_name"`
+	Status       string `json:"status"` // "done" | "missed" | "upcoming"
+	RecordID     *int64 `json:"record_id"`
+}
+
+// SaveProgramRequestToDomain converts SaveProgramRequest to domain.Program
+func SaveProgramRequestToDomain(userID dom.ULID, req SaveProgramRequest) (*dp.Program, error) {
+	days := make([]dp.ProgramDay, 0, len(req.Days))
+	for _, d := range req.Days {
+		prescriptions := make([]dp.Prescription, 0, len(d.Prescriptions))
+		for _, rx := range d.Prescriptions {
+			prescriptions = append(prescriptions, dp.Prescription{
+				Exercise:     workout.WorkoutExerciseRef{ID: dom.ID(rx.ExerciseID)},
+				PercentOfMax: rx.PercentOfMax,
+				Reps:         rx.Reps,
+				AMRAP:        rx.AMRAP,
+			})
+		}
+		days = append(days, dp.ProgramDay{
+			Week:          d.Week,
+			Day:           d.Day,
+			TemplateID:    dom.ID(d.TemplateID),
+			Prescriptions: prescriptions,
+		})
+	}
+	return dp.NewProgram(userID, req.Name, req.Note, req.Weeks, days)
+}
+
+// ProgramToDTO converts domain.Program to ProgramDTO
+// 種目名は locale で解決する
+func ProgramToDTO(p *dp.Program, locale string) ProgramDTO {
+	out := ProgramDTO{
+		ID:    int64(p.ID),
+		Name:  p.Name,
+		Note:  p.Note,
+		Weeks: p.Weeks,
+		Days:  make([]ProgramDayDTO, 0, len(p.Days)),
+	}
+	for _, d := range p.Days {
+		day := ProgramDayDTO{
+			Week:          d.Week,
+			Day:           d.Day,
+			TemplateID:    int64(d.TemplateID),
+			TemplateName:  d.TemplateName,
+			Prescriptions: make([]PrescriptionDTO, 0, len(d.Prescriptions)),
+		}
+		for _, rx := range d.Prescriptions {
+			day.Prescriptions = append(day.Prescriptions, PrescriptionDTO{
+				ExerciseID:   int64(rx.Exercise.ID),
+				Name:         rx.Exercise.LocalizedName(locale),
+				SetNumber:    rx.SetNumber,
+				PercentOfMax: rx.PercentOfMax,
+				Reps:         rx.Reps,
+				AMRAP:        rx.AMRAP,
+			})
+		}
+		out.Days = append(out.Days, day)
+	}
+	return out
+}
+
+// ProgramsToDTO converts domain.Program list to ProgramDTO list
+func ProgramsToDTO(programs []dp.Program, locale string) []ProgramDTO {
+	out := make([]ProgramDTO, 0, len(programs))
+	for i := range programs {
+		out = append(out, ProgramToDTO(&programs[i], locale))
+	}
+	return out
+}
+
+// EnrollmentToDTO converts domain.Enrollment to EnrollmentDTO
+func EnrollmentToDTO(e *dp.Enrollment) EnrollmentDTO {
+	return EnrollmentDTO{
+		ID:          int64(e.ID),
+		ProgramID:   int64(e.Program.ID),
+		ProgramName: e.Program.Name,
+		Weeks:       e.Program.Weeks,
+		StartDate:   util.FormatDate(e.StartDate),
+		EndDate:     util.FormatDate(e.EndDate()),
+	}
+}
+
+// ProgramTodayToDTO converts domain.DayPlan to ProgramTodayDTO（Workout は呼び出し側で詰める）
+func ProgramTodayToDTO(e *dp.Enrollment, plan dp.DayPlan, units dom.WeightUnits) ProgramTodayDTO {
+	out := ProgramTodayDTO{
+		Date:       util.FormatDate(plan.Date),
+		Enrollment: EnrollmentToDTO(e),
+		Status:     string(plan.Status),
+		Units:      UnitsToDTO(units),
+	}
+	if plan.Week > 0 {
+		week, day := plan.Week, plan.Day
+		out.Week = &week
+		out.Day = &day
+	}
+	return out
+}
+
+// PrescribedExercisesToDTO は処方を種目ごとにまとめ、トレーニングマックスから重量を求める
+// trainingMaxes は種目IDごとのトレーニングマックス（kg）。重量は units の丸め幅に丸める
+func PrescribedExercisesToDTO(prescriptions []dp.Prescription, trainingMaxes map[workout.ID]float64, locale string, units dom.WeightUnits) []PrescribedExerciseDTO {
+	out := make([]PrescribedExerciseDTO, 0)
+	index := make(map[workout.ID]int)
+	for _, rx := range prescriptions {
+		i, ok := index[rx.Exercise.ID]
+		if !ok {
+			ex := PrescribedExerciseDTO{
+				ExerciseID: int64(rx.Exercise.ID),
+				Name:       rx.Exercise.LocalizedName(locale),
+				Sets:       []PrescribedSetDTO{},
+			}
+			if tm, ok := trainingMaxes[rx.Exercise.ID]; ok {
+				ex.TrainingMaxKg = &tm
+				ex.TrainingMax = displayWeight(&tm, units)
+			}
+			i = len(out)
+			index[rx.Exercise.ID] = i
+			out = append(out, ex)
+		}
+
+		set := PrescribedSetDTO{
+			SetNumber:    rx.SetNumber,
+			PercentOfMax: rx.PercentOfMax,
+			Reps:         rx.Reps,
+			AMRAP:        rx.AMRAP,
+		}
+		if tm := out[i].TrainingMaxKg; tm != nil {
+			w := rx.WorkingWeight(*tm, units.IncrementKg())
+			set.WeightKg = &w
+			set.Weight = displayWeight(&w, units)
+		}
+		out[i].Sets = append(out[i].Sets, set)
+	}
+	return out
+}
+
+// AdherenceToDTO converts domain.Adherence to AdherenceDTO
+func AdherenceToDTO(asOf time.Time, e *dp.Enrollment, a dp.Adherence) AdherenceDTO {
+	out := AdherenceDTO{
+		AsOf:       util.FormatDate(asOf),
+		Enrollment: EnrollmentToDTO(e),
+		Planned:    a.Planned,
+		Performed:  a.Performed,
+		Rate:       a.Rate(),
+		Days:       make([]DayAdherenceDTO, 0, len(a.Days)),
+	}
+	for _, d := range a.Days {
+		day := DayAdherenceDTO{
+			Week:         d.Day.Week,
+			Day:          d.Day.Day,
+			Date:         util.FormatDate(d.Date),
+			TemplateID:   int64(d.Day.TemplateID),
+			TemplateName: d.Day.TemplateName,
+			Status:       string(d.Status),
+		}
+		if d.RecordID != nil {
+			id := int64(*d.RecordID)
+			day.RecordID = &id
+		}
+		out.Days = append(out.Days, day)
+	}
+	return out
+}
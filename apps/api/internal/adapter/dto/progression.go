@@ -0,0 +1,142 @@
+package dto
+
+import (
+	"fmt"
+	"strconv"
+
+	dom "gogym-api/internal/domain/entities"
+	"gogym-api/internal/domain/entities/workout"
+	"gogym-api/internal/util"
+)
+
+// ProgressionSuggestionDTO は次のセッションの重量・回数の提案
+type ProgressionSuggestionDTO struct {
+	ExerciseID  int64                   `json:"exercise_id"`
+	Name        string                  `json:"name,omitempty"` // 記録がない場合は空
+	Strategy    string                  `json:"strategy"`       // "double_progression" | "linear"
+	Rule        string                  `json:"rule"`           // "no_history" | "increase_weight" | "add_reps" | "repeat" | "deload"
+	Kind        string                  `json:"kind,omitempty"`
+	WeightKg    *float64                `json:"weight_kg"` // 記録がない場合は null
+	Weight      *float64                `json:"weight"`    // WeightKg の表示単位の値
+	Reps        *int                    `json:"reps"`
+	Sets        *int                    `json:"sets"`
+	IncrementKg float64                 `json:"increment_kg"`
+	Increment   float64                 `json:"increment"`   // IncrementKg の表示単位の値
+	Explanation string                  `json:"explanation"` // 適用したルールの説明（リクエストのロケール・表示単位）
+	History     []ProgressionSessionDTO `json:"history"`     // 分析したセッション（新しい順）
+	Units       UnitsDTO                `json:"units"`
+}
+
+type ProgressionSessionDTO struct {
+	PerformedDate string  `json:"performed_date"`
+	WeightKg      float64 `json:"weight_kg"`
+	Weight        float64 `json:"weight"` // WeightKg の表示単位の値
+	Reps          []int   `json:"reps"`
+	Missed        bool    `json:"missed"`
+	ReachedTop    bool    `json:"reached_top"`
+}
+
+// ProgressionSuggestionToDTO converts domain.ProgressionSuggestion to ProgressionSuggestionDTO
+// 重量は kg と units の単位の両方で返し、説明文は units の単位で書く
+func ProgressionSuggestionToDTO(exerciseID int64, s workout.ProgressionSuggestion, locale string, units dom.WeightUnits) ProgressionSuggestionDTO {
+	out := ProgressionSuggestionDTO{
+		ExerciseID:  exerciseID,
+		Strategy:    string(s.Config.Strategy),
+		Rule:        string(s.Rule),
+		IncrementKg: roundKg(float64(s.Increment)),
+		Increment:   units.FromKg(float64(s.Increment)),
+		Explanation: progressionExplanation(s, locale, units),
+		History:     make([]ProgressionSessionDTO, 0, len(s.History)),
+		Units:       UnitsToDTO(units),
+	}
+	if s.Rule != workout.RuleNoHistory {
+		weight, reps, sets := roundKg(float64(s.Weight)), int(s.Reps), s.Sets
+		out.Name = s.Exercise.LocalizedName(locale)
+		out.Kind = string(s.Kind)
+		out.WeightKg = &weight
+		out.Weight = displayWeight(&weight, units)
+		out.Reps = &reps
+		out.Sets = &sets
+	}
+	for _, p := range s.History {
+		reps := make([]int, 0, len(p.Reps))
+		for _, r := range p.Reps {
+			reps = append(reps, int(r))
+		}
+		out.History = append(out.History, ProgressionSessionDTO{
+			PerformedDate: util.FormatDate(p.PerformedDate),
+			WeightKg:      float64(p.Weight),
+			Weight:        units.FromKg(float64(p.Weight)),
+			Reps:          reps,
+			Missed:        p.Missed,
+			ReachedTop:    p.ReachedTop,
+		})
+	}
+	return out
+}
+
+// progressionExplanation は適用したルールの説明を locale の言語・units の単位で返す（ja 以外は英語）
+func progressionExplanation(s workout.ProgressionSuggestion, locale string, units dom.WeightUnits) string {
+	ja := locale == dom.LocaleJa
+	unit := string(units.UnitOrDefault())
+	formatWeight := func(w workout.WeightKg) string {
+		return formatNumber(units.FromKg(float64(w))) + " " + unit
+	}
+	cfg := s.Config
+	assisted := s.Kind == workout.SetKindAssisted
+	var last workout.SessionPerformance
+	if len(s.History) > 0 {
+		last = s.History[0]
+	}
+
+	switch s.Rule {
+	case workout.RuleNoHistory:
+		if ja {
+			return fmt.Sprintf("直近の記録がないため提案できません。無理のない重量で%d〜%d回を目安に始めてください。", cfg.RepsMin, cfg.RepsMax)
+		}
+		return fmt.Sprintf("No recent working sets to analyse. Start with a comfortable weight for %d-%d reps.", cfg.RepsMin, cfg.RepsMax)
+
+	case workout.RuleIncreaseWeight:
+		target := cfg.RepsMax
+		if cfg.Strategy == workout.ProgressionLinear {
+			target = cfg.RepsMin
+		}
+		switch {
+		case ja && assisted:
+			return fmt.Sprintf("前回は%sの補助で全%dセット%d回以上できたため、補助を%s減らして%s・%d回にします。", formatWeight(last.Weight), len(last.Reps), target, formatWeight(s.Increment), formatWeight(s.Weight), s.Reps)
+		case ja:
+			return fmt.Sprintf("前回は%sで全%dセット%d回以上できたため、%s上げて%s・%d回にします。", formatWeight(last.Weight), len(last.Reps), target, formatWeight(s.Increment), formatWeight(s.Weight), s.Reps)
+		case assisted:
+			return fmt.Sprintf("All %d sets reached %d reps with %s of assistance last time, so reduce the assistance by %s to %s for %d reps.", len(last.Reps), target, formatWeight(last.Weight), formatWeight(s.Increment), formatWeight(s.Weight), s.Reps)
+		}
+		return fmt.Sprintf("All %d sets reached %d reps at %s last time, so add %s and do %s for %d reps.", len(last.Reps), target, formatWeight(last.Weight), formatWeight(s.Increment), formatWeight(s.Weight), s.Reps)
+
+	case workout.RuleAddReps:
+		if ja {
+			return fmt.Sprintf("前回は%sで最少%d回だったため、同じ重量で%d回を目指します。", formatWeight(last.Weight), s.Reps-1, s.Reps)
+		}
+		return fmt.Sprintf("Your weakest set was %d reps at %s last time, so stay at this weight and aim for %d reps.", s.Reps-1, formatWeight(last.Weight), s.Reps)
+
+	case workout.RuleRepeat:
+		if ja {
+			return fmt.Sprintf("前回は%sで%d回に届かないセットがあったため、同じ重量でもう一度行います（%dセッション続けて届かなければディロードします）。", formatWeight(last.Weight), cfg.RepsMin, cfg.DeloadAfterMisses)
+		}
+		return fmt.Sprintf("Some sets fell short of %d reps at %s last time, so repeat this weight (a deload follows after %d missed sessions in a row).", cfg.RepsMin, formatWeight(last.Weight), cfg.DeloadAfterMisses)
+
+	case workout.RuleDeload:
+		switch {
+		case ja && assisted:
+			return fmt.Sprintf("%sの補助で%dセッション続けて%d回に届かなかったため、補助を%sに増やして立て直します。", formatWeight(last.Weight), s.Misses, cfg.RepsMin, formatWeight(s.Weight))
+		case ja:
+			return fmt.Sprintf("%sで%dセッション続けて%d回に届かなかったため、%s%%下げて%sで立て直します。", formatWeight(last.Weight), s.Misses, cfg.RepsMin, formatNumber(cfg.DeloadPercent), formatWeight(s.Weight))
+		case assisted:
+			return fmt.Sprintf("You missed %d reps with %s of assistance in %d sessions in a row, so increase the assistance to %s.", cfg.RepsMin, formatWeight(last.Weight), s.Misses, formatWeight(s.Weight))
+		}
+		return fmt.Sprintf("You missed %d reps at %s in %d sessions in a row, so deload by %s%% to %s.", cfg.RepsMin, formatWeight(last.Weight), s.Misses, formatNumber(cfg.DeloadPercent), formatWeight(s.Weight))
+	}
+	return ""
+}
+
+func formatNumber(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
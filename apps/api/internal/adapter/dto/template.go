@@ -0,0 +1,126 @@
+package dto
+
+import (
+	"fmt"
+
+	dom "gogym-api/internal/domain/entities"
+	dt "gogym-api/internal/domain/entities/template"
+	"gogym-api/internal/domain/entities/workout"
+	"gogym-api/internal/util"
+)
+
+// TemplateDTO はワークアウトのテンプレート（ルーティン）
+type TemplateDTO struct {
+	ID        int64                 `json:"id"`
+	Name      string                `json:"name"`
+	Note      *string               `json:"note,omitempty"`
+	Exercises []TemplateExerciseDTO `json:"exercises"`
+	Units     UnitsDTO              `json:"units"` // target_weight の表示単位
+}
+
+// TemplateExerciseDTO はテンプレート内の種目と目標（並び順は配列の順）
+type TemplateExerciseDTO struct {
+	ExerciseID     int64    `json:"exercise_id" validate:"required"`
+	Name           string   `json:"name,omitempty"`            // リクエストのロケールで解決した名前（レスポンスのみ）
+	WorkoutPartID  *int64   `json:"workout_part_id,omitempty"` // レスポンスのみ
+	TargetSets     int      `json:"target_sets" validate:"min=1,max=20"`
+	RepsMin        int      `json:"reps_min" validate:"min=1,max=100"`
+	RepsMax        int      `json:"reps_max" validate:"gtefield=RepsMin,max=100"`
+	TargetWeightKg *float64 `json:"target_weight_kg,omitempty" validate:"omitnil,gte=0,lte=1000"` // 前回の記録がない場合に使う重量
+	TargetWeight   *float64 `json:"target_weight,omitempty" validate:"omitnil,gte=0,lte=2205"`    // TargetWeightKg の表示単位の値（リクエストでは target_weight_kg がなければこちらを使う）
+	Note           *string  `json:"note,omitempty" validate:"omitnil,max=2000"`
+}
+
+// SaveTemplateRequest はテンプレートの作成・更新リクエスト（種目は全件置き換え）
+type SaveTemplateRequest struct {
+	Name      string                `json:"name" validate:"required,max=100"`
+	Note      *string               `json:"note,omitempty" validate:"omitnil,max=2000"`
+	Exercises []TemplateExerciseDTO `json:"exercises" validate:"min=1,max=30,dive"`
+}
+
+// StartTemplateRequest はテンプレートからセッションを開始するリクエスト
+type StartTemplateRequest struct {
+	PerformedDate string  `json:"performed_date" validate:"required,datetime=2006-01-02"` // YYYY-MM-DD
+	StartedAt     *string `json:"started_at,omitempty" validate:"omitnil,datetime=15:04"` // HH:mm
+}
+
+// SaveTemplateRequestToDomain converts SaveTemplateRequest to domain.Template
+// 目標重量は target_weight_kg を優先し、なければ units の単位の target_weight を kg に変換する
+func SaveTemplateRequestToDomain(userID dom.ULID, req SaveTemplateRequest, units dom.WeightUnits) (*dt.Template, error) {
+	exercises := make([]dt.TemplateExercise, 0, len(req.Exercises))
+	for _, ex := range req.Exercises {
+		exercises = append(exercises, dt.TemplateExercise{
+			Exercise:       workout.WorkoutExerciseRef{ID: dom.ID(ex.ExerciseID)},
+			TargetSets:     ex.TargetSets,
+			RepsMin:        ex.RepsMin,
+			RepsMax:        ex.RepsMax,
+			TargetWeightKg: inputWeightKg(ex.TargetWeightKg, ex.TargetWeight, units),
+			Note:           ex.Note,
+		})
+	}
+	return dt.NewTemplate(userID, req.Name, req.Note, exercises)
+}
+
+// TemplateToDTO converts domain.Template to TemplateDTO
+// 種目名は locale で解決し、目標重量は units の単位でも返す
+func TemplateToDTO(t *dt.Template, locale string, units dom.WeightUnits) TemplateDTO {
+	out := TemplateDTO{
+		ID:        int64(t.ID),
+		Name:      t.Name,
+		Note:      t.Note,
+		Exercises: make([]TemplateExerciseDTO, 0, len(t.Exercises)),
+		Units:     UnitsToDTO(units),
+	}
+	for _, ex := range t.Exercises {
+		var partID *int64
+		if ex.Exercise.PartID != nil {
+			pid := int64(*ex.Exercise.PartID)
+			partID = &pid
+		}
+		out.Exercises = append(out.Exercises, TemplateExerciseDTO{
+			ExerciseID:     int64(ex.Exercise.ID),
+			Name:           ex.Exercise.LocalizedName(locale),
+			WorkoutPartID:  partID,
+			TargetSets:     ex.TargetSets,
+			RepsMin:        ex.RepsMin,
+			RepsMax:        ex.RepsMax,
+			TargetWeightKg: ex.TargetWeightKg,
+			TargetWeight:   displayWeight(ex.TargetWeightKg, units),
+			Note:           ex.Note,
+		})
+	}
+	return out
+}
+
+// TemplatesToDTO converts domain.Template list to TemplateDTO list
+func TemplatesToDTO(templates []dt.Template, locale string, units dom.WeightUnits) []TemplateDTO {
+	out := make([]TemplateDTO, 0, len(templates))
+	for i := range templates {
+		out = append(out, TemplateToDTO(&templates[i], locale, units))
+	}
+	return out
+}
+
+// StartTemplateRequestToDomain converts StartTemplateRequest to an empty domain.WorkoutRecord
+// 日付・開始時刻の扱いは WorkoutRecordDTOToDomain と同じ（セットはテンプレートから作る）
+func StartTemplateRequestToDomain(req StartTemplateRequest, zone util.TimeZone) (*workout.WorkoutRecord, error) {
+	performedDate, err := zone.ParseDate(req.PerformedDate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid performedDate format: %w", err)
+	}
+
+	record := &workout.WorkoutRecord{
+		PerformedDate: performedDate,
+		Condition:     workout.CondUnknown,
+		Sets:          []workout.WorkoutSet{},
+	}
+	startedAt, _, err := zone.ParseSpan(performedDate, req.StartedAt, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := record.SetTimes(startedAt, nil); err != nil {
+		return nil, fmt.Errorf("invalid times: %w", err)
+	}
+
+	return record, nil
+}
@@ -0,0 +1,55 @@
+package dto
+
+import (
+	"math"
+
+	dom "gogym-api/internal/domain/entities"
+)
+
+// UnitsDTO は重量を含むレスポンスの表示単位のメタデータ
+// *_kg のフィールドは常に kg、単位の付かない重量のフィールド（weight など）は unit の値
+type UnitsDTO struct {
+	Unit      string  `json:"unit"`      // "kg" | "lb"
+	Increment float64 `json:"increment"` // unit での丸め幅
+}
+
+// UnitsToDTO converts domain.WeightUnits to UnitsDTO
+func UnitsToDTO(u dom.WeightUnits) UnitsDTO {
+	return UnitsDTO{
+		Unit:      string(u.UnitOrDefault()),
+		Increment: u.IncrementOrDefault(),
+	}
+}
+
+// unitsPtr はレスポンスに付ける表示単位のメタデータを返す
+func unitsPtr(u dom.WeightUnits) *UnitsDTO {
+	out := UnitsToDTO(u)
+	return &out
+}
+
+// displayWeight は kg の重量を表示単位の値にする（nil はそのまま）
+func displayWeight(kg *float64, u dom.WeightUnits) *float64 {
+	if kg == nil {
+		return nil
+	}
+	v := u.FromKg(*kg)
+	return &v
+}
+
+// inputWeightKg はリクエストの重量を kg にする
+// kg のフィールドがあればそれを使い、なければ表示単位のフィールドを変換する
+func inputWeightKg(kg, value *float64, u dom.WeightUnits) *float64 {
+	if kg != nil {
+		return kg
+	}
+	if value == nil {
+		return nil
+	}
+	v := u.ToKg(*value)
+	return &v
+}
+
+// roundKg は kg の重量を保存と同じ 0.01 kg 単位にする
+func roundKg(kg float64) float64 {
+	return math.Round(kg*100) / 100
+}
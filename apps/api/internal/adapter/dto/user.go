@@ -1,10 +1,12 @@
 package dto
 
+import dom "gogym-api/internal/domain/entities/user"
+
 // SignUpRequest はユーザー登録のリクエスト
 type SignUpRequest struct {
-	Name     string `json:"name" validate:"required"`
-	Email    string `json:"email" validate:"required,email"`
-	Password string `json:"password" validate:"required,min=8,containsany=ABCDEFGHIJKLMNOPQRSTUVWXYZ,containsany=abcdefghijklmnopqrstuvwxyz,containsany=0123456789"`
+	Name     string `json:"name" validate:"required,max=100"`
+	Email    string `json:"email" validate:"required,email,max=255"`
+	Password string `json:"password" validate:"required,password"` // 8〜72文字、英大文字・英小文字・数字を含む
 }
 
 // LoginRequest ログインリクエストのペイロード
@@ -12,3 +14,34 @@ type LoginRequest struct {
 	Email    string `json:"email" validate:"required,email"`
 	Password string `json:"password" validate:"required"`
 }
+
+// UserPreferencesResponse はユーザーの表示設定
+type UserPreferencesResponse struct {
+	Locale   *string  `json:"locale"`    // null なら Accept-Language に従う
+	TimeZone *string  `json:"time_zone"` // IANA のタイムゾーン名。null なら X-Time-Zone ヘッダー → Asia/Tokyo に従う
+	Units    UnitsDTO `json:"units"`     // 重量の表示単位と丸め幅（未設定なら kg・既定の幅）
+}
+
+// UpdateUserPreferencesRequest は表示設定の更新リクエスト（省略した項目は変更しない）
+type UpdateUserPreferencesRequest struct {
+	Locale   *string `json:"locale,omitempty" validate:"omitnil,oneof='' ja en"` // "" で未設定に戻す
+	TimeZone *string `json:"time_zone,omitempty" validate:"omitnil,max=64"`      // "" で未設定に戻す
+
+	WeightUnit      *string  `json:"weight_unit,omitempty" validate:"omitnil,oneof='' kg lb"`    // "kg" | "lb"、"" で未設定に戻す（丸め幅も既定値に戻る）
+	WeightIncrement *float64 `json:"weight_increment,omitempty" validate:"omitnil,gte=0,lte=50"` // weight_unit での丸め幅（例: 2.5kg, 5lb, 1.25kg）、0 で既定値に戻す
+}
+
+// UserToPreferencesResponse converts domain User to UserPreferencesResponse
+func UserToPreferencesResponse(u *dom.User) UserPreferencesResponse {
+	var locale *string
+	if u.Locale != "" {
+		l := u.Locale
+		locale = &l
+	}
+	var timeZone *string
+	if u.TimeZone != "" {
+		tz := u.TimeZone
+		timeZone = &tz
+	}
+	return UserPreferencesResponse{Locale: locale, TimeZone: timeZone, Units: UnitsToDTO(u.WeightUnits)}
+}
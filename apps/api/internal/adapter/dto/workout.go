@@ -1,9 +1,11 @@
 package dto
 
 import (
+	"errors"
 	"fmt"
 	"gogym-api/internal/util"
 	"sort"
+	"strings"
 	"time"
 
 	dom "gogym-api/internal/domain/entities"
@@ -13,8 +15,11 @@ import (
 type WorkoutPartListItemDTO struct {
 	ID           int64                        `json:"id"`
 	Key          string                       `json:"key"`
+	Name         string                       `json:"name"`   // リクエストのロケールで解決した名前
+	Source       string                       `json:"source"` // "preset" | "custom"
 	Translations []WorkoutPartTranslationDTO  `json:"translations"`
 	Exercises    []WorkoutExerciseListItemDTO `json:"exercises"`
+	Units        UnitsDTO                     `json:"units"` // 種目の training_max の表示単位
 }
 
 type WorkoutPartTranslationDTO struct {
@@ -22,24 +27,81 @@ type WorkoutPartTranslationDTO struct {
 	Name   string `json:"name"`
 }
 
+type WorkoutExerciseTranslationDTO struct {
+	Locale string `json:"locale"`
+	Name   string `json:"name"`
+}
+
 type WorkoutRecordDTO struct {
 	ID             *int64  `json:"id,omitempty"`
-	PerformedDate  string  `json:"performed_date"`
-	StartedAt      *string `json:"started_at,omitempty"`
-	EndedAt        *string `json:"ended_at,omitempty"`
-	GymID          *int64  `json:"gym_id,omitempty"`
-	GymName        *string `json:"gym_name,omitempty"`
-	Note           *string `json:"note,omitempty"`
-	ConditionLevel *int    `json:"condition_level,omitempty"`
+	PerformedDate  string  `json:"performed_date" validate:"required,datetime=2006-01-02"`
+	StartedAt      *string `json:"started_at,omitempty" validate:"omitnil,datetime=15:04"` // HH:mm（ユーザーのタイムゾーン）
+	EndedAt        *string `json:"ended_at,omitempty" validate:"omitnil,datetime=15:04"`   // HH:mm（started_at より前なら翌日の時刻）
+	GymID          *int64  `json:"gym_id,omitempty" validate:"omitnil,gt=0"`
+	GymName        *string `json:"gym_name,omitempty" validate:"omitnil,max=255"`
+	Note           *string `json:"note,omitempty" validate:"omitnil,max=2000"`
+	ConditionLevel *int    `json:"condition_level,omitempty" validate:"omitnil,min=1,max=5"`
+	TemplateID     *int64  `json:"template_id,omitempty"` // テンプレートから開始したセッション（レスポンスのみ）
+
+	// VolumeKg はウォームアップを除く総ボリューム（レスポンスのみ、リクエストでは無視）
+	VolumeKg *float64 `json:"volume_kg,omitempty"`
+	Volume   *float64 `json:"volume,omitempty"` // VolumeKg の表示単位の値（レスポンスのみ）
+
+	// Units は重量の表示単位（レスポンスのみ）
+	Units *UnitsDTO `json:"units,omitempty"`
+
+	Parts []WorkoutPartGroupDTO `json:"parts" validate:"dive"`
+}
+
+// errExerciseUnidentified はセットのある種目に ID も名前もない場合のエラー
+var errExerciseUnidentified = errors.New("exercise id or name is required")
 
-	Parts []WorkoutPartGroupDTO `json:"parts"`
+// WorkoutValidationError はセッションの保存リクエストの種目ごとの検証エラーをまとめたもの
+type WorkoutValidationError struct {
+	Errors []ExerciseErrorDTO
+}
+
+func (e *WorkoutValidationError) Error() string {
+	msgs := make([]string, 0, len(e.Errors))
+	for _, fe := range e.Errors {
+		msgs = append(msgs, fmt.Sprintf("parts[%d].exercises[%d]: %s", fe.PartIndex, fe.ExerciseIndex, fe.Message))
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Unwrap は各種目のエラーを返す（errors.Is(err, workout.ErrInvalidSet) などで判定できる）
+func (e *WorkoutValidationError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Errors))
+	for _, fe := range e.Errors {
+		errs = append(errs, fe.err)
+	}
+	return errs
+}
+
+// ExerciseErrorDTO は種目（またはそのセット）の検証エラー
+// part_index・exercise_index はリクエストの parts・exercises の位置
+type ExerciseErrorDTO struct {
+	PartIndex     int    `json:"part_index"`
+	ExerciseIndex int    `json:"exercise_index"`
+	ExerciseID    *int64 `json:"exercise_id,omitempty"`
+	Name          string `json:"name,omitempty"`
+	SetNumber     *int   `json:"set_number,omitempty"` // 種目自体のエラーなら null
+	Message       string `json:"message"`
+
+	err error
+}
+
+// WorkoutRecordsByDateDTO は1日分のセッション一覧
+type WorkoutRecordsByDateDTO struct {
+	PerformedDate string             `json:"performed_date"`
+	Records       []WorkoutRecordDTO `json:"records"`
 }
 
 type WorkoutPartGroupDTO struct {
 	ID           int64                       `json:"id"`
 	Key          string                      `json:"key"`
 	Translations []WorkoutPartTranslationDTO `json:"translations"`
-	Exercises    []ExerciseDTO               `json:"exercises"`
+	Exercises    []ExerciseDTO               `json:"exercises" validate:"dive"`
 }
 
 type WorkoutPartDTO struct {
@@ -49,37 +111,110 @@ type WorkoutPartDTO struct {
 }
 
 type ExerciseDTO struct {
-	ID            *int64   `json:"id,omitempty"`
-	Name          string   `json:"name"`
-	WorkoutPartID *int64   `json:"workout_part_id,omitempty"`
-	Sets          []SetDTO `json:"sets"`
+	ID            *int64                          `json:"id,omitempty"`
+	Name          string                          `json:"name" validate:"max=100"` // レスポンスではリクエストのロケールで解決した名前
+	Translations  []WorkoutExerciseTranslationDTO `json:"translations,omitempty"`
+	WorkoutPartID *int64                          `json:"workout_part_id,omitempty"`
+	Sets          []SetDTO                        `json:"sets" validate:"dive"`
+	Units         *UnitsDTO                       `json:"units,omitempty"` // 種目単体のレスポンスでのみ返す
 }
 
 type SetDTO struct {
-	ID        *int64   `json:"id,omitempty"`
-	SetNumber int      `json:"set_number"`
-	WeightKg  *float64 `json:"weight_kg,omitempty"` // 空文字→null→nil→層内で検証
-	Reps      *int     `json:"reps,omitempty"`
-	Note      *string  `json:"note,omitempty"`
+	ID            *int64   `json:"id,omitempty"`
+	SetNumber     int      `json:"set_number" validate:"min=1"`
+	Kind          string   `json:"kind,omitempty" validate:"omitempty,oneof=weight_reps bodyweight assisted timed distance"` // 省略時は weight_reps
+	WeightKg      *float64 `json:"weight_kg,omitempty" validate:"omitnil,gte=0,lte=1000"`                                    // 空文字→null→nil→層内で検証。自重では加重、補助付きでは補助重量
+	Weight        *float64 `json:"weight,omitempty" validate:"omitnil,gte=0,lte=2205"`                                       // WeightKg の表示単位の値（リクエストでは weight_kg がなければこちらを使う、上限は lb で 1000kg 相当）
+	Reps          *int     `json:"reps,omitempty" validate:"omitnil,gte=0,lte=1000"`
+	DurationSec   *int     `json:"duration_sec,omitempty" validate:"omitnil,gte=0,lte=86400"`
+	DistanceM     *float64 `json:"distance_m,omitempty" validate:"omitnil,gte=0,lte=1000000"`
+	BodyweightKg  *float64 `json:"bodyweight_kg,omitempty" validate:"omitnil,gte=0,lte=1000"`            // 記録時の体重
+	Bodyweight    *float64 `json:"bodyweight,omitempty" validate:"omitnil,gte=0,lte=2205"`               // BodyweightKg の表示単位の値（リクエストでは bodyweight_kg がなければこちらを使う）
+	Type          string   `json:"type,omitempty" validate:"omitempty,oneof=normal warmup drop failure"` // 省略時は normal
+	RPE           *float64 `json:"rpe,omitempty" validate:"omitnil,gte=1,lte=10"`
+	RIR           *int     `json:"rir,omitempty" validate:"omitnil,gte=0,lte=10"`
+	SupersetGroup *string  `json:"superset_group,omitempty" validate:"omitnil,max=20"`
+	EstimatedMax  *float64 `json:"estimated_max,omitempty"` // 推定1RM（表示単位、レスポンスのみ、ウォームアップは対象外）
+	CompletedAt   *string  `json:"completed_at,omitempty"`  // セットを終えた時刻（RFC3339、オフセットなしはユーザーのタイムゾーン）
+	RestSec       *int     `json:"rest_sec,omitempty"`      // 同じ種目の直前のセットからの休憩（レスポンスのみ）
+	Note          *string  `json:"note,omitempty" validate:"omitnil,max=2000"`
 }
 
 type CreateWorkoutExerciseRequest struct {
-	Exercises []CreateWorkoutExerciseItem `json:"exercises"`
+	Exercises []CreateWorkoutExerciseItem `json:"exercises" validate:"required,dive"`
 }
 
 type CreateWorkoutExerciseItem struct {
-	ID            *int64 `json:"id,omitempty"` // nil = insert, value = update
-	Name          string `json:"name"`
-	WorkoutPartID int64  `json:"workout_part_id"`
+	ID               *int64   `json:"id,omitempty" validate:"omitnil,gt=0"` // nil = insert, value = update
+	Name             string   `json:"name" validate:"required,max=100"`
+	WorkoutPartID    int64    `json:"workout_part_id" validate:"required"`
+	Equipment        string   `json:"equipment,omitempty"`
+	MovementPattern  string   `json:"movement_pattern,omitempty"`
+	PrimaryMuscles   []string `json:"primary_muscles,omitempty"`
+	SecondaryMuscles []string `json:"secondary_muscles,omitempty"`
+	SecondaryParts   []string `json:"secondary_parts,omitempty"` // 部位キー
+	Unilateral       bool     `json:"unilateral,omitempty"`
 }
 
 type WorkoutExerciseListItemDTO struct {
-	ID            int64  `json:"id"`
-	Name          string `json:"name"`
-	WorkoutPartID *int64 `json:"workout_part_id,omitempty"`
+	ID               int64                           `json:"id"`
+	Key              string                          `json:"key,omitempty"`
+	Name             string                          `json:"name"` // リクエストのロケールで解決した名前
+	Translations     []WorkoutExerciseTranslationDTO `json:"translations"`
+	WorkoutPartID    *int64                          `json:"workout_part_id,omitempty"`
+	Source           string                          `json:"source"` // "preset" | "custom"
+	PrimaryMuscles   []string                        `json:"primary_muscles"`
+	SecondaryMuscles []string                        `json:"secondary_muscles"`
+	SecondaryParts   []string                        `json:"secondary_parts"`
+	Equipment        string                          `json:"equipment,omitempty"`
+	MovementPattern  string                          `json:"movement_pattern,omitempty"`
+	Unilateral       bool                            `json:"unilateral"`
+	Hidden           bool                            `json:"hidden"`
+	DefaultRestSec   *int                            `json:"default_rest_sec"` // ユーザーが設定したセット間の休憩（未設定は null）
+	TrainingMaxKg    *float64                        `json:"training_max_kg"`  // ユーザーが設定したトレーニングマックス（未設定は null）
+	TrainingMax      *float64                        `json:"training_max"`     // TrainingMaxKg の表示単位の値
+}
+
+// UpdateExerciseRestRequest は種目のセット間の休憩の設定（null で解除）
+type UpdateExerciseRestRequest struct {
+	DefaultRestSec *int `json:"default_rest_sec" validate:"omitnil,gte=0,lte=3600"`
+}
+
+// UpdateExerciseTrainingMaxRequest は種目のトレーニングマックスの設定（null で解除）
+type UpdateExerciseTrainingMaxRequest struct {
+	TrainingMaxKg *float64 `json:"training_max_kg" validate:"omitnil,gt=0,lte=1000"`
+	TrainingMax   *float64 `json:"training_max,omitempty" validate:"omitnil,gt=0,lte=2205"` // 表示単位の値（training_max_kg が null ならこちらを使う）
+}
+
+// TrainingMaxKgIn はリクエストのトレーニングマックスを kg で返す（どちらも null なら解除）
+func (r UpdateExerciseTrainingMaxRequest) TrainingMaxKgIn(units dom.WeightUnits) *float64 {
+	return inputWeightKg(r.TrainingMaxKg, r.TrainingMax, units)
+}
+
+// RestSummaryDTO は期間内のセット間の休憩の種目別集計
+type RestSummaryDTO struct {
+	From      string                   `json:"from"`
+	To        string                   `json:"to"`
+	Exercises []ExerciseRestSummaryDTO `json:"exercises"`
+}
+
+type ExerciseRestSummaryDTO struct {
+	ExerciseID     int64  `json:"exercise_id"`
+	Name           string `json:"name"` // リクエストのロケールで解決した名前
+	AverageRestSec int    `json:"average_rest_sec"`
+	SampleCount    int    `json:"sample_count"`
+	DefaultRestSec *int   `json:"default_rest_sec"`
+	OverDefault    bool   `json:"over_default"` // 平均が設定した休憩より長い
 }
 
-func WorkoutDomainToDTO(record *workout.WorkoutRecord) *WorkoutRecordDTO {
+const (
+	SourcePreset = "preset"
+	SourceCustom = "custom"
+)
+
+// WorkoutDomainToDTO converts domain.WorkoutRecord to WorkoutRecordDTO
+// 種目名は locale で解決し、すべての翻訳も返す。時刻は zone の時刻、重量は units の単位でも返す
+func WorkoutDomainToDTO(record *workout.WorkoutRecord, locale string, zone util.TimeZone, units dom.WeightUnits) *WorkoutRecordDTO {
 	if record == nil {
 		return nil
 	}
@@ -92,13 +227,13 @@ func WorkoutDomainToDTO(record *workout.WorkoutRecord) *WorkoutRecordDTO {
 
 	var startedAt *string
 	if record.StartedAt != nil && !record.StartedAt.IsZero() {
-		s := util.FormatJSTTime(*record.StartedAt)
+		s := zone.FormatClock(*record.StartedAt)
 		startedAt = &s
 	}
 
 	var endedAt *string
 	if record.EndedAt != nil && !record.EndedAt.IsZero() {
-		s := util.FormatJSTTime(*record.EndedAt)
+		s := zone.FormatClock(*record.EndedAt)
 		endedAt = &s
 	}
 
@@ -114,15 +249,27 @@ func WorkoutDomainToDTO(record *workout.WorkoutRecord) *WorkoutRecordDTO {
 		conditionLevel = &cl
 	}
 
+	var templateID *int64
+	if record.TemplateID != nil {
+		tid := int64(*record.TemplateID)
+		templateID = &tid
+	}
+
+	volume := float64(record.Volume())
+
 	out := &WorkoutRecordDTO{
 		ID:             id,
-		PerformedDate:  util.FormatJSTDate(record.PerformedDate),
+		PerformedDate:  util.FormatDate(record.PerformedDate),
 		StartedAt:      startedAt,
 		EndedAt:        endedAt,
 		GymID:          gymID,
 		GymName:        record.GymName,
 		Note:           record.Note,
 		ConditionLevel: conditionLevel,
+		VolumeKg:       &volume,
+		Volume:         displayWeight(&volume, units),
+		Units:          unitsPtr(units),
+		TemplateID:     templateID,
 		Parts:          []WorkoutPartGroupDTO{},
 	}
 
@@ -159,28 +306,14 @@ func WorkoutDomainToDTO(record *workout.WorkoutRecord) *WorkoutRecordDTO {
 			partID := pid
 			exMap[pid][eid] = &ExerciseDTO{
 				ID:            &exID,
-				Name:          ex.Name,
+				Name:          ex.LocalizedName(locale),
+				Translations:  ExerciseTranslationsToDTO(ex.Translations),
 				WorkoutPartID: &partID,
 				Sets:          []SetDTO{},
 			}
 		}
 
-		var setID *int64
-		if set.ID != nil {
-			sid := int64(*set.ID)
-			setID = &sid
-		}
-
-		weight := float64(set.Weight)
-		reps := int(set.Reps)
-
-		exMap[pid][eid].Sets = append(exMap[pid][eid].Sets, SetDTO{
-			ID:        setID,
-			SetNumber: set.SetNumber,
-			WeightKg:  &weight,
-			Reps:      &reps,
-			Note:      set.Note,
-		})
+		exMap[pid][eid].Sets = append(exMap[pid][eid].Sets, SetToDTO(set, zone, units))
 	}
 
 	partIDs := make([]int64, 0, len(partMap))
@@ -212,6 +345,20 @@ func WorkoutDomainToDTO(record *workout.WorkoutRecord) *WorkoutRecordDTO {
 	return out
 }
 
+// WorkoutRecordsToByDateDTO converts the sessions of a day to WorkoutRecordsByDateDTO
+func WorkoutRecordsToByDateDTO(performedDate string, records []workout.WorkoutRecord, locale string, zone util.TimeZone, units dom.WeightUnits) WorkoutRecordsByDateDTO {
+	out := WorkoutRecordsByDateDTO{
+		PerformedDate: performedDate,
+		Records:       make([]WorkoutRecordDTO, 0, len(records)),
+	}
+	for i := range records {
+		if r := WorkoutDomainToDTO(&records[i], locale, zone, units); r != nil {
+			out.Records = append(out.Records, *r)
+		}
+	}
+	return out
+}
+
 func domainIDToInt64Ptr(id *dom.ID) *int64 {
 	if id == nil {
 		return nil
@@ -240,25 +387,25 @@ func conditionLevelToIntPtr(c workout.ConditionLevel) *int {
 }
 
 // WorkoutRecordDTOToDomain converts WorkoutRecordDTO to domain.WorkoutRecord
-func WorkoutRecordDTOToDomain(dto *WorkoutRecordDTO) (*workout.WorkoutRecord, error) {
+// parts のすべてのグループの種目を1つのセッションにまとめる（空の parts はセットなしのセッション）
+// セットの検証エラーは最初の1件で止めず、種目ごとに集めて *WorkoutValidationError で返す
+// 実施日は暦日として、開始・終了時刻（HH:mm）は zone の時刻として UTC に変換する
+// 終了時刻が開始時刻より前なら日付をまたいだセッションとして翌日の時刻にする
+// 重量は weight_kg を優先し、なければ units の単位の weight を kg に変換する
+func WorkoutRecordDTOToDomain(dto *WorkoutRecordDTO, zone util.TimeZone, units dom.WeightUnits) (*workout.WorkoutRecord, error) {
 	if dto == nil {
 		return nil, fmt.Errorf("dto is nil")
 	}
 
-	// Parse performedDate as UTC midnight to avoid timezone shift
-	// "2025-11-25" should be stored as "2025-11-25 00:00:00 UTC" regardless of JST
-	performedDate, err := time.Parse("2006-01-02", dto.PerformedDate)
+	performedDate, err := zone.ParseDate(dto.PerformedDate)
 	if err != nil {
 		return nil, fmt.Errorf("invalid performedDate format: %w", err)
 	}
 
-	// Ensure it's UTC midnight
-	performedDateUTC := time.Date(performedDate.Year(), performedDate.Month(), performedDate.Day(), 0, 0, 0, 0, time.UTC)
-
 	// Create WorkoutRecord with placeholder userID (will be set by handler/usecase)
 	record := &workout.WorkoutRecord{
 		UserID:        dom.ULID(""), // will be set by handler
-		PerformedDate: performedDateUTC,
+		PerformedDate: performedDate,
 		Condition:     workout.CondUnknown,
 		Sets:          []workout.WorkoutSet{},
 	}
@@ -270,20 +417,9 @@ func WorkoutRecordDTOToDomain(dto *WorkoutRecordDTO) (*workout.WorkoutRecord, er
 	}
 
 	// Parse and set times
-	var startedAt, endedAt *time.Time
-	if dto.StartedAt != nil {
-		t, err := parseTimeWithDate(performedDate, *dto.StartedAt)
-		if err != nil {
-			return nil, fmt.Errorf("invalid startedAt format: %w", err)
-		}
-		startedAt = &t
-	}
-	if dto.EndedAt != nil {
-		t, err := parseTimeWithDate(performedDate, *dto.EndedAt)
-		if err != nil {
-			return nil, fmt.Errorf("invalid endedAt format: %w", err)
-		}
-		endedAt = &t
+	startedAt, endedAt, err := zone.ParseSpan(performedDate, dto.StartedAt, dto.EndedAt)
+	if err != nil {
+		return nil, err
 	}
 	if err := record.SetTimes(startedAt, endedAt); err != nil {
 		return nil, fmt.Errorf("invalid times: %w", err)
@@ -298,66 +434,202 @@ func WorkoutRecordDTOToDomain(dto *WorkoutRecordDTO) (*workout.WorkoutRecord, er
 		record.GymID = &gymID
 	}
 
-	for _, exercise := range dto.Parts[0].Exercises {
-		exerciseRef := workout.WorkoutExerciseRef{
-			Name: exercise.Name,
-		}
-		if exercise.ID != nil {
-			exerciseRef.ID = dom.ID(*exercise.ID)
-		}
-		if exercise.WorkoutPartID != nil {
-			partID := dom.ID(*exercise.WorkoutPartID)
-			exerciseRef.PartID = &partID
-		}
-		// Owner は exercise.ID の有無で判定される想定
-		// 既存の exercise.ID がない場合は新規作成されるため、Owner は usecase 層で設定される
-
-		// Convert sets
-		for _, setDTO := range exercise.Sets {
-			// Skip empty sets (both weight and reps are nil)
-			if setDTO.WeightKg == nil || setDTO.Reps == nil {
-				continue
+	// 部位ごとのグループをまとめて変換し、種目ごとの検証エラーはすべて集めて返す
+	verr := &WorkoutValidationError{}
+	for pi, part := range dto.Parts {
+		for ei, exercise := range part.Exercises {
+			exerciseRef := workout.WorkoutExerciseRef{
+				Name: exercise.Name,
 			}
-
-			workoutSet := workout.WorkoutSet{
-				Exercise:  exerciseRef,
-				SetNumber: setDTO.SetNumber,
-				Weight:    workout.WeightKg(*setDTO.WeightKg),
-				Reps:      workout.Reps(*setDTO.Reps),
-				Note:      setDTO.Note,
+			if exercise.ID != nil {
+				exerciseRef.ID = dom.ID(*exercise.ID)
 			}
-
-			if setDTO.ID != nil {
-				id := dom.ID(*setDTO.ID)
-				workoutSet.ID = &id
+			// 種目に部位の指定がなければ、送信されたグループの部位に属するものとする
+			if exercise.WorkoutPartID != nil {
+				partID := dom.ID(*exercise.WorkoutPartID)
+				exerciseRef.PartID = &partID
+			} else if part.ID > 0 {
+				partID := dom.ID(part.ID)
+				exerciseRef.PartID = &partID
+			}
+			// Owner は exercise.ID の有無で判定される想定
+			// 既存の exercise.ID がない場合は新規作成されるため、Owner は usecase 層で設定される
+
+			fail := func(setNumber *int, err error) {
+				verr.Errors = append(verr.Errors, ExerciseErrorDTO{
+					PartIndex:     pi,
+					ExerciseIndex: ei,
+					ExerciseID:    exercise.ID,
+					Name:          exerciseRef.Name,
+					SetNumber:     setNumber,
+					Message:       err.Error(),
+					err:           err,
+				})
 			}
 
-			// Add set to record
-			if err := record.AddSet(workoutSet); err != nil {
-				return nil, fmt.Errorf("failed to add set: %w", err)
+			// Convert sets
+			added := 0
+			for _, setDTO := range exercise.Sets {
+				setNumber := setDTO.SetNumber
+				workoutSet, ok, err := setDTOToDomain(setDTO, exerciseRef, zone, units)
+				if err != nil {
+					fail(&setNumber, err)
+					continue
+				}
+				if !ok {
+					continue
+				}
+
+				// Add set to record（同じ種目が複数のグループにあってもセット番号の重複はここで検出する）
+				if err := record.AddSet(workoutSet); err != nil {
+					fail(&setNumber, err)
+					continue
+				}
+				added++
+			}
+			if added > 0 && exercise.ID == nil && strings.TrimSpace(exercise.Name) == "" {
+				fail(nil, errExerciseUnidentified)
 			}
 		}
 	}
+	if len(verr.Errors) > 0 {
+		return nil, verr
+	}
 
 	return record, nil
 }
 
-// parseTimeWithDate combines a date and HH:mm time string
-// フロントから受け取った HH:mm をそのまま UTC として保存（タイムゾーン変換しない）
-func parseTimeWithDate(date time.Time, hhmmStr string) (time.Time, error) {
-	t, err := time.Parse("15:04", hhmmStr)
-	if err != nil {
-		return time.Time{}, err
+// SetToDTO converts domain.WorkoutSet to SetDTO
+// 重量・回数は従来のクライアントのため種類によらず返す
+func SetToDTO(set workout.WorkoutSet, zone util.TimeZone, units dom.WeightUnits) SetDTO {
+	var setID *int64
+	if set.ID != nil {
+		sid := int64(*set.ID)
+		setID = &sid
+	}
+
+	weight := float64(set.Weight)
+	reps := int(set.Reps)
+
+	var bodyweight *float64
+	if set.BodyweightKg != nil {
+		bw := float64(*set.BodyweightKg)
+		bodyweight = &bw
 	}
 
-	// タイムゾーン変換せず、そのままUTCとして保存
-	utcTime := time.Date(date.Year(), date.Month(), date.Day(), t.Hour(), t.Minute(), 0, 0, time.UTC)
+	var completedAt *string
+	if set.CompletedAt != nil {
+		t := zone.FormatInstant(*set.CompletedAt)
+		completedAt = &t
+	}
 
-	return utcTime, nil
+	var restSec *int
+	if set.Rest != nil {
+		sec := int(set.Rest.Seconds())
+		restSec = &sec
+	}
+
+	return SetDTO{
+		ID:            setID,
+		SetNumber:     set.SetNumber,
+		Kind:          string(set.KindOrDefault()),
+		WeightKg:      &weight,
+		Weight:        displayWeight(&weight, units),
+		Reps:          &reps,
+		DurationSec:   set.DurationSec,
+		DistanceM:     set.DistanceM,
+		BodyweightKg:  bodyweight,
+		Bodyweight:    displayWeight(bodyweight, units),
+		Type:          string(set.TypeOrDefault()),
+		RPE:           set.RPE,
+		RIR:           set.RIR,
+		SupersetGroup: set.SupersetGroup,
+		EstimatedMax:  displayWeight(set.EstimatedMax, units),
+		CompletedAt:   completedAt,
+		RestSec:       restSec,
+		Note:          set.Note,
+	}
+}
+
+// setDTOToDomain converts SetDTO to domain.WorkoutSet
+// 入力途中の空のセットは ok=false を返して読み飛ばす（記録値の検証は AddSet で行う）
+func setDTOToDomain(s SetDTO, exercise workout.WorkoutExerciseRef, zone util.TimeZone, units dom.WeightUnits) (workout.WorkoutSet, bool, error) {
+	s.WeightKg = inputWeightKg(s.WeightKg, s.Weight, units)
+	s.BodyweightKg = inputWeightKg(s.BodyweightKg, s.Bodyweight, units)
+
+	kind := workout.SetKind(s.Kind)
+	if kind == "" {
+		kind = workout.SetKindWeightReps
+	}
+	// 重量×回数は重量・回数の両方が入力されたセットだけを保存する
+	if kind == workout.SetKindWeightReps && (s.WeightKg == nil || s.Reps == nil) {
+		return workout.WorkoutSet{}, false, nil
+	}
+
+	set := workout.WorkoutSet{
+		Exercise:      exercise,
+		SetNumber:     s.SetNumber,
+		Kind:          kind,
+		DurationSec:   s.DurationSec,
+		DistanceM:     s.DistanceM,
+		Type:          workout.SetType(s.Type),
+		RPE:           s.RPE,
+		RIR:           s.RIR,
+		SupersetGroup: s.SupersetGroup,
+		Note:          s.Note,
+	}
+	if s.WeightKg != nil {
+		set.Weight = workout.WeightKg(*s.WeightKg)
+	}
+	if s.Reps != nil {
+		set.Reps = workout.Reps(*s.Reps)
+	}
+	if s.BodyweightKg != nil {
+		bw := workout.WeightKg(*s.BodyweightKg)
+		set.BodyweightKg = &bw
+	}
+	if s.SupersetGroup != nil {
+		group := strings.TrimSpace(*s.SupersetGroup)
+		set.SupersetGroup = &group
+	}
+	if s.CompletedAt != nil {
+		t, err := zone.ParseInstant(*s.CompletedAt)
+		if err != nil {
+			return workout.WorkoutSet{}, false, fmt.Errorf("invalid completedAt format: %w", err)
+		}
+		set.CompletedAt = &t
+	}
+	if s.ID != nil {
+		id := dom.ID(*s.ID)
+		set.ID = &id
+	}
+
+	return set, !set.IsEmpty(), nil
+}
+
+// RestSummariesToDTO converts domain.RestSummary list to RestSummaryDTO
+func RestSummariesToDTO(from, to string, summaries []workout.RestSummary, locale string) RestSummaryDTO {
+	out := RestSummaryDTO{
+		From:      from,
+		To:        to,
+		Exercises: make([]ExerciseRestSummaryDTO, 0, len(summaries)),
+	}
+	for _, s := range summaries {
+		out.Exercises = append(out.Exercises, ExerciseRestSummaryDTO{
+			ExerciseID:     int64(s.Exercise.ID),
+			Name:           s.Exercise.LocalizedName(locale),
+			AverageRestSec: int(s.Average.Round(time.Second).Seconds()),
+			SampleCount:    s.Samples,
+			DefaultRestSec: s.Exercise.DefaultRestSec,
+			OverDefault:    s.ExceedsDefault(),
+		})
+	}
+	return out
 }
 
 // WorkoutPartToDTO converts domain.WorkoutPart to WorkoutPartListItemDTO
-func WorkoutPartToDTO(part *workout.WorkoutPart) *WorkoutPartListItemDTO {
+// 部位名・種目名は locale で解決し、すべての翻訳も返す
+func WorkoutPartToDTO(part *workout.WorkoutPart, locale string, units dom.WeightUnits) *WorkoutPartListItemDTO {
 	if part == nil {
 		return nil
 	}
@@ -381,25 +653,65 @@ func WorkoutPartToDTO(part *workout.WorkoutPart) *WorkoutPartListItemDTO {
 		}
 
 		exercises = append(exercises, WorkoutExerciseListItemDTO{
-			ID:            int64(ex.ID),
-			Name:          ex.Name,
-			WorkoutPartID: partIDPtr,
+			ID:               int64(ex.ID),
+			Key:              ex.Key,
+			Name:             ex.LocalizedName(locale),
+			Translations:     ExerciseTranslationsToDTO(ex.Translations),
+			WorkoutPartID:    partIDPtr,
+			Source:           sourceOf(ex.IsPreset()),
+			PrimaryMuscles:   musclesToStrings(ex.PrimaryMuscles),
+			SecondaryMuscles: musclesToStrings(ex.SecondaryMuscles),
+			SecondaryParts:   append([]string{}, ex.SecondaryParts...),
+			Equipment:        string(ex.Equipment),
+			MovementPattern:  string(ex.MovementPattern),
+			Unilateral:       ex.Unilateral,
+			Hidden:           ex.Hidden,
+			DefaultRestSec:   ex.DefaultRestSec,
+			TrainingMaxKg:    ex.TrainingMaxKg,
+			TrainingMax:      displayWeight(ex.TrainingMaxKg, units),
 		})
 	}
 
 	return &WorkoutPartListItemDTO{
 		ID:           int64(part.ID),
 		Key:          part.Key,
+		Name:         part.LocalizedName(locale),
+		Source:       sourceOf(part.IsPreset()),
 		Translations: translations,
 		Exercises:    exercises,
+		Units:        UnitsToDTO(units),
 	}
 }
 
 // WorkoutPartsToDTO converts slice of domain.WorkoutPart to slice of WorkoutPartListItemDTO
-func WorkoutPartsToDTO(parts []workout.WorkoutPart) []WorkoutPartListItemDTO {
+func WorkoutPartsToDTO(parts []workout.WorkoutPart, locale string, units dom.WeightUnits) []WorkoutPartListItemDTO {
 	result := make([]WorkoutPartListItemDTO, len(parts))
 	for i, part := range parts {
-		result[i] = *WorkoutPartToDTO(&part)
+		result[i] = *WorkoutPartToDTO(&part, locale, units)
 	}
 	return result
 }
+
+// ExerciseTranslationsToDTO converts exercise translations to DTOs
+func ExerciseTranslationsToDTO(translations []workout.WorkoutExerciseTranslation) []WorkoutExerciseTranslationDTO {
+	out := make([]WorkoutExerciseTranslationDTO, 0, len(translations))
+	for _, t := range translations {
+		out = append(out, WorkoutExerciseTranslationDTO{Locale: t.Locale, Name: t.Name})
+	}
+	return out
+}
+
+func sourceOf(preset bool) string {
+	if preset {
+		return SourcePreset
+	}
+	return SourceCustom
+}
+
+func musclesToStrings(muscles []workout.Muscle) []string {
+	out := make([]string, 0, len(muscles))
+	for _, m := range muscles {
+		out = append(out, string(m))
+	}
+	return out
+}
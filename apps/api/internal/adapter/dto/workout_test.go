@@ -0,0 +1,342 @@
+package dto
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	dom "gogym-api/internal/domain/entities"
+	dw "gogym-api/internal/domain/entities/workout"
+	"gogym-api/internal/util"
+)
+
+func TestWorkoutRecordDTOToDomain_SetKinds(t *testing.T) {
+	t.Parallel()
+
+	t.Run("正常系: 種類ごとの記録値を変換し、空のセットは読み飛ばす", func(t *testing.T) {
+		t.Parallel()
+
+		record, err := WorkoutRecordDTOToDomain(newRecord(
+			SetDTO{SetNumber: 1, WeightKg: ptr(80.0), Reps: ptr(10)},
+			SetDTO{SetNumber: 2, WeightKg: ptr(80.0)}, // 回数未入力
+			SetDTO{SetNumber: 3, Kind: "bodyweight", Reps: ptr(12), WeightKg: ptr(10.0), BodyweightKg: ptr(70.0)},
+			SetDTO{SetNumber: 4, Kind: "assisted", Reps: ptr(8), WeightKg: ptr(20.0), BodyweightKg: ptr(70.0)},
+			SetDTO{SetNumber: 5, Kind: "timed", DurationSec: ptr(60)},
+			SetDTO{SetNumber: 6, Kind: "distance", DistanceM: ptr(5000.0), DurationSec: ptr(1500)},
+			SetDTO{SetNumber: 7, Kind: "timed"}, // 時間未入力
+		), util.TimeZone{}, dom.WeightUnits{})
+		require.NoError(t, err)
+		require.Len(t, record.Sets, 5)
+
+		require.Equal(t, dw.SetKindWeightReps, record.Sets[0].Kind)
+		require.Equal(t, dw.WeightKg(80), record.Sets[0].Weight)
+
+		load, ok := record.Sets[1].EffectiveLoad()
+		require.True(t, ok)
+		require.Equal(t, dw.WeightKg(80), load)
+
+		load, ok = record.Sets[2].EffectiveLoad()
+		require.True(t, ok)
+		require.Equal(t, dw.WeightKg(50), load)
+
+		require.Equal(t, dw.SetKindTimed, record.Sets[3].Kind)
+		require.Equal(t, 60, *record.Sets[3].DurationSec)
+		require.Equal(t, dw.SetKindDistance, record.Sets[4].Kind)
+		require.Equal(t, 5000.0, *record.Sets[4].DistanceM)
+	})
+
+	t.Run("正常系: ドメインのセットをDTOに戻すと種類と記録値が復元される", func(t *testing.T) {
+		t.Parallel()
+
+		record, err := WorkoutRecordDTOToDomain(newRecord(
+			SetDTO{SetNumber: 1, Kind: "distance", DistanceM: ptr(400.0), WeightKg: ptr(24.0)},
+		), util.TimeZone{}, dom.WeightUnits{})
+		require.NoError(t, err)
+
+		got := SetToDTO(record.Sets[0], util.TimeZone{}, dom.WeightUnits{})
+		require.Equal(t, "distance", got.Kind)
+		require.Equal(t, 400.0, *got.DistanceM)
+		require.Equal(t, 24.0, *got.WeightKg)
+		require.Nil(t, got.DurationSec)
+	})
+
+	t.Run("異常系: 種類に合わない記録値はErrInvalidSetを返す", func(t *testing.T) {
+		t.Parallel()
+
+		cases := map[string]SetDTO{
+			"未定義の種類":      {SetNumber: 1, Kind: "swim", Reps: ptr(1)},
+			"負の時間":        {SetNumber: 1, Kind: "timed", DurationSec: ptr(-30)},
+			"時間セットに距離":    {SetNumber: 1, Kind: "timed", DurationSec: ptr(30), DistanceM: ptr(100.0)},
+			"重量セットに時間":    {SetNumber: 1, WeightKg: ptr(60.0), Reps: ptr(5), DurationSec: ptr(30)},
+			"補助重量なしの補助付き": {SetNumber: 1, Kind: "assisted", Reps: ptr(5)},
+			"体重を超える補助重量":  {SetNumber: 1, Kind: "assisted", Reps: ptr(5), WeightKg: ptr(80.0), BodyweightKg: ptr(70.0)},
+		}
+		for name, set := range cases {
+			_, err := WorkoutRecordDTOToDomain(newRecord(set), util.TimeZone{}, dom.WeightUnits{})
+			require.ErrorIs(t, err, dw.ErrInvalidSet, name)
+		}
+	})
+}
+
+func TestWorkoutRecordDTOToDomain_SetAnnotations(t *testing.T) {
+	t.Parallel()
+
+	t.Run("正常系: ウォームアップはボリュームと推定1RMの対象外で、注釈はDTOに戻しても保たれる", func(t *testing.T) {
+		t.Parallel()
+
+		record, err := WorkoutRecordDTOToDomain(newRecord(
+			SetDTO{SetNumber: 1, Type: "warmup", WeightKg: ptr(60.0), Reps: ptr(10)},
+			SetDTO{SetNumber: 2, WeightKg: ptr(100.0), Reps: ptr(5), RPE: ptr(8.5), RIR: ptr(1), SupersetGroup: ptr(" A ")},
+			SetDTO{SetNumber: 3, Type: "drop", WeightKg: ptr(80.0), Reps: ptr(8)},
+		), util.TimeZone{}, dom.WeightUnits{})
+		require.NoError(t, err)
+		require.Equal(t, dw.WeightKg(100*5+80*8), record.Volume())
+
+		warmup := SetToDTO(record.Sets[0], util.TimeZone{}, dom.WeightUnits{})
+		require.Equal(t, "warmup", warmup.Type)
+		require.Nil(t, warmup.EstimatedMax)
+
+		working := SetToDTO(record.Sets[1], util.TimeZone{}, dom.WeightUnits{})
+		require.Equal(t, "normal", working.Type)
+		require.Equal(t, 8.5, *working.RPE)
+		require.Equal(t, 1, *working.RIR)
+		require.Equal(t, "A", *working.SupersetGroup)
+		require.InDelta(t, 116.67, *working.EstimatedMax, 0.001)
+
+		out := WorkoutDomainToDTO(record, "ja", util.TimeZone{}, dom.WeightUnits{})
+		require.Equal(t, 1140.0, *out.VolumeKg)
+	})
+
+	t.Run("異常系: 不正な注釈はErrInvalidSetを返す", func(t *testing.T) {
+		t.Parallel()
+
+		cases := map[string]SetDTO{
+			"未定義の区分":      {SetNumber: 1, Type: "cluster", WeightKg: ptr(60.0), Reps: ptr(5)},
+			"範囲外のRPE":     {SetNumber: 1, WeightKg: ptr(60.0), Reps: ptr(5), RPE: ptr(11.0)},
+			"0.5刻みでないRPE": {SetNumber: 1, WeightKg: ptr(60.0), Reps: ptr(5), RPE: ptr(7.3)},
+			"負のRIR":       {SetNumber: 1, WeightKg: ptr(60.0), Reps: ptr(5), RIR: ptr(-1)},
+			"空のスーパーセット":   {SetNumber: 1, WeightKg: ptr(60.0), Reps: ptr(5), SupersetGroup: ptr(" ")},
+		}
+		for name, set := range cases {
+			_, err := WorkoutRecordDTOToDomain(newRecord(set), util.TimeZone{}, dom.WeightUnits{})
+			require.ErrorIs(t, err, dw.ErrInvalidSet, name)
+		}
+	})
+}
+
+func TestWorkoutRecordDTOToDomain_TimeZone(t *testing.T) {
+	t.Parallel()
+
+	newYork, err := util.LoadTimeZone("America/New_York")
+	require.NoError(t, err)
+
+	t.Run("正常系: 開始・終了時刻はユーザーのタイムゾーンの時刻としてUTCに変換し、出力で戻す", func(t *testing.T) {
+		t.Parallel()
+
+		dto := newRecord(SetDTO{SetNumber: 1, WeightKg: ptr(60.0), Reps: ptr(5), CompletedAt: ptr("2025-11-25T19:05:00")})
+		dto.StartedAt = ptr("18:30")
+		dto.EndedAt = ptr("19:45")
+
+		record, err := WorkoutRecordDTOToDomain(dto, newYork, dom.WeightUnits{})
+		require.NoError(t, err)
+		require.Equal(t, time.Date(2025, 11, 25, 0, 0, 0, 0, time.UTC), record.PerformedDate)
+		require.Equal(t, time.Date(2025, 11, 25, 23, 30, 0, 0, time.UTC), *record.StartedAt)
+		require.Equal(t, time.Date(2025, 11, 26, 0, 45, 0, 0, time.UTC), *record.EndedAt)
+		require.Equal(t, time.Date(2025, 11, 26, 0, 5, 0, 0, time.UTC), *record.Sets[0].CompletedAt)
+
+		out := WorkoutDomainToDTO(record, "ja", newYork, dom.WeightUnits{})
+		require.Equal(t, "2025-11-25", out.PerformedDate)
+		require.Equal(t, "18:30", *out.StartedAt)
+		require.Equal(t, "19:45", *out.EndedAt)
+		require.Equal(t, "2025-11-25T19:05:00-05:00", *out.Parts[0].Exercises[0].Sets[0].CompletedAt)
+	})
+
+	t.Run("正常系: 終了時刻が開始時刻より前なら日付をまたいだセッションとして扱う", func(t *testing.T) {
+		t.Parallel()
+
+		dto := newRecord(SetDTO{SetNumber: 1, WeightKg: ptr(60.0), Reps: ptr(5)})
+		dto.StartedAt = ptr("23:30")
+		dto.EndedAt = ptr("00:40")
+
+		record, err := WorkoutRecordDTOToDomain(dto, util.TimeZone{}, dom.WeightUnits{})
+		require.NoError(t, err)
+		require.Equal(t, time.Date(2025, 11, 25, 14, 30, 0, 0, time.UTC), *record.StartedAt)
+		require.Equal(t, time.Date(2025, 11, 25, 15, 40, 0, 0, time.UTC), *record.EndedAt)
+		require.Equal(t, 70, *record.DurationMin)
+		require.Equal(t, time.Date(2025, 11, 25, 0, 0, 0, 0, time.UTC), record.PerformedDate)
+	})
+
+	t.Run("異常系: 時刻の形式が不正ならエラーを返す", func(t *testing.T) {
+		t.Parallel()
+
+		dto := newRecord(SetDTO{SetNumber: 1, WeightKg: ptr(60.0), Reps: ptr(5)})
+		dto.StartedAt = ptr("7pm")
+
+		_, err := WorkoutRecordDTOToDomain(dto, newYork, dom.WeightUnits{})
+		require.Error(t, err)
+	})
+}
+
+func TestWorkoutRecordDTOToDomain_Parts(t *testing.T) {
+	t.Parallel()
+
+	chest, triceps := int64(1), int64(4)
+	bench, pushdown := int64(10), int64(40)
+	multiPart := func(benchSets, pushdownSets []SetDTO) *WorkoutRecordDTO {
+		return &WorkoutRecordDTO{
+			PerformedDate: "2025-11-25",
+			Parts: []WorkoutPartGroupDTO{
+				{ID: chest, Exercises: []ExerciseDTO{{ID: &bench, Name: "ベンチプレス", WorkoutPartID: &chest, Sets: benchSets}}},
+				{ID: triceps, Exercises: []ExerciseDTO{{ID: &pushdown, Name: "プッシュダウン", Sets: pushdownSets}}},
+			},
+		}
+	}
+
+	t.Run("正常系: 複数の部位のグループをすべて1つのセッションにまとめる", func(t *testing.T) {
+		t.Parallel()
+
+		record, err := WorkoutRecordDTOToDomain(multiPart(
+			[]SetDTO{{SetNumber: 1, WeightKg: ptr(60.0), Reps: ptr(8)}, {SetNumber: 2, WeightKg: ptr(60.0), Reps: ptr(8)}},
+			[]SetDTO{{SetNumber: 1, WeightKg: ptr(20.0), Reps: ptr(12)}},
+		), util.TimeZone{}, dom.WeightUnits{})
+		require.NoError(t, err)
+		require.Len(t, record.Sets, 3)
+		require.Len(t, record.SetsOf(dw.ID(bench)), 2)
+		require.Len(t, record.SetsOf(dw.ID(pushdown)), 1)
+		// 種目に部位の指定がなければグループの部位を使う
+		require.Equal(t, dw.ID(triceps), *record.SetsOf(dw.ID(pushdown))[0].Exercise.PartID)
+	})
+
+	t.Run("正常系: partsが空ならセットなしのセッションにする", func(t *testing.T) {
+		t.Parallel()
+
+		record, err := WorkoutRecordDTOToDomain(&WorkoutRecordDTO{PerformedDate: "2025-11-25"}, util.TimeZone{}, dom.WeightUnits{})
+		require.NoError(t, err)
+		require.Empty(t, record.Sets)
+	})
+
+	t.Run("異常系: 不正なセットは最初の1件で止めず種目ごとにすべて返す", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := WorkoutRecordDTOToDomain(multiPart(
+			[]SetDTO{{SetNumber: 1, WeightKg: ptr(-5.0), Reps: ptr(8)}, {SetNumber: 2, WeightKg: ptr(60.0), Reps: ptr(8)}},
+			[]SetDTO{{SetNumber: 1, Kind: "bodyweight"}, {SetNumber: 2, WeightKg: ptr(20.0), Reps: ptr(12), RPE: ptr(11.0)}},
+		), util.TimeZone{}, dom.WeightUnits{})
+
+		var verr *WorkoutValidationError
+		require.ErrorAs(t, err, &verr)
+		require.ErrorIs(t, err, dw.ErrInvalidSet)
+		require.Len(t, verr.Errors, 2)
+
+		require.Equal(t, 0, verr.Errors[0].PartIndex)
+		require.Equal(t, bench, *verr.Errors[0].ExerciseID)
+		require.Equal(t, 1, *verr.Errors[0].SetNumber)
+
+		require.Equal(t, 1, verr.Errors[1].PartIndex)
+		require.Equal(t, 0, verr.Errors[1].ExerciseIndex)
+		require.Equal(t, "プッシュダウン", verr.Errors[1].Name)
+		require.Equal(t, 2, *verr.Errors[1].SetNumber)
+		require.Contains(t, verr.Errors[1].Message, "rpe")
+	})
+
+	t.Run("異常系: 同じ種目が複数のグループにありセット番号が重複する場合はエラーを返す", func(t *testing.T) {
+		t.Parallel()
+
+		dto := multiPart([]SetDTO{{SetNumber: 1, WeightKg: ptr(60.0), Reps: ptr(8)}}, nil)
+		dto.Parts[1].Exercises[0].ID = &bench
+		dto.Parts[1].Exercises[0].Sets = []SetDTO{{SetNumber: 1, WeightKg: ptr(50.0), Reps: ptr(10)}}
+
+		_, err := WorkoutRecordDTOToDomain(dto, util.TimeZone{}, dom.WeightUnits{})
+		var verr *WorkoutValidationError
+		require.ErrorAs(t, err, &verr)
+		require.Len(t, verr.Errors, 1)
+		require.Equal(t, 1, verr.Errors[0].PartIndex)
+	})
+
+	t.Run("異常系: セットのある種目にIDも名前もない場合はエラーを返す", func(t *testing.T) {
+		t.Parallel()
+
+		dto := multiPart(nil, []SetDTO{{SetNumber: 1, WeightKg: ptr(20.0), Reps: ptr(12)}})
+		dto.Parts[1].Exercises[0].ID = nil
+		dto.Parts[1].Exercises[0].Name = " "
+
+		_, err := WorkoutRecordDTOToDomain(dto, util.TimeZone{}, dom.WeightUnits{})
+		var verr *WorkoutValidationError
+		require.ErrorAs(t, err, &verr)
+		require.Len(t, verr.Errors, 1)
+		require.Nil(t, verr.Errors[0].SetNumber)
+	})
+}
+
+// newRecord は1種目だけのセッションを作る
+func newRecord(sets ...SetDTO) *WorkoutRecordDTO {
+	exerciseID := int64(10)
+	partID := int64(1)
+	return &WorkoutRecordDTO{
+		PerformedDate: "2025-11-25",
+		Parts: []WorkoutPartGroupDTO{{
+			ID: partID,
+			Exercises: []ExerciseDTO{{
+				ID:            &exerciseID,
+				Name:          "exercise",
+				WorkoutPartID: &partID,
+				Sets:          sets,
+			}},
+		}},
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestWorkoutRecordDTOToDomain_WeightUnits(t *testing.T) {
+	t.Parallel()
+
+	lb := dom.WeightUnits{Unit: dom.WeightUnitLb}
+
+	t.Run("正常系: 表示単位の重量はkgに変換して保存し、出力でkgと表示単位の両方を返す", func(t *testing.T) {
+		t.Parallel()
+
+		record, err := WorkoutRecordDTOToDomain(newRecord(
+			SetDTO{SetNumber: 1, Weight: ptr(135.0), Reps: ptr(5), Bodyweight: ptr(180.0)},
+		), util.TimeZone{}, lb)
+		require.NoError(t, err)
+		require.Equal(t, dw.WeightKg(61.23), record.Sets[0].Weight)
+		require.Equal(t, dw.WeightKg(81.65), *record.Sets[0].BodyweightKg)
+
+		got := SetToDTO(record.Sets[0], util.TimeZone{}, lb)
+		require.Equal(t, 61.23, *got.WeightKg)
+		require.Equal(t, 135.0, *got.Weight)
+		require.Equal(t, 180.0, *got.Bodyweight)
+
+		out := WorkoutDomainToDTO(record, "ja", util.TimeZone{}, lb)
+		require.Equal(t, "lb", out.Units.Unit)
+		require.Equal(t, 5.0, out.Units.Increment)
+	})
+
+	t.Run("正常系: weight_kg があれば表示単位の値より優先する", func(t *testing.T) {
+		t.Parallel()
+
+		record, err := WorkoutRecordDTOToDomain(newRecord(
+			SetDTO{SetNumber: 1, WeightKg: ptr(60.0), Weight: ptr(135.0), Reps: ptr(5)},
+		), util.TimeZone{}, lb)
+		require.NoError(t, err)
+		require.Equal(t, dw.WeightKg(60), record.Sets[0].Weight)
+	})
+
+	t.Run("正常系: 単位が未設定ならkgとして扱う", func(t *testing.T) {
+		t.Parallel()
+
+		record, err := WorkoutRecordDTOToDomain(newRecord(
+			SetDTO{SetNumber: 1, Weight: ptr(60.0), Reps: ptr(5)},
+		), util.TimeZone{}, dom.WeightUnits{})
+		require.NoError(t, err)
+		require.Equal(t, dw.WeightKg(60), record.Sets[0].Weight)
+
+		out := WorkoutDomainToDTO(record, "ja", util.TimeZone{}, dom.WeightUnits{})
+		require.Equal(t, "kg", out.Units.Unit)
+		require.Equal(t, 2.5, out.Units.Increment)
+	})
+}
@@ -0,0 +1,26 @@
+package handler
+
+import (
+	"errors"
+
+	"gogym-api/internal/adapter/validation"
+
+	"github.com/labstack/echo/v4"
+)
+
+// bindRequest はリクエストを req に読み込み、validate タグの規則で検証する
+// 規則を満たさない項目はリクエストのロケールのメッセージで返す（400）
+func bindRequest(c echo.Context, req any) error {
+	if err := c.Bind(req); err != nil {
+		return invalidRequest(err)
+	}
+	if err := c.Validate(req); err != nil {
+		var verr *validation.Error
+		if errors.As(err, &verr) {
+			locale := localeOf(c)
+			return verr.Localize(locale)
+		}
+		return err
+	}
+	return nil
+}
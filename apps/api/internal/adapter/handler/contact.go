@@ -1,8 +1,12 @@
 package handler
 
 import (
+	"fmt"
 	"gogym-api/internal/application/contact"
-	"log/slog"
+	"net/http"
+	"strconv"
+
+	dom "gogym-api/internal/domain/entities"
 
 	"github.com/labstack/echo/v4"
 )
@@ -18,35 +22,95 @@ func NewContactHandler(cu contact.ContactUseCase) *ContactHandler {
 }
 
 type ContactRequest struct {
-	Email   string `json:"email"`
-	Message string `json:"message"`
+	Email        string `json:"email" validate:"required,email,max=255"`
+	Message      string `json:"message" validate:"required,max=2000"`
+	Website      string `json:"website"` // ハニーポット（フォーム上は非表示）
+	FormToken    string `json:"form_token"`
+	CaptchaToken string `json:"captcha_token"`
+}
+
+// GET /api/v1/contact/token
+func (h *ContactHandler) GetContactToken(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	response, err := h.cu.IssueFormToken(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to issue contact form token: %w", err)
+	}
+
+	return c.JSON(http.StatusOK, response)
 }
 
 func (h *ContactHandler) PostContact(c echo.Context) error {
 	ctx := c.Request().Context()
-	slog.InfoContext(ctx, "PostContact Handler")
 
 	ip := c.RealIP()
 	ua := c.Request().UserAgent()
 
 	var req ContactRequest
-	if err := c.Bind(&req); err != nil {
-		return echo.NewHTTPError(400, "invalid request body")
+	if err := bindRequest(c, &req); err != nil {
+		return err
 	}
 
-	// ユーザーIDはnil許容なので、存在しない場合もある
+	// ユーザーIDは OptionalAuthMiddleware がログイン中の場合だけ設定する（未ログインなら nil）
 	userID, _ := c.Get("user_id").(string)
 	var userIDPtr *string
 	if userID != "" {
 		userIDPtr = &userID
 	}
 
-	err := h.cu.SendContact(ctx, req.Email, req.Message, userIDPtr, ip, ua)
+	// 問い合わせは保存された時点で受け付け完了（Slack通知は非同期）
+	err := h.cu.SendContact(ctx, contact.SendContactInput{
+		Email:        req.Email,
+		Message:      req.Message,
+		UserID:       userIDPtr,
+		IP:           ip,
+		UserAgent:    ua,
+		Honeypot:     req.Website,
+		FormToken:    req.FormToken,
+		CaptchaToken: req.CaptchaToken,
+	})
 	if err != nil {
-		// エラーログは出力するが、通知失敗でもユーザーには成功を返す
-		// （問い合わせ自体は受け付けたとみなす）
-		c.Logger().Error("failed to send contact notification:", err)
+		// 入力・スパム対策の拒否（400）と送信回数の上限（429）は domain/entities/contact のエラーの種類で決まる
+		return fmt.Errorf("failed to save contact message: %w", err)
 	}
 
 	return c.NoContent(204)
 }
+
+// GET /api/v1/admin/contacts?status=open&limit=50&offset=0
+func (h *ContactHandler) ListContacts(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	limit, _ := strconv.Atoi(c.QueryParam("limit"))
+	offset, _ := strconv.Atoi(c.QueryParam("offset"))
+
+	response, err := h.cu.ListContacts(ctx, c.QueryParam("status"), limit, offset)
+	if err != nil {
+		return fmt.Errorf("failed to list contact messages: %w", err)
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// PUT /api/v1/admin/contacts/:id/resolve
+func (h *ContactHandler) ResolveContact(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	adminUserID, ok := c.Get("user_id").(string)
+	if !ok || adminUserID == "" {
+		return dom.ErrUnauthorized
+	}
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return dom.InvalidField("id", "invalid contact ID format")
+	}
+
+	response, err := h.cu.ResolveContact(ctx, id, adminUserID)
+	if err != nil {
+		return fmt.Errorf("failed to resolve contact message: %w", err)
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,139 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"gogym-api/internal/adapter/dto"
+	dom "gogym-api/internal/domain/entities"
+
+	"github.com/labstack/echo/v4"
+)
+
+// HTTPErrorHandler はハンドラ・ミドルウェアが返したエラーを RFC 7807 の problem+json にする
+// 型付きのエラー（domain.Error）は種類に応じたステータスに、それ以外は 500 にして内部のメッセージは返さない
+func HTTPErrorHandler(err error, c echo.Context) {
+	if c.Response().Committed {
+		return
+	}
+
+	problem := problemOf(err)
+	problem.Instance = c.Request().URL.Path
+	problem.RequestID = c.Response().Header().Get(echo.HeaderXRequestID)
+
+	// リクエストID・ユーザーID・ルートはコンテキストからログに付く
+	ctx := c.Request().Context()
+	if problem.Status >= http.StatusInternalServerError {
+		slog.ErrorContext(ctx, "Request failed",
+			"method", c.Request().Method, "status", problem.Status, "error", err)
+	} else {
+		slog.InfoContext(ctx, "Request rejected",
+			"method", c.Request().Method, "status", problem.Status, "code", problem.Code, "error", err)
+	}
+
+	// c.JSON は Content-Type が未設定の場合だけ application/json を付ける
+	c.Response().Header().Set(echo.HeaderContentType, dto.ProblemContentType)
+	if c.Request().Method == http.MethodHead {
+		err = c.NoContent(problem.Status)
+	} else {
+		err = c.JSON(problem.Status, problem)
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to write error response", "error", err)
+	}
+}
+
+// problemOf はエラーの種類からレスポンスのステータス・コード・詳細を決める
+func problemOf(err error) dto.ProblemDTO {
+	var workoutErr *dto.WorkoutValidationError
+	if errors.As(err, &workoutErr) {
+		// 形式は正しいが種目・セットの記録値が不正なリクエスト
+		return newProblem(http.StatusUnprocessableEntity, "invalid_workout_sets", "invalid workout sets", workoutErr.Errors)
+	}
+
+	var validationErr *dom.ValidationError
+	if errors.As(err, &validationErr) {
+		code := dom.ErrValidation.Code
+		if e, ok := dom.AsError(validationErr); ok {
+			code = e.Code
+		}
+		return newProblem(http.StatusBadRequest, code, validationErr.Error(), validationErr.Fields)
+	}
+
+	if e, ok := dom.AsError(err); ok {
+		return newProblem(statusOf(e.Kind), e.Code, detailOf(err, e), nil)
+	}
+
+	var httpErr *echo.HTTPError
+	if errors.As(err, &httpErr) {
+		// ルーティング（404・405）や Bind の失敗など Echo が返したエラー
+		detail := ""
+		if httpErr.Code < http.StatusInternalServerError {
+			detail = messageOf(httpErr.Message)
+		}
+		return newProblem(httpErr.Code, "", detail, nil)
+	}
+
+	return newProblem(http.StatusInternalServerError, "", "", nil)
+}
+
+// detailOf は型付きのエラーの詳細を返す
+// 呼び出し側が付けた "failed to ...: " などの前置きを除き、型付きのエラーのメッセージで始まる部分を使う
+func detailOf(err error, e *dom.Error) string {
+	for cur := err; cur != nil; cur = errors.Unwrap(cur) {
+		if msg := cur.Error(); strings.HasPrefix(msg, e.Message) {
+			return msg
+		}
+	}
+	return e.Message
+}
+
+func newProblem(status int, code, detail string, fieldErrors any) dto.ProblemDTO {
+	return dto.ProblemDTO{
+		Type:   "about:blank",
+		Title:  http.StatusText(status),
+		Status: status,
+		Detail: detail,
+		Code:   code,
+		Errors: fieldErrors,
+	}
+}
+
+// statusOf はエラーの種類を HTTP のステータスに対応付ける
+func statusOf(kind dom.ErrorKind) int {
+	switch kind {
+	case dom.KindValidation:
+		return http.StatusBadRequest
+	case dom.KindUnauthorized:
+		return http.StatusUnauthorized
+	case dom.KindForbidden:
+		return http.StatusForbidden
+	case dom.KindNotFound:
+		return http.StatusNotFound
+	case dom.KindConflict:
+		return http.StatusConflict
+	case dom.KindRateLimited:
+		return http.StatusTooManyRequests
+	}
+	return http.StatusInternalServerError
+}
+
+func messageOf(msg any) string {
+	switch m := msg.(type) {
+	case string:
+		return m
+	case error:
+		return m.Error()
+	case nil:
+		return ""
+	}
+	return fmt.Sprint(msg)
+}
+
+// invalidRequest は Bind に失敗したリクエストのエラー（JSON の形式・型の不一致）
+func invalidRequest(err error) error {
+	return echo.NewHTTPError(http.StatusBadRequest, "invalid request format").SetInternal(err)
+}
@@ -0,0 +1,104 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"gogym-api/internal/adapter/dto"
+	dom "gogym-api/internal/domain/entities"
+	dw "gogym-api/internal/domain/entities/workout"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPErrorHandler(t *testing.T) {
+	t.Parallel()
+
+	type problem struct {
+		Status    int               `json:"status"`
+		Title     string            `json:"title"`
+		Detail    string            `json:"detail"`
+		Instance  string            `json:"instance"`
+		Code      string            `json:"code"`
+		RequestID string            `json:"request_id"`
+		Errors    []json.RawMessage `json:"errors"`
+	}
+
+	run := func(err error) (*httptest.ResponseRecorder, problem) {
+		e := echo.New()
+		rec := httptest.NewRecorder()
+		c := e.NewContext(httptest.NewRequest(http.MethodGet, "/api/v1/workouts/records/1", nil), rec)
+		c.Response().Header().Set(echo.HeaderXRequestID, "rid-1")
+
+		HTTPErrorHandler(err, c)
+
+		var got problem
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+		return rec, got
+	}
+
+	t.Run("正常系: 型付きのエラーは種類に応じたステータスとコードになる", func(t *testing.T) {
+		t.Parallel()
+		rec, got := run(fmt.Errorf("failed to get workout record: %w", dw.ErrRecordNotFound))
+
+		require.Equal(t, http.StatusNotFound, rec.Code)
+		require.Equal(t, dto.ProblemContentType, rec.Header().Get(echo.HeaderContentType))
+		require.Equal(t, http.StatusNotFound, got.Status)
+		require.Equal(t, "Not Found", got.Title)
+		require.Equal(t, dw.ErrRecordNotFound.Code, got.Code)
+		require.Equal(t, dw.ErrRecordNotFound.Message, got.Detail, "呼び出し側の前置きは返さない")
+		require.Equal(t, "/api/v1/workouts/records/1", got.Instance)
+		require.Equal(t, "rid-1", got.RequestID)
+	})
+
+	t.Run("正常系: 詳細を付けたエラーは型付きのエラー以降のメッセージを返す", func(t *testing.T) {
+		t.Parallel()
+		rec, got := run(fmt.Errorf("%w: admin privileges required", dom.ErrForbidden))
+
+		require.Equal(t, http.StatusForbidden, rec.Code)
+		require.Equal(t, "forbidden: admin privileges required", got.Detail)
+	})
+
+	t.Run("正常系: 項目ごとの検証エラーは 400 で項目を返す", func(t *testing.T) {
+		t.Parallel()
+		rec, got := run(dom.InvalidField("id", "invalid workout record ID format"))
+
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+		require.Equal(t, dom.ErrValidation.Code, got.Code)
+		require.Len(t, got.Errors, 1)
+		require.JSONEq(t, `{"field":"id","message":"invalid workout record ID format"}`, string(got.Errors[0]))
+	})
+
+	t.Run("正常系: 種目ごとの検証エラーは 422 になる", func(t *testing.T) {
+		t.Parallel()
+		verr := &dto.WorkoutValidationError{Errors: []dto.ExerciseErrorDTO{{PartIndex: 0, ExerciseIndex: 1, Message: "reps must be positive"}}}
+		rec, got := run(verr)
+
+		require.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+		require.Equal(t, "invalid_workout_sets", got.Code)
+		require.Len(t, got.Errors, 1)
+	})
+
+	t.Run("正常系: Echo のエラーはステータスとメッセージを保つ", func(t *testing.T) {
+		t.Parallel()
+		rec, got := run(invalidRequest(errors.New("unexpected EOF")))
+
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+		require.Equal(t, "invalid request format", got.Detail)
+	})
+
+	t.Run("異常系: 型付きでないエラーは 500 で内部のメッセージを返さない", func(t *testing.T) {
+		t.Parallel()
+		rec, got := run(fmt.Errorf("failed to save workout record: %w", errors.New("pq: connection refused")))
+
+		require.Equal(t, http.StatusInternalServerError, rec.Code)
+		require.Empty(t, got.Detail)
+		require.Empty(t, got.Code)
+		require.NotContains(t, rec.Body.String(), "pq:")
+	})
+}
@@ -0,0 +1,29 @@
+package handler
+
+import (
+	dom "gogym-api/internal/domain/entities"
+	"gogym-api/internal/util"
+
+	"github.com/labstack/echo/v4"
+)
+
+// PreferencesMiddleware が Context に設定した表示設定を読むためのアクセサ
+// 未設定の場合はゼロ値（デフォルトロケール・デフォルトのタイムゾーン・kg）として扱われる
+
+// localeOf はレスポンスに使う表示ロケールを返す
+func localeOf(c echo.Context) string {
+	locale, _ := c.Get("locale").(string)
+	return locale
+}
+
+// zoneOf は日付・時刻の入出力に使うタイムゾーンを返す
+func zoneOf(c echo.Context) util.TimeZone {
+	zone, _ := c.Get("time_zone").(util.TimeZone)
+	return zone
+}
+
+// unitsOf は重量の入出力に使う単位と丸め幅を返す
+func unitsOf(c echo.Context) dom.WeightUnits {
+	units, _ := c.Get("weight_units").(dom.WeightUnits)
+	return units
+}
@@ -0,0 +1,274 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"gogym-api/internal/adapter/dto"
+	"net/http"
+
+	pu "gogym-api/internal/application/program"
+	dom "gogym-api/internal/domain/entities"
+	dt "gogym-api/internal/domain/entities/template"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ProgramHandler struct {
+	pu pu.ProgramUseCase
+}
+
+func NewProgramHandler(pu pu.ProgramUseCase) *ProgramHandler {
+	return &ProgramHandler{
+		pu: pu,
+	}
+}
+
+// GET /api/v1/workouts/programs
+func (h *ProgramHandler) ListPrograms(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok || userID == "" {
+		return dom.ErrUnauthorized
+	}
+
+	locale := localeOf(c)
+
+	programs, err := h.pu.ListPrograms(ctx, userID, locale)
+	if err != nil {
+		return programError(err)
+	}
+
+	return c.JSON(http.StatusOK, programs)
+}
+
+// GET /api/v1/workouts/programs/:id
+func (h *ProgramHandler) GetProgram(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok || userID == "" {
+		return dom.ErrUnauthorized
+	}
+
+	locale := localeOf(c)
+
+	var programID int64
+	if _, err := fmt.Sscanf(c.Param("id"), "%d", &programID); err != nil {
+		return dom.InvalidField("id", "invalid program ID format")
+	}
+
+	program, err := h.pu.GetProgram(ctx, userID, programID, locale)
+	if err != nil {
+		return programError(err)
+	}
+
+	return c.JSON(http.StatusOK, program)
+}
+
+// POST /api/v1/workouts/programs
+func (h *ProgramHandler) CreateProgram(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok || userID == "" {
+		return dom.ErrUnauthorized
+	}
+
+	locale := localeOf(c)
+
+	var req dto.SaveProgramRequest
+	if err := bindRequest(c, &req); err != nil {
+		return err
+	}
+
+	program, err := h.pu.CreateProgram(ctx, userID, req, locale)
+	if err != nil {
+		return programError(err)
+	}
+
+	return c.JSON(http.StatusCreated, program)
+}
+
+// PUT /api/v1/workouts/programs/:id
+func (h *ProgramHandler) UpdateProgram(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok || userID == "" {
+		return dom.ErrUnauthorized
+	}
+
+	locale := localeOf(c)
+
+	var programID int64
+	if _, err := fmt.Sscanf(c.Param("id"), "%d", &programID); err != nil {
+		return dom.InvalidField("id", "invalid program ID format")
+	}
+
+	var req dto.SaveProgramRequest
+	if err := bindRequest(c, &req); err != nil {
+		return err
+	}
+
+	program, err := h.pu.UpdateProgram(ctx, userID, programID, req, locale)
+	if err != nil {
+		return programError(err)
+	}
+
+	return c.JSON(http.StatusOK, program)
+}
+
+// DELETE /api/v1/workouts/programs/:id
+func (h *ProgramHandler) DeleteProgram(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok || userID == "" {
+		return dom.ErrUnauthorized
+	}
+
+	var programID int64
+	if _, err := fmt.Sscanf(c.Param("id"), "%d", &programID); err != nil {
+		return dom.InvalidField("id", "invalid program ID format")
+	}
+
+	if err := h.pu.DeleteProgram(ctx, userID, programID); err != nil {
+		return programError(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// POST /api/v1/workouts/programs/:id/enroll
+// プログラムを開始日から開始する（進行中のプログラムは終了する）
+func (h *ProgramHandler) EnrollProgram(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok || userID == "" {
+		return dom.ErrUnauthorized
+	}
+
+	var programID int64
+	if _, err := fmt.Sscanf(c.Param("id"), "%d", &programID); err != nil {
+		return dom.InvalidField("id", "invalid program ID format")
+	}
+
+	var req dto.EnrollProgramRequest
+	if err := bindRequest(c, &req); err != nil {
+		return err
+	}
+
+	zone := zoneOf(c)
+
+	startDate, err := zone.ParseDate(req.StartDate)
+	if err != nil {
+		return dom.InvalidField("start_date", "invalid date format")
+	}
+
+	enrollment, err := h.pu.Enroll(ctx, userID, programID, startDate)
+	if err != nil {
+		return programError(err)
+	}
+
+	return c.JSON(http.StatusCreated, enrollment)
+}
+
+// GET /api/v1/workouts/programs/enrollment
+// 進行中のプログラム
+func (h *ProgramHandler) GetEnrollment(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok || userID == "" {
+		return dom.ErrUnauthorized
+	}
+
+	enrollment, err := h.pu.GetEnrollment(ctx, userID)
+	if err != nil {
+		return programError(err)
+	}
+
+	return c.JSON(http.StatusOK, enrollment)
+}
+
+// DELETE /api/v1/workouts/programs/enrollment
+// 進行中のプログラムを終了する
+func (h *ProgramHandler) EndEnrollment(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok || userID == "" {
+		return dom.ErrUnauthorized
+	}
+
+	if err := h.pu.EndEnrollment(ctx, userID); err != nil {
+		return programError(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// GET /api/v1/workouts/programs/today?date=YYYY-MM-DD
+// 進行中のプログラムのその日の予定（省略時は今日）
+func (h *ProgramHandler) GetProgramToday(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok || userID == "" {
+		return dom.ErrUnauthorized
+	}
+
+	locale := localeOf(c)
+
+	zone := zoneOf(c)
+
+	date, err := zone.ParseDateOrToday(c.QueryParam("date"))
+	if err != nil {
+		return dom.InvalidField("date", "invalid date format")
+	}
+
+	units := unitsOf(c)
+
+	today, err := h.pu.GetToday(ctx, userID, date, locale, units)
+	if err != nil {
+		return programError(err)
+	}
+
+	return c.JSON(http.StatusOK, today)
+}
+
+// GET /api/v1/workouts/programs/adherence?date=YYYY-MM-DD
+// 進行中のプログラムのその日までの実施状況（省略時は今日）
+func (h *ProgramHandler) GetAdherence(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok || userID == "" {
+		return dom.ErrUnauthorized
+	}
+
+	zone := zoneOf(c)
+
+	asOf, err := zone.ParseDateOrToday(c.QueryParam("date"))
+	if err != nil {
+		return dom.InvalidField("date", "invalid date format")
+	}
+
+	adherence, err := h.pu.GetAdherence(ctx, userID, asOf)
+	if err != nil {
+		return programError(err)
+	}
+
+	return c.JSON(http.StatusOK, adherence)
+}
+
+// programError はプログラムの操作のエラーを返す
+// プログラムの日程が参照するテンプレートがない場合はリクエストの不正として扱う
+func programError(err error) error {
+	if errors.Is(err, dt.ErrTemplateNotFound) {
+		return dom.InvalidField("template_id", "workout template not found")
+	}
+	return err
+}
@@ -1,9 +1,9 @@
 package handler
 
 import (
+	"fmt"
 	"gogym-api/internal/adapter/dto"
 	su "gogym-api/internal/application/session"
-	"log/slog"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
@@ -21,22 +21,19 @@ func (h *SessionHandler) Login(c echo.Context) error {
 	ctx := c.Request().Context()
 
 	var req dto.LoginRequest
-	if err := c.Bind(&req); err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid request"})
+	if err := bindRequest(c, &req); err != nil {
+		return err
 	}
 
-	// User認証
-	err := h.su.Login(ctx, req)
-	if err != nil {
-		slog.Error("Login failed", "email", req.Email, "error", err.Error())
-		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "invalid credentials"})
+	// User認証（メールアドレス・パスワードの不一致は su.ErrInvalidCredentials）
+	if err := h.su.Login(ctx, req); err != nil {
+		return err
 	}
 
 	// Session作成
 	tokens, err := h.su.CreateSession(ctx, req.Email)
 	if err != nil {
-		slog.Error("Failed to create session", "email", req.Email, "error", err.Error())
-		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to create session"})
+		return fmt.Errorf("failed to create session: %w", err)
 	}
 
 	return c.JSON(http.StatusOK, tokens)
@@ -46,15 +43,14 @@ func (h *SessionHandler) RefreshToken(c echo.Context) error {
 	ctx := c.Request().Context()
 
 	var req dto.RefreshRequest
-	if err := c.Bind(&req); err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid request"})
+	if err := bindRequest(c, &req); err != nil {
+		return err
 	}
 
-	// トークンリフレッシュ
+	// トークンリフレッシュ（不正・期限切れは su.ErrInvalidRefreshToken）
 	tokens, err := h.su.RefreshToken(ctx, req.RefreshToken)
 	if err != nil {
-		slog.Error("Token refresh failed", "error", err.Error())
-		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "invalid or expired refresh token"})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, tokens)
@@ -0,0 +1,198 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"gogym-api/internal/adapter/dto"
+	"net/http"
+
+	tu "gogym-api/internal/application/template"
+	dom "gogym-api/internal/domain/entities"
+	dw "gogym-api/internal/domain/entities/workout"
+
+	"github.com/labstack/echo/v4"
+)
+
+type TemplateHandler struct {
+	tu tu.TemplateUseCase
+}
+
+func NewTemplateHandler(tu tu.TemplateUseCase) *TemplateHandler {
+	return &TemplateHandler{
+		tu: tu,
+	}
+}
+
+// GET /api/v1/workouts/templates
+func (h *TemplateHandler) ListTemplates(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok || userID == "" {
+		return dom.ErrUnauthorized
+	}
+
+	locale := localeOf(c)
+
+	units := unitsOf(c)
+
+	templates, err := h.tu.ListTemplates(ctx, userID, locale, units)
+	if err != nil {
+		return templateError(err)
+	}
+
+	return c.JSON(http.StatusOK, templates)
+}
+
+// GET /api/v1/workouts/templates/:id
+func (h *TemplateHandler) GetTemplate(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok || userID == "" {
+		return dom.ErrUnauthorized
+	}
+
+	locale := localeOf(c)
+
+	var templateID int64
+	if _, err := fmt.Sscanf(c.Param("id"), "%d", &templateID); err != nil {
+		return dom.InvalidField("id", "invalid template ID format")
+	}
+
+	units := unitsOf(c)
+
+	template, err := h.tu.GetTemplate(ctx, userID, templateID, locale, units)
+	if err != nil {
+		return templateError(err)
+	}
+
+	return c.JSON(http.StatusOK, template)
+}
+
+// POST /api/v1/workouts/templates
+func (h *TemplateHandler) CreateTemplate(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok || userID == "" {
+		return dom.ErrUnauthorized
+	}
+
+	locale := localeOf(c)
+
+	var req dto.SaveTemplateRequest
+	if err := bindRequest(c, &req); err != nil {
+		return err
+	}
+
+	units := unitsOf(c)
+
+	template, err := h.tu.CreateTemplate(ctx, userID, req, locale, units)
+	if err != nil {
+		return templateError(err)
+	}
+
+	return c.JSON(http.StatusCreated, template)
+}
+
+// PUT /api/v1/workouts/templates/:id
+func (h *TemplateHandler) UpdateTemplate(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok || userID == "" {
+		return dom.ErrUnauthorized
+	}
+
+	locale := localeOf(c)
+
+	var templateID int64
+	if _, err := fmt.Sscanf(c.Param("id"), "%d", &templateID); err != nil {
+		return dom.InvalidField("id", "invalid template ID format")
+	}
+
+	var req dto.SaveTemplateRequest
+	if err := bindRequest(c, &req); err != nil {
+		return err
+	}
+
+	units := unitsOf(c)
+
+	template, err := h.tu.UpdateTemplate(ctx, userID, templateID, req, locale, units)
+	if err != nil {
+		return templateError(err)
+	}
+
+	return c.JSON(http.StatusOK, template)
+}
+
+// DELETE /api/v1/workouts/templates/:id
+func (h *TemplateHandler) DeleteTemplate(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok || userID == "" {
+		return dom.ErrUnauthorized
+	}
+
+	var templateID int64
+	if _, err := fmt.Sscanf(c.Param("id"), "%d", &templateID); err != nil {
+		return dom.InvalidField("id", "invalid template ID format")
+	}
+
+	if err := h.tu.DeleteTemplate(ctx, userID, templateID); err != nil {
+		return templateError(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// POST /api/v1/workouts/templates/:id/start
+// テンプレートから前回の重量を引き継いだセッションを作成する
+func (h *TemplateHandler) StartWorkout(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok || userID == "" {
+		return dom.ErrUnauthorized
+	}
+
+	locale := localeOf(c)
+
+	var templateID int64
+	if _, err := fmt.Sscanf(c.Param("id"), "%d", &templateID); err != nil {
+		return dom.InvalidField("id", "invalid template ID format")
+	}
+
+	var req dto.StartTemplateRequest
+	if err := bindRequest(c, &req); err != nil {
+		return err
+	}
+
+	zone := zoneOf(c)
+
+	base, err := dto.StartTemplateRequestToDomain(req, zone)
+	if err != nil {
+		return dom.InvalidInput(err)
+	}
+	base.UserID = dom.ULID(userID)
+
+	units := unitsOf(c)
+
+	record, err := h.tu.StartWorkout(ctx, templateID, *base, locale, zone, units)
+	if err != nil {
+		return templateError(err)
+	}
+
+	return c.JSON(http.StatusCreated, record)
+}
+
+// templateError はテンプレートの操作のエラーを返す
+// テンプレートの種目が存在しない場合はリクエストの不正として扱う
+func templateError(err error) error {
+	if errors.Is(err, dw.ErrExerciseNotFound) {
+		return dom.InvalidField("exercises", "workout exercise not found")
+	}
+	return err
+}
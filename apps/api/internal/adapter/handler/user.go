@@ -2,10 +2,10 @@ package handler
 
 import (
 	"gogym-api/internal/adapter/dto"
-	"log/slog"
 	"net/http"
 
 	uu "gogym-api/internal/application/user"
+	dom "gogym-api/internal/domain/entities"
 
 	"github.com/labstack/echo/v4"
 )
@@ -23,18 +23,54 @@ func NewUserHandler(uu uu.UserUseCase) *UserHandler {
 // POST /api/v1/user
 func (h *UserHandler) SignUp(c echo.Context) error {
 	ctx := c.Request().Context()
-	slog.InfoContext(ctx, "SignUp Handler")
 	var req dto.SignUpRequest
-	if err := c.Bind(&req); err != nil {
-		slog.ErrorContext(ctx, "Failed to bind request", "error", err)
-		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	if err := bindRequest(c, &req); err != nil {
+		return err
 	}
 
-	err := h.uu.SignUp(ctx, req)
-	if err != nil {
-		slog.ErrorContext(ctx, "Failed to sign up user", "error", err)
-		return c.JSON(http.StatusConflict, err.Error())
+	// 登録済みのメールアドレスは uu.ErrEmailAlreadyExists（409）
+	if err := h.uu.SignUp(ctx, req); err != nil {
+		return err
 	}
 
 	return c.NoContent(http.StatusCreated)
 }
+
+// GET /api/v1/users/me/preferences
+func (h *UserHandler) GetPreferences(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok || userID == "" {
+		return dom.ErrUnauthorized
+	}
+
+	response, err := h.uu.GetPreferences(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// PUT /api/v1/users/me/preferences
+func (h *UserHandler) UpdatePreferences(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok || userID == "" {
+		return dom.ErrUnauthorized
+	}
+
+	var req dto.UpdateUserPreferencesRequest
+	if err := bindRequest(c, &req); err != nil {
+		return err
+	}
+
+	response, err := h.uu.UpdatePreferences(ctx, userID, req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
@@ -1,14 +1,17 @@
 package handler
 
 import (
+	"errors"
 	"fmt"
 	"gogym-api/internal/adapter/dto"
-	"gogym-api/internal/util"
-	"log/slog"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	wu "gogym-api/internal/application/workout"
 	dom "gogym-api/internal/domain/entities"
+	dw "gogym-api/internal/domain/entities/workout"
 
 	"github.com/labstack/echo/v4"
 )
@@ -25,25 +28,55 @@ func NewWorkoutHandler(wu wu.WorkoutUseCase) *WorkoutHandler {
 
 func (h *WorkoutHandler) GetWorkoutRecords(c echo.Context) error {
 	ctx := c.Request().Context()
-	slog.InfoContext(ctx, "GetWorkoutRecords Handler")
 
 	userID, ok := c.Get("user_id").(string)
 	if !ok || userID == "" {
-		slog.ErrorContext(ctx, "User ID not found in context")
-		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
+		return dom.ErrUnauthorized
 	}
 
+	locale := localeOf(c)
+
 	dateStr := c.QueryParam("date")
-	date, err := util.ParseJSTDateOrToday(dateStr)
+	zone := zoneOf(c)
+
+	date, err := zone.ParseDateOrToday(dateStr)
+	if err != nil {
+		return dom.InvalidField("date", "invalid date format")
+	}
+
+	units := unitsOf(c)
+
+	response, err := h.wu.GetWorkoutRecords(ctx, userID, date, locale, zone, units)
 	if err != nil {
-		slog.ErrorContext(ctx, "Failed to parse date", "dateStr", dateStr, "error", err)
-		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid date format"})
+		return fmt.Errorf("failed to get workout records: %w", err)
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// GET /api/v1/workouts/records/:id
+func (h *WorkoutHandler) GetWorkoutRecord(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok || userID == "" {
+		return dom.ErrUnauthorized
 	}
 
-	response, err := h.wu.GetWorkoutRecords(ctx, userID, date)
+	locale := localeOf(c)
+
+	var recordID int64
+	if _, err := fmt.Sscanf(c.Param("id"), "%d", &recordID); err != nil {
+		return dom.InvalidField("id", "invalid record ID format")
+	}
+
+	zone := zoneOf(c)
+
+	units := unitsOf(c)
+
+	response, err := h.wu.GetWorkoutRecord(ctx, userID, recordID, locale, zone, units)
 	if err != nil {
-		slog.ErrorContext(ctx, "Failed to get workout records", "userID", userID, "date", date, "error", err)
-		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+		return fmt.Errorf("failed to get workout record: %w", err)
 	}
 
 	return c.JSON(http.StatusOK, response)
@@ -51,26 +84,23 @@ func (h *WorkoutHandler) GetWorkoutRecords(c echo.Context) error {
 
 func (h *WorkoutHandler) CreateWorkoutRecord(c echo.Context) error {
 	ctx := c.Request().Context()
-	slog.InfoContext(ctx, "CreateWorkoutRecord Handler")
 
 	userID, ok := c.Get("user_id").(string)
 	if !ok || userID == "" {
-		slog.ErrorContext(ctx, "User ID not found in context")
-		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
+		return dom.ErrUnauthorized
 	}
 
 	var req dto.WorkoutRecordDTO
-	err := c.Bind(&req)
-	if err != nil {
-		slog.ErrorContext(ctx, "Failed to bind request", "error", err)
-		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	if err := bindRequest(c, &req); err != nil {
+		return err
 	}
 
-	// dto → domain変換
-	domainRecord, err := dto.WorkoutRecordDTOToDomain(&req)
+	// dto → domain変換（時刻はユーザーのタイムゾーン、重量はユーザーの単位として変換する）
+	zone := zoneOf(c)
+	units := unitsOf(c)
+	domainRecord, err := dto.WorkoutRecordDTOToDomain(&req, zone, units)
 	if err != nil {
-		slog.ErrorContext(ctx, "Failed to convert DTO to domain model", "error", err)
-		return c.JSON(http.StatusBadRequest, map[string]string{"error": fmt.Sprintf("Invalid request data: %v", err)})
+		return recordInputError(err)
 	}
 
 	// Set userID
@@ -80,66 +110,75 @@ func (h *WorkoutHandler) CreateWorkoutRecord(c echo.Context) error {
 	if req.GymName != nil && *req.GymName != "" {
 		gymID, err := h.wu.ResolveGymIDFromName(ctx, userID, *req.GymName)
 		if err != nil {
-			slog.ErrorContext(ctx, "Failed to resolve gym_id from gym_name", "userID", userID, "gymName", *req.GymName, "error", err)
-			return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to resolve gym"})
+			return fmt.Errorf("failed to resolve gym_id from gym_name: %w", err)
 		}
 		domainRecord.GymID = &gymID
 	}
 
-	err = h.wu.CreateWorkoutRecord(ctx, *domainRecord)
+	recordID, err := h.wu.CreateWorkoutRecord(ctx, *domainRecord)
 	if err != nil {
-		slog.ErrorContext(ctx, "Failed to create workout record", "userID", userID, "error", err)
-		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+		return fmt.Errorf("failed to create workout record: %w", err)
 	}
 
-	return c.JSON(http.StatusCreated, map[string]string{"message": "Workout record created successfully"})
+	return c.JSON(http.StatusCreated, map[string]interface{}{
+		"id":      recordID,
+		"message": "Workout record created successfully",
+	})
+}
+
+// recordInputError はセッションの保存リクエストの変換エラーを返す
+// 種目ごとの検証エラー（*dto.WorkoutValidationError、422）以外は日付・時刻などの形式エラーとして扱う
+func recordInputError(err error) error {
+	var verr *dto.WorkoutValidationError
+	if errors.As(err, &verr) {
+		return err
+	}
+	return dom.InvalidInput(err)
 }
 
 func (h *WorkoutHandler) UpdateWorkoutRecord(c echo.Context) error {
 	ctx := c.Request().Context()
-	slog.InfoContext(ctx, "UpdateWorkoutRecord Handler")
 
 	userID, ok := c.Get("user_id").(string)
 	if !ok || userID == "" {
-		slog.ErrorContext(ctx, "User ID not found in context")
-		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
+		return dom.ErrUnauthorized
 	}
 
-	// パスパラメータから record ID を取得（使用しない可能性もあるが、一応取得）
-	// recordID := c.Param("id")
+	var recordID int64
+	if _, err := fmt.Sscanf(c.Param("id"), "%d", &recordID); err != nil {
+		return dom.InvalidField("id", "invalid record ID format")
+	}
 
 	var req dto.WorkoutRecordDTO
-	err := c.Bind(&req)
-	if err != nil {
-		slog.ErrorContext(ctx, "Failed to bind request", "error", err)
-		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	if err := bindRequest(c, &req); err != nil {
+		return err
 	}
 
-	// dto → domain変換
-	domainRecord, err := dto.WorkoutRecordDTOToDomain(&req)
+	// dto → domain変換（時刻はユーザーのタイムゾーン、重量はユーザーの単位として変換する）
+	zone := zoneOf(c)
+	units := unitsOf(c)
+	domainRecord, err := dto.WorkoutRecordDTOToDomain(&req, zone, units)
 	if err != nil {
-		slog.ErrorContext(ctx, "Failed to convert DTO to domain model", "error", err)
-		return c.JSON(http.StatusBadRequest, map[string]string{"error": fmt.Sprintf("Invalid request data: %v", err)})
+		return recordInputError(err)
 	}
 
-	// Set userID
+	// Set userID / record ID（更新対象はパスパラメータで指定）
 	domainRecord.UserID = dom.ULID(userID)
+	id := dom.ID(recordID)
+	domainRecord.ID = &id
 
 	// gym_name から gym_id を解決（gym_name優先、なければgym_idをそのまま使用）
 	if req.GymName != nil && *req.GymName != "" {
 		gymID, err := h.wu.ResolveGymIDFromName(ctx, userID, *req.GymName)
 		if err != nil {
-			slog.ErrorContext(ctx, "Failed to resolve gym_id from gym_name", "userID", userID, "gymName", *req.GymName, "error", err)
-			return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to resolve gym"})
+			return fmt.Errorf("failed to resolve gym_id from gym_name: %w", err)
 		}
 		domainRecord.GymID = &gymID
 	}
 
-	// Upsert を使用（同日同部位の場合は更新、異なる場合は新規作成）
-	err = h.wu.UpsertWorkoutRecord(ctx, *domainRecord)
+	err = h.wu.UpdateWorkoutRecord(ctx, *domainRecord)
 	if err != nil {
-		slog.ErrorContext(ctx, "Failed to update workout record", "userID", userID, "error", err)
-		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+		return fmt.Errorf("failed to update workout record: %w", err)
 	}
 
 	return c.JSON(http.StatusOK, map[string]string{"message": "Workout record updated successfully"})
@@ -147,38 +186,88 @@ func (h *WorkoutHandler) UpdateWorkoutRecord(c echo.Context) error {
 
 func (h *WorkoutHandler) GetWorkoutParts(c echo.Context) error {
 	ctx := c.Request().Context()
-	slog.InfoContext(ctx, "GetWorkoutParts Handler")
 
 	userID, ok := c.Get("user_id").(string)
 	if !ok || userID == "" {
-		slog.ErrorContext(ctx, "User ID not found in context")
-		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
+		return dom.ErrUnauthorized
+	}
+
+	locale := localeOf(c)
+
+	filter, err := parseWorkoutPartsFilter(c)
+	if err != nil {
+		return dom.InvalidInput(err)
 	}
 
-	parts, err := h.wu.GetWorkoutParts(ctx, userID)
+	units := unitsOf(c)
+
+	parts, err := h.wu.GetWorkoutParts(ctx, userID, filter, locale, units)
 	if err != nil {
-		slog.ErrorContext(ctx, "Failed to get workout parts", "userID", userID, "error", err)
-		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+		return fmt.Errorf("failed to get workout parts: %w", err)
 	}
 
-	slog.InfoContext(ctx, "Successfully retrieved workout parts", "userID", userID, "count", len(parts))
 	return c.JSON(http.StatusOK, parts)
 }
 
+// parseWorkoutPartsFilter は種目一覧の絞り込み条件をクエリから読み取る
+// include_hidden=true で非表示にしたプリセット種目も返す
+// equipment / movement_pattern / muscle はカンマ区切りで複数指定でき、unilateral は true / false
+func parseWorkoutPartsFilter(c echo.Context) (wu.WorkoutPartsFilter, error) {
+	filter := wu.WorkoutPartsFilter{IncludeHidden: c.QueryParam("include_hidden") == "true"}
+
+	for _, v := range splitQueryList(c.QueryParam("equipment")) {
+		eq := dw.Equipment(v)
+		if !eq.Valid() {
+			return filter, fmt.Errorf("invalid equipment: %s", v)
+		}
+		filter.Equipment = append(filter.Equipment, eq)
+	}
+	for _, v := range splitQueryList(c.QueryParam("movement_pattern")) {
+		mp := dw.MovementPattern(v)
+		if !mp.Valid() {
+			return filter, fmt.Errorf("invalid movement_pattern: %s", v)
+		}
+		filter.MovementPatterns = append(filter.MovementPatterns, mp)
+	}
+	for _, v := range splitQueryList(c.QueryParam("muscle")) {
+		m := dw.Muscle(v)
+		if !m.Valid() {
+			return filter, fmt.Errorf("invalid muscle: %s", v)
+		}
+		filter.Muscles = append(filter.Muscles, m)
+	}
+	if v := c.QueryParam("unilateral"); v != "" {
+		unilateral, err := strconv.ParseBool(v)
+		if err != nil {
+			return filter, fmt.Errorf("invalid unilateral: %s", v)
+		}
+		filter.Unilateral = &unilateral
+	}
+
+	return filter, nil
+}
+
+func splitQueryList(v string) []string {
+	var values []string
+	for _, s := range strings.Split(v, ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			values = append(values, s)
+		}
+	}
+	return values
+}
+
 func (h *WorkoutHandler) SeedWorkoutParts(c echo.Context) error {
 	ctx := c.Request().Context()
-	slog.InfoContext(ctx, "SeedWorkoutParts Handler")
 
 	userID, ok := c.Get("user_id").(string)
 	if !ok || userID == "" {
-		slog.ErrorContext(ctx, "User ID not found in context")
-		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
+		return dom.ErrUnauthorized
 	}
 
 	err := h.wu.SeedWorkoutParts(ctx, userID)
 	if err != nil {
-		slog.ErrorContext(ctx, "Failed to seed workout parts", "userID", userID, "error", err)
-		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+		return fmt.Errorf("failed to seed workout parts: %w", err)
 	}
 
 	return c.JSON(http.StatusOK, map[string]string{"message": "Workout parts seeded successfully"})
@@ -186,25 +275,20 @@ func (h *WorkoutHandler) SeedWorkoutParts(c echo.Context) error {
 
 func (h *WorkoutHandler) CreateWorkoutExercise(c echo.Context) error {
 	ctx := c.Request().Context()
-	slog.InfoContext(ctx, "CreateWorkoutExercise Handler")
 
 	userID, ok := c.Get("user_id").(string)
 	if !ok || userID == "" {
-		slog.ErrorContext(ctx, "User ID not found in context")
-		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
+		return dom.ErrUnauthorized
 	}
 
 	var req dto.CreateWorkoutExerciseRequest
-	err := c.Bind(&req)
-	if err != nil {
-		slog.ErrorContext(ctx, "Failed to bind request", "error", err)
-		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	if err := bindRequest(c, &req); err != nil {
+		return err
 	}
 
-	err = h.wu.CreateWorkoutExercise(ctx, userID, req.Exercises)
+	err := h.wu.CreateWorkoutExercise(ctx, userID, req.Exercises)
 	if err != nil {
-		slog.ErrorContext(ctx, "Failed to create workout exercises", "userID", userID, "error", err)
-		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+		return fmt.Errorf("failed to create workout exercises: %w", err)
 	}
 
 	return c.JSON(http.StatusCreated, map[string]string{"message": "Workout exercises created successfully"})
@@ -212,50 +296,268 @@ func (h *WorkoutHandler) CreateWorkoutExercise(c echo.Context) error {
 
 func (h *WorkoutHandler) DeleteWorkoutExercise(c echo.Context) error {
 	ctx := c.Request().Context()
-	slog.InfoContext(ctx, "DeleteWorkoutExercise Handler")
 
 	userID, ok := c.Get("user_id").(string)
 	if !ok || userID == "" {
-		slog.ErrorContext(ctx, "User ID not found in context")
-		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
+		return dom.ErrUnauthorized
 	}
 
 	exerciseIDStr := c.Param("id")
 	var exerciseID int64
 	if _, err := fmt.Sscanf(exerciseIDStr, "%d", &exerciseID); err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid exercise ID format"})
+		return dom.InvalidField("id", "invalid exercise ID format")
 	}
 
 	err := h.wu.DeleteWorkoutExercise(ctx, userID, exerciseID)
 	if err != nil {
-		slog.ErrorContext(ctx, "Failed to delete workout exercise", "userID", userID, "exerciseID", exerciseID, "error", err)
-		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+		return fmt.Errorf("failed to delete workout exercise: %w", err)
 	}
 
 	return c.JSON(http.StatusOK, map[string]string{"message": "Workout exercise deleted successfully"})
 }
 
+// HideExercise はプリセット種目をユーザーの一覧から非表示にする
+func (h *WorkoutHandler) HideExercise(c echo.Context) error {
+	return h.setExerciseHidden(c, true)
+}
+
+// UnhideExercise はプリセット種目の非表示を解除する
+func (h *WorkoutHandler) UnhideExercise(c echo.Context) error {
+	return h.setExerciseHidden(c, false)
+}
+
+func (h *WorkoutHandler) setExerciseHidden(c echo.Context, hidden bool) error {
+	ctx := c.Request().Context()
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok || userID == "" {
+		return dom.ErrUnauthorized
+	}
+
+	var exerciseID int64
+	if _, err := fmt.Sscanf(c.Param("id"), "%d", &exerciseID); err != nil {
+		return dom.InvalidField("id", "invalid exercise ID format")
+	}
+
+	var err error
+	if hidden {
+		err = h.wu.HideExercise(ctx, userID, exerciseID)
+	} else {
+		err = h.wu.UnhideExercise(ctx, userID, exerciseID)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to change exercise visibility: %w", err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// restSummaryDefaultDays は期間を省略したときに集計する日数（今日を含む）
+const (
+	restSummaryDefaultDays = 30
+	restSummaryMaxDays     = 366
+)
+
+// PUT /api/v1/workouts/exercises/:id/rest
+// 種目のセット間の休憩（秒）を設定する。default_rest_sec を null にすると解除
+func (h *WorkoutHandler) UpdateExerciseRest(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok || userID == "" {
+		return dom.ErrUnauthorized
+	}
+
+	var exerciseID int64
+	if _, err := fmt.Sscanf(c.Param("id"), "%d", &exerciseID); err != nil {
+		return dom.InvalidField("id", "invalid exercise ID format")
+	}
+
+	var req dto.UpdateExerciseRestRequest
+	if err := bindRequest(c, &req); err != nil {
+		return err
+	}
+
+	err := h.wu.SetExerciseDefaultRest(ctx, userID, exerciseID, req.DefaultRestSec)
+	if err != nil {
+		return fmt.Errorf("failed to update exercise rest: %w", err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// PUT /api/v1/workouts/exercises/:id/training-max
+// 種目のトレーニングマックス（kg）を設定する。training_max_kg を null にすると解除
+func (h *WorkoutHandler) UpdateExerciseTrainingMax(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok || userID == "" {
+		return dom.ErrUnauthorized
+	}
+
+	var exerciseID int64
+	if _, err := fmt.Sscanf(c.Param("id"), "%d", &exerciseID); err != nil {
+		return dom.InvalidField("id", "invalid exercise ID format")
+	}
+
+	var req dto.UpdateExerciseTrainingMaxRequest
+	if err := bindRequest(c, &req); err != nil {
+		return err
+	}
+
+	units := unitsOf(c)
+
+	err := h.wu.SetExerciseTrainingMax(ctx, userID, exerciseID, req.TrainingMaxKgIn(units))
+	if err != nil {
+		return fmt.Errorf("failed to update exercise training max: %w", err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// GET /api/v1/workouts/exercises/rest-summary?from=YYYY-MM-DD&to=YYYY-MM-DD
+// 期間内のセット間の休憩の平均を種目ごとに返す（省略時は今日までの30日間）
+func (h *WorkoutHandler) GetRestSummary(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok || userID == "" {
+		return dom.ErrUnauthorized
+	}
+
+	locale := localeOf(c)
+
+	zone := zoneOf(c)
+
+	to, err := zone.ParseDateOrToday(c.QueryParam("to"))
+	if err != nil {
+		return dom.InvalidField("to", "invalid date format")
+	}
+	from := to.AddDate(0, 0, -(restSummaryDefaultDays - 1))
+	if v := c.QueryParam("from"); v != "" {
+		if from, err = zone.ParseDate(v); err != nil {
+			return dom.InvalidField("from", "invalid date format")
+		}
+	}
+	if from.After(to) || to.Sub(from) >= restSummaryMaxDays*24*time.Hour {
+		return dom.InvalidField("from", fmt.Sprintf("date range must be 1-%d days", restSummaryMaxDays))
+	}
+
+	summary, err := h.wu.GetRestSummary(ctx, userID, from, to, locale)
+	if err != nil {
+		return fmt.Errorf("failed to get rest summary: %w", err)
+	}
+
+	return c.JSON(http.StatusOK, summary)
+}
+
 func (h *WorkoutHandler) GetLastWorkoutRecord(c echo.Context) error {
 	ctx := c.Request().Context()
-	slog.InfoContext(ctx, "GetLastWorkoutRecord Handler")
 
 	userID, ok := c.Get("user_id").(string)
 	if !ok || userID == "" {
-		slog.ErrorContext(ctx, "User ID not found in context")
-		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
+		return dom.ErrUnauthorized
 	}
 
+	locale := localeOf(c)
+
 	exerciseIDStr := c.Param("id")
 	var exerciseID int64
 	if _, err := fmt.Sscanf(exerciseIDStr, "%d", &exerciseID); err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid exercise ID format"})
+		return dom.InvalidField("id", "invalid exercise ID format")
 	}
 
-	response, err := h.wu.GetLastWorkoutRecord(ctx, userID, exerciseID)
+	zone := zoneOf(c)
+
+	units := unitsOf(c)
+
+	response, err := h.wu.GetLastWorkoutRecord(ctx, userID, exerciseID, locale, zone, units)
 	if err != nil {
-		slog.ErrorContext(ctx, "Failed to get last workout record", "userID", userID, "exerciseID", exerciseID, "error", err)
-		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+		return fmt.Errorf("failed to get last workout record: %w", err)
 	}
 
 	return c.JSON(http.StatusOK, response)
 }
+
+// GET /api/v1/workouts/exercises/:id/suggestion
+// 直近のセッションから次の重量・回数を提案する。ルールはクエリで変えられる（省略時は既定値）
+//   - strategy: double_progression | linear
+//   - reps_min, reps_max: 回数の範囲（linear は reps_min が目標回数）
+//   - increment_kg: 重量を上げる幅（省略時は器具ごとの既定値）
+//   - sessions: 分析する直近のセッション数
+//   - deload_after, deload_percent: 何セッション続けて届かなければ何%下げるか
+func (h *WorkoutHandler) SuggestProgression(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok || userID == "" {
+		return dom.ErrUnauthorized
+	}
+
+	locale := localeOf(c)
+
+	var exerciseID int64
+	if _, err := fmt.Sscanf(c.Param("id"), "%d", &exerciseID); err != nil {
+		return dom.InvalidField("id", "invalid exercise ID format")
+	}
+
+	cfg, err := parseProgressionConfig(c)
+	if err != nil {
+		return dom.InvalidInput(err)
+	}
+
+	units := unitsOf(c)
+
+	suggestion, err := h.wu.SuggestProgression(ctx, userID, exerciseID, cfg, locale, units)
+	if err != nil {
+		return fmt.Errorf("failed to suggest progression: %w", err)
+	}
+
+	return c.JSON(http.StatusOK, suggestion)
+}
+
+// parseProgressionConfig はクエリパラメータから提案のルールを組み立てる（値の範囲はユースケースで検証する）
+func parseProgressionConfig(c echo.Context) (dw.ProgressionConfig, error) {
+	cfg := dw.DefaultProgressionConfig()
+	if v := c.QueryParam("strategy"); v != "" {
+		cfg.Strategy = dw.ProgressionStrategy(v)
+	}
+
+	ints := []struct {
+		name string
+		dst  *int
+	}{
+		{"reps_min", &cfg.RepsMin},
+		{"reps_max", &cfg.RepsMax},
+		{"sessions", &cfg.Sessions},
+		{"deload_after", &cfg.DeloadAfterMisses},
+	}
+	for _, p := range ints {
+		if v := c.QueryParam(p.name); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil {
+				return cfg, fmt.Errorf("invalid %s parameter", p.name)
+			}
+			*p.dst = n
+		}
+	}
+
+	if v := c.QueryParam("increment_kg"); v != "" {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid increment_kg parameter")
+		}
+		inc := dw.WeightKg(f)
+		cfg.Increment = &inc
+	}
+	if v := c.QueryParam("deload_percent"); v != "" {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid deload_percent parameter")
+		}
+		cfg.DeloadPercent = f
+	}
+
+	return cfg, nil
+}
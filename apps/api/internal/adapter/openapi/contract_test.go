@@ -0,0 +1,628 @@
+package openapi_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"gogym-api/internal/adapter/dto"
+	"gogym-api/internal/adapter/handler"
+	"gogym-api/internal/adapter/openapi"
+	"gogym-api/internal/adapter/router"
+	"gogym-api/internal/adapter/validation"
+	cu "gogym-api/internal/application/contact"
+	gu "gogym-api/internal/application/gym"
+	pu "gogym-api/internal/application/program"
+	su "gogym-api/internal/application/session"
+	tu "gogym-api/internal/application/template"
+	uu "gogym-api/internal/application/user"
+	wu "gogym-api/internal/application/workout"
+	"gogym-api/internal/configs"
+	dom "gogym-api/internal/domain/entities"
+	dc "gogym-api/internal/domain/entities/contact"
+	dp "gogym-api/internal/domain/entities/program"
+	dt "gogym-api/internal/domain/entities/template"
+	du "gogym-api/internal/domain/entities/user"
+	dw "gogym-api/internal/domain/entities/workout"
+	"gogym-api/internal/infra/metrics"
+	"gogym-api/internal/infra/server"
+	"gogym-api/internal/util"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testJWTSecret = "contract-test-secret"
+	testUserID    = "01HZX3KQ6R8V2B7N4M5P9T0W1Y"
+)
+
+// contractCase は1回のリクエストと、レスポンスを照合する OpenAPI のオペレーション
+type contractCase struct {
+	name   string
+	method string
+	path   string // /api/v1 以下の実際のパス
+	route  string // OpenAPI のパス（例: /workouts/records/{id}）
+	body   any
+	public bool // 認証ヘッダーを付けない
+	status int
+}
+
+// TestContract は全ルートのレスポンスを OpenAPI ドキュメントのスキーマで検証する
+func TestContract(t *testing.T) {
+	t.Parallel()
+
+	doc := loadSpec(t)
+	e := newServer()
+	token := signToken(t)
+
+	t.Run("正常系: すべてのルートが OpenAPI ドキュメントに定義され、ドキュメントのすべてのオペレーションがルートに存在する", func(t *testing.T) {
+		t.Parallel()
+
+		routes := map[string]bool{}
+		for _, r := range e.Routes() {
+			if r.Method == echo.RouteNotFound {
+				continue
+			}
+			key := operationKey(r.Method, specPath(r.Path))
+			require.Contains(t, doc.operations, key, "OpenAPI ドキュメントにないルート")
+			routes[key] = true
+		}
+		for key := range doc.operations {
+			require.Contains(t, routes, key, "ルートのない OpenAPI のオペレーション")
+		}
+	})
+
+	t.Run("正常系: 全オペレーションの成功レスポンスがスキーマを満たす", func(t *testing.T) {
+		t.Parallel()
+
+		covered := map[string]bool{}
+		for _, tc := range successCases() {
+			rec := serve(e, tc, token)
+			doc.requireResponse(t, tc, rec)
+			covered[operationKey(tc.method, tc.route)] = true
+		}
+		for key := range doc.operations {
+			require.Contains(t, covered, key, "成功レスポンスを検証していないオペレーション")
+		}
+	})
+
+	t.Run("異常系: エラーレスポンスが problem+json のスキーマを満たす", func(t *testing.T) {
+		t.Parallel()
+
+		cases := []contractCase{
+			{name: "認証なし", method: http.MethodGet, path: "/workouts/templates", route: "/workouts/templates", public: true, status: http.StatusUnauthorized},
+			{name: "項目の検証エラー", method: http.MethodPost, path: "/users", route: "/users", public: true,
+				body: dto.SignUpRequest{Name: "Taro", Email: "taro", Password: "password"}, status: http.StatusBadRequest},
+			{name: "パスのパラメータの形式エラー", method: http.MethodGet, path: "/workouts/records/abc", route: "/workouts/records/{id}", status: http.StatusBadRequest},
+			{name: "存在しない対象", method: http.MethodGet, path: "/workouts/templates/404", route: "/workouts/templates/{id}", status: http.StatusNotFound},
+			{name: "種目ごとの検証エラー", method: http.MethodPost, path: "/workouts/records", route: "/workouts/records",
+				body: invalidRecordRequest(), status: http.StatusUnprocessableEntity},
+		}
+		for _, tc := range cases {
+			rec := serve(e, tc, token)
+			require.Equal(t, dto.ProblemContentType, rec.Header().Get(echo.HeaderContentType), tc.name)
+			doc.requireResponse(t, tc, rec)
+		}
+	})
+
+	t.Run("正常系: 配信する OpenAPI ドキュメントは埋め込んだものと同じ", func(t *testing.T) {
+		t.Parallel()
+
+		rec := serve(e, contractCase{method: http.MethodGet, path: "/openapi.json", public: true}, "")
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.JSONEq(t, string(openapi.Spec), rec.Body.String())
+	})
+}
+
+// spec は OpenAPI ドキュメントとレスポンスのスキーマのコンパイラ
+type spec struct {
+	mu         sync.Mutex // Compiler は並行に使えない
+	compiler   *jsonschema.Compiler
+	operations map[string]map[string]any // "GET /workouts/records" → オペレーション
+	responses  map[string]map[string]any // components/responses
+}
+
+func loadSpec(t *testing.T) *spec {
+	t.Helper()
+
+	raw, err := jsonschema.UnmarshalJSON(bytes.NewReader(openapi.Spec))
+	require.NoError(t, err)
+	compiler := jsonschema.NewCompiler()
+	compiler.DefaultDraft(jsonschema.Draft2020)
+	require.NoError(t, compiler.AddResource("openapi.json", raw))
+
+	var doc struct {
+		OpenAPI    string                               `json:"openapi"`
+		Paths      map[string]map[string]map[string]any `json:"paths"`
+		Components struct {
+			Responses map[string]map[string]any `json:"responses"`
+		} `json:"components"`
+	}
+	require.NoError(t, json.Unmarshal(openapi.Spec, &doc))
+	require.Equal(t, "3.1.0", doc.OpenAPI)
+
+	operations := map[string]map[string]any{}
+	for path, item := range doc.Paths {
+		for method, op := range item {
+			operations[operationKey(method, path)] = op
+		}
+	}
+	return &spec{compiler: compiler, operations: operations, responses: doc.Components.Responses}
+}
+
+// compile はドキュメント内の pointer（JSON Pointer）のスキーマをコンパイルする
+func (s *spec) compile(pointer string) (*jsonschema.Schema, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.compiler.Compile("openapi.json#" + pointer)
+}
+
+// requireResponse はレスポンスのステータスがオペレーションに定義され、本文がそのスキーマを満たすことを検証する
+func (s *spec) requireResponse(t *testing.T, tc contractCase, rec *httptest.ResponseRecorder) {
+	t.Helper()
+	key := operationKey(tc.method, tc.route)
+	require.Equal(t, tc.status, rec.Code, "%s %s: %s", key, tc.name, rec.Body.String())
+
+	op, ok := s.operations[key]
+	require.True(t, ok, "OpenAPI ドキュメントにないオペレーション: %s", key)
+	responses, _ := op["responses"].(map[string]any)
+
+	base := "/paths/" + escapePointer(tc.route) + "/" + strings.ToLower(tc.method) + "/responses/"
+	pointer := base + strconv.Itoa(rec.Code)
+	response, ok := responses[strconv.Itoa(rec.Code)].(map[string]any)
+	if !ok {
+		pointer = base + "default"
+		response, ok = responses["default"].(map[string]any)
+	}
+	require.True(t, ok, "%s: %d のレスポンスが定義されていない", key, rec.Code)
+	if ref, isRef := response["$ref"].(string); isRef {
+		// 共通のレスポンス（components/responses）はその定義をたどる
+		pointer = strings.TrimPrefix(ref, "#")
+		response = s.responses[pointer[strings.LastIndex(pointer, "/")+1:]]
+		require.NotNil(t, response, "%s が定義されていない", ref)
+	}
+
+	content, hasContent := response["content"].(map[string]any)
+	if !hasContent {
+		require.Empty(t, rec.Body.Bytes(), "%s: 本文のないレスポンスに本文がある", key)
+		return
+	}
+	mediaType := rec.Header().Get(echo.HeaderContentType)
+	mediaType, _, _ = strings.Cut(mediaType, ";")
+	require.Contains(t, content, mediaType, "%s: Content-Type %s が定義されていない", key, mediaType)
+
+	schema, err := s.compile(pointer + "/content/" + escapePointer(mediaType) + "/schema")
+	require.NoError(t, err)
+	body, err := jsonschema.UnmarshalJSON(bytes.NewReader(rec.Body.Bytes()))
+	require.NoError(t, err, "%s: %s", key, rec.Body.String())
+	require.NoError(t, schema.Validate(body), "%s %s: %s", key, tc.name, rec.Body.String())
+}
+
+// newServer は cmd/main.go と同じ設定の Echo に、フェイクのユースケースを使うハンドラのルートを登録する
+func newServer() *echo.Echo {
+	e := server.NewEcho(configs.HTTPConfig{}, metrics.New())
+	e.HTTPErrorHandler = handler.HTTPErrorHandler
+	e.Validator = validation.New()
+
+	users := fakeUserUseCase{}
+	router.RegisterRoutes(e,
+		handler.NewGymHandler(struct{ gu.GymUseCase }{}),
+		handler.NewUserHandler(users),
+		handler.NewSessionHandler(fakeSessionUseCase{}),
+		handler.NewWorkoutHandler(fakeWorkoutUseCase{}),
+		handler.NewTemplateHandler(fakeTemplateUseCase{}),
+		handler.NewProgramHandler(fakeProgramUseCase{}),
+		handler.NewContactHandler(fakeContactUseCase{}),
+		users,
+		testJWTSecret,
+		[]string{testUserID},
+	)
+	return e
+}
+
+func signToken(t *testing.T) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"sub": testUserID,
+		"exp": time.Now().Add(time.Hour).Unix(),
+	}).SignedString([]byte(testJWTSecret))
+	require.NoError(t, err)
+	return token
+}
+
+func serve(e *echo.Echo, tc contractCase, token string) *httptest.ResponseRecorder {
+	var body bytes.Buffer
+	if tc.body != nil {
+		_ = json.NewEncoder(&body).Encode(tc.body)
+	}
+	req := httptest.NewRequest(tc.method, "/api/v1"+tc.path, &body)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	if !tc.public {
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+// specPath は Echo のルートのパス（/api/v1/workouts/records/:id）を OpenAPI のパス（/workouts/records/{id}）にする
+func specPath(route string) string {
+	segments := strings.Split(strings.TrimPrefix(route, "/api/v1"), "/")
+	for i, s := range segments {
+		if name, ok := strings.CutPrefix(s, ":"); ok {
+			segments[i] = "{" + name + "}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+func operationKey(method, path string) string {
+	return strings.ToUpper(method) + " " + path
+}
+
+// escapePointer は JSON Pointer のトークンをエスケープする（RFC 6901）
+func escapePointer(s string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(s, "~", "~0"), "/", "~1")
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+// successCases は全オペレーションの成功するリクエスト
+func successCases() []contractCase {
+	template := dto.SaveTemplateRequest{Name: "Push", Exercises: []dto.TemplateExerciseDTO{
+		{ExerciseID: 10, TargetSets: 3, RepsMin: 8, RepsMax: 12, TargetWeight: ptr(135.0)},
+	}}
+	program := dto.SaveProgramRequest{Name: "5/3/1", Weeks: 4, Days: []dto.ProgramDayDTO{
+		{Week: 1, Day: 1, TemplateID: 7, Prescriptions: []dto.PrescriptionDTO{{ExerciseID: 10, PercentOfMax: 65, Reps: 5}}},
+	}}
+
+	return []contractCase{
+		{method: http.MethodGet, path: "/openapi.json", route: "/openapi.json", public: true, status: http.StatusOK},
+		{method: http.MethodPost, path: "/users", route: "/users", public: true, status: http.StatusCreated,
+			body: dto.SignUpRequest{Name: "Taro", Email: "taro@example.com", Password: "Passw0rd"}},
+		{method: http.MethodPost, path: "/sessions/login", route: "/sessions/login", public: true, status: http.StatusOK,
+			body: dto.LoginRequest{Email: "taro@example.com", Password: "Passw0rd"}},
+		{method: http.MethodPost, path: "/sessions/refresh", route: "/sessions/refresh", public: true, status: http.StatusOK,
+			body: dto.RefreshRequest{RefreshToken: "refresh-token"}},
+		{method: http.MethodGet, path: "/contact/token", route: "/contact/token", public: true, status: http.StatusOK},
+		{method: http.MethodPost, path: "/contact", route: "/contact", public: true, status: http.StatusNoContent,
+			body: handler.ContactRequest{Email: "taro@example.com", Message: "Hello", FormToken: "form-token"}},
+
+		{method: http.MethodGet, path: "/users/me/preferences", route: "/users/me/preferences", status: http.StatusOK},
+		{method: http.MethodPut, path: "/users/me/preferences", route: "/users/me/preferences", status: http.StatusOK,
+			body: dto.UpdateUserPreferencesRequest{Locale: ptr("en"), WeightUnit: ptr("lb")}},
+
+		{method: http.MethodGet, path: "/workouts/records?date=2026-10-19", route: "/workouts/records", status: http.StatusOK},
+		{method: http.MethodGet, path: "/workouts/records/1", route: "/workouts/records/{id}", status: http.StatusOK},
+		{method: http.MethodPost, path: "/workouts/records", route: "/workouts/records", status: http.StatusCreated, body: recordRequest()},
+		{method: http.MethodPut, path: "/workouts/records/1", route: "/workouts/records/{id}", status: http.StatusOK, body: recordRequest()},
+		{method: http.MethodGet, path: "/workouts/parts?include_hidden=true&equipment=barbell", route: "/workouts/parts", status: http.StatusOK},
+		{method: http.MethodPost, path: "/workouts/seed", route: "/workouts/seed", status: http.StatusOK},
+		{method: http.MethodPost, path: "/workouts/exercises", route: "/workouts/exercises", status: http.StatusCreated,
+			body: dto.CreateWorkoutExerciseRequest{Exercises: []dto.CreateWorkoutExerciseItem{{Name: "Cable Fly", WorkoutPartID: 1, Equipment: "cable"}}}},
+		{method: http.MethodDelete, path: "/workouts/exercises/10", route: "/workouts/exercises/{id}", status: http.StatusOK},
+		{method: http.MethodPut, path: "/workouts/exercises/10/hide", route: "/workouts/exercises/{id}/hide", status: http.StatusNoContent},
+		{method: http.MethodDelete, path: "/workouts/exercises/10/hide", route: "/workouts/exercises/{id}/hide", status: http.StatusNoContent},
+		{method: http.MethodPut, path: "/workouts/exercises/10/rest", route: "/workouts/exercises/{id}/rest", status: http.StatusNoContent,
+			body: dto.UpdateExerciseRestRequest{DefaultRestSec: ptr(120)}},
+		{method: http.MethodPut, path: "/workouts/exercises/10/training-max", route: "/workouts/exercises/{id}/training-max", status: http.StatusNoContent,
+			body: dto.UpdateExerciseTrainingMaxRequest{TrainingMax: ptr(225.0)}},
+		{method: http.MethodGet, path: "/workouts/exercises/rest-summary?from=2026-10-01&to=2026-10-19", route: "/workouts/exercises/rest-summary", status: http.StatusOK},
+		{method: http.MethodGet, path: "/workouts/exercises/10/last", route: "/workouts/exercises/{id}/last", status: http.StatusOK},
+		{name: "記録なし", method: http.MethodGet, path: "/workouts/exercises/99/last", route: "/workouts/exercises/{id}/last", status: http.StatusOK},
+		{method: http.MethodGet, path: "/workouts/exercises/10/suggestion?strategy=double_progression&reps_min=8&reps_max=12", route: "/workouts/exercises/{id}/suggestion", status: http.StatusOK},
+		{name: "記録なし", method: http.MethodGet, path: "/workouts/exercises/99/suggestion", route: "/workouts/exercises/{id}/suggestion", status: http.StatusOK},
+
+		{method: http.MethodGet, path: "/workouts/templates", route: "/workouts/templates", status: http.StatusOK},
+		{method: http.MethodPost, path: "/workouts/templates", route: "/workouts/templates", status: http.StatusCreated, body: template},
+		{method: http.MethodGet, path: "/workouts/templates/7", route: "/workouts/templates/{id}", status: http.StatusOK},
+		{method: http.MethodPut, path: "/workouts/templates/7", route: "/workouts/templates/{id}", status: http.StatusOK, body: template},
+		{method: http.MethodDelete, path: "/workouts/templates/7", route: "/workouts/templates/{id}", status: http.StatusNoContent},
+		{method: http.MethodPost, path: "/workouts/templates/7/start", route: "/workouts/templates/{id}/start", status: http.StatusCreated,
+			body: dto.StartTemplateRequest{PerformedDate: "2026-10-19", StartedAt: ptr("07:30")}},
+
+		{method: http.MethodGet, path: "/workouts/programs", route: "/workouts/programs", status: http.StatusOK},
+		{method: http.MethodPost, path: "/workouts/programs", route: "/workouts/programs", status: http.StatusCreated, body: program},
+		{method: http.MethodGet, path: "/workouts/programs/3", route: "/workouts/programs/{id}", status: http.StatusOK},
+		{method: http.MethodPut, path: "/workouts/programs/3", route: "/workouts/programs/{id}", status: http.StatusOK, body: program},
+		{method: http.MethodDelete, path: "/workouts/programs/3", route: "/workouts/programs/{id}", status: http.StatusNoContent},
+		{method: http.MethodPost, path: "/workouts/programs/3/enroll", route: "/workouts/programs/{id}/enroll", status: http.StatusCreated,
+			body: dto.EnrollProgramRequest{StartDate: "2026-10-05"}},
+		{method: http.MethodGet, path: "/workouts/programs/enrollment", route: "/workouts/programs/enrollment", status: http.StatusOK},
+		{method: http.MethodDelete, path: "/workouts/programs/enrollment", route: "/workouts/programs/enrollment", status: http.StatusNoContent},
+		{method: http.MethodGet, path: "/workouts/programs/today?date=2026-10-19", route: "/workouts/programs/today", status: http.StatusOK},
+		{name: "休養日", method: http.MethodGet, path: "/workouts/programs/today?date=2026-10-20", route: "/workouts/programs/today", status: http.StatusOK},
+		{method: http.MethodGet, path: "/workouts/programs/adherence?date=2026-10-19", route: "/workouts/programs/adherence", status: http.StatusOK},
+
+		{method: http.MethodGet, path: "/admin/contacts?status=open&limit=20", route: "/admin/contacts", status: http.StatusOK},
+		{method: http.MethodPut, path: "/admin/contacts/1/resolve", route: "/admin/contacts/{id}/resolve", status: http.StatusOK},
+	}
+}
+
+// ---- フィクスチャ ----
+
+var (
+	benchPress = dw.WorkoutExerciseRef{
+		ID: 10, Key: "bench_press", Name: "Bench Press", PartID: ptr(dom.ID(1)),
+		Translations:   []dw.WorkoutExerciseTranslation{{Locale: dom.LocaleJa, Name: "ベンチプレス"}, {Locale: dom.LocaleEn, Name: "Bench Press"}},
+		PrimaryMuscles: []dw.Muscle{"chest"}, SecondaryMuscles: []dw.Muscle{"triceps", "front_delts"},
+		Equipment: dw.EquipmentBarbell, MovementPattern: "horizontal_push",
+		DefaultRestSec: ptr(180), TrainingMaxKg: ptr(100.0),
+	}
+	pushTemplate = dt.Template{ID: 7, Name: "Push", Exercises: []dt.TemplateExercise{
+		{Exercise: benchPress, Position: 1, TargetSets: 3, RepsMin: 8, RepsMax: 12, TargetWeightKg: ptr(60.0)},
+	}}
+	program531 = dp.Program{ID: 3, Name: "5/3/1", Weeks: 4, Days: []dp.ProgramDay{
+		{Week: 1, Day: 1, TemplateID: 7, TemplateName: "Push", Prescriptions: []dp.Prescription{
+			{Exercise: benchPress, SetNumber: 1, PercentOfMax: 65, Reps: 5},
+			{Exercise: benchPress, SetNumber: 2, PercentOfMax: 85, Reps: 5, AMRAP: true},
+		}},
+	}}
+	// enrollment は 2026-10-05（月）開始なので 2026-10-19 が3週目の1日目、翌日は休養日
+	enrollment = dp.Enrollment{ID: 5, UserID: testUserID, Program: program531, StartDate: time.Date(2026, 10, 5, 0, 0, 0, 0, time.UTC)}
+)
+
+func recordRequest() dto.WorkoutRecordDTO {
+	return dto.WorkoutRecordDTO{
+		PerformedDate:  "2026-10-19",
+		StartedAt:      ptr("07:30"),
+		EndedAt:        ptr("08:45"),
+		Note:           ptr("good"),
+		ConditionLevel: ptr(4),
+		Parts: []dto.WorkoutPartGroupDTO{{ID: 1, Exercises: []dto.ExerciseDTO{{
+			ID:   ptr(int64(10)),
+			Name: "Bench Press",
+			Sets: []dto.SetDTO{
+				{SetNumber: 1, Type: "warmup", WeightKg: ptr(40.0), Reps: ptr(10)},
+				{SetNumber: 2, WeightKg: ptr(80.0), Reps: ptr(12), RPE: ptr(8.5), CompletedAt: ptr("2026-10-19T07:40:00")},
+				{SetNumber: 3, WeightKg: ptr(80.0), Reps: ptr(12), CompletedAt: ptr("2026-10-19T07:43:00")},
+			},
+		}}}},
+	}
+}
+
+func invalidRecordRequest() dto.WorkoutRecordDTO {
+	req := recordRequest()
+	req.Parts[0].Exercises[0].Sets = []dto.SetDTO{{SetNumber: 1, Kind: "timed", DurationSec: ptr(30), DistanceM: ptr(100.0)}}
+	return req
+}
+
+// record は recordRequest を保存したセッション
+func record(zone util.TimeZone, units dom.WeightUnits) dw.WorkoutRecord {
+	req := recordRequest()
+	r, err := dto.WorkoutRecordDTOToDomain(&req, zone, units)
+	if err != nil {
+		panic(err)
+	}
+	r.ID = ptr(dom.ID(1))
+	r.UserID = testUserID
+	for i := range r.Sets {
+		r.Sets[i].Exercise = benchPress
+	}
+	return *r
+}
+
+func contactMessage() dc.Message {
+	return dc.Message{
+		ID: 1, Email: "taro@example.com", Body: "Hello", UserID: ptr(testUserID), IP: "192.0.2.1", UserAgent: "test",
+		Status: dc.StatusResolved, SpamScore: 1, SpamReasons: []string{"links:1"},
+		DeliveryStatus: dc.DeliveryDelivered, DeliveryAttempts: 1, DeliveredAt: ptr(time.Now()),
+		ResolvedAt: ptr(time.Now()), ResolvedBy: ptr(testUserID), CreatedAt: time.Now(),
+	}
+}
+
+// ---- フェイクのユースケース ----
+
+type fakeUserUseCase struct{ uu.UserUseCase }
+
+func (fakeUserUseCase) SignUp(context.Context, dto.SignUpRequest) error { return nil }
+
+func (fakeUserUseCase) GetPreferences(context.Context, string) (dto.UserPreferencesResponse, error) {
+	return dto.UserToPreferencesResponse(&du.User{}), nil
+}
+
+func (fakeUserUseCase) UpdatePreferences(_ context.Context, _ string, req dto.UpdateUserPreferencesRequest) (dto.UserPreferencesResponse, error) {
+	return dto.UserToPreferencesResponse(&du.User{Locale: *req.Locale, TimeZone: "Asia/Tokyo", WeightUnits: dom.WeightUnits{Unit: dom.WeightUnit(*req.WeightUnit)}}), nil
+}
+
+// Preferences は表示単位の変換も検証するため lb を返す
+func (fakeUserUseCase) Preferences(context.Context, string) (dom.UserPreferences, error) {
+	return dom.UserPreferences{WeightUnits: dom.WeightUnits{Unit: dom.WeightUnitLb}}, nil
+}
+
+type fakeSessionUseCase struct{ su.SessionUseCase }
+
+func (fakeSessionUseCase) Login(context.Context, dto.LoginRequest) error { return nil }
+
+func (fakeSessionUseCase) CreateSession(context.Context, string) (dto.TokenResponse, error) {
+	return tokenResponse(), nil
+}
+
+func (fakeSessionUseCase) RefreshToken(context.Context, string) (dto.TokenResponse, error) {
+	return tokenResponse(), nil
+}
+
+func tokenResponse() dto.TokenResponse {
+	return dto.TokenResponse{
+		User:        dto.UserResponse{ID: testUserID, Name: "Taro", Email: "taro@example.com"},
+		AccessToken: "access-token", RefreshToken: "refresh-token", ExpiresIn: 3600,
+	}
+}
+
+type fakeWorkoutUseCase struct{ wu.WorkoutUseCase }
+
+func (fakeWorkoutUseCase) GetWorkoutRecords(_ context.Context, _ string, date time.Time, locale string, zone util.TimeZone, units dom.WeightUnits) (dto.WorkoutRecordsByDateDTO, error) {
+	return dto.WorkoutRecordsToByDateDTO(util.FormatDate(date), []dw.WorkoutRecord{record(zone, units)}, locale, zone, units), nil
+}
+
+func (fakeWorkoutUseCase) GetWorkoutRecord(_ context.Context, _ string, _ int64, locale string, zone util.TimeZone, units dom.WeightUnits) (dto.WorkoutRecordDTO, error) {
+	r := record(zone, units)
+	return *dto.WorkoutDomainToDTO(&r, locale, zone, units), nil
+}
+
+func (fakeWorkoutUseCase) CreateWorkoutRecord(context.Context, dw.WorkoutRecord) (int64, error) {
+	return 1, nil
+}
+
+func (fakeWorkoutUseCase) UpdateWorkoutRecord(context.Context, dw.WorkoutRecord) error { return nil }
+
+func (fakeWorkoutUseCase) GetWorkoutParts(_ context.Context, _ string, _ wu.WorkoutPartsFilter, locale string, units dom.WeightUnits) ([]dto.WorkoutPartListItemDTO, error) {
+	return dto.WorkoutPartsToDTO([]dw.WorkoutPart{{
+		ID: 1, Key: "chest",
+		Translations: []dw.WorkoutPartTranslation{{Locale: dom.LocaleJa, Name: "胸"}, {Locale: dom.LocaleEn, Name: "Chest"}},
+		Exercises:    []dw.WorkoutExerciseRef{benchPress, {ID: 11, Name: "Cable Fly", PartID: ptr(dom.ID(1)), Owner: ptr(dom.ULID(testUserID))}},
+	}}, locale, units), nil
+}
+
+func (fakeWorkoutUseCase) SeedWorkoutParts(context.Context, string) error { return nil }
+
+func (fakeWorkoutUseCase) HideExercise(context.Context, string, int64) error { return nil }
+
+func (fakeWorkoutUseCase) UnhideExercise(context.Context, string, int64) error { return nil }
+
+func (fakeWorkoutUseCase) SetExerciseDefaultRest(context.Context, string, int64, *int) error {
+	return nil
+}
+
+func (fakeWorkoutUseCase) SetExerciseTrainingMax(context.Context, string, int64, *float64) error {
+	return nil
+}
+
+func (fakeWorkoutUseCase) GetRestSummary(_ context.Context, _ string, from, to time.Time, locale string) (dto.RestSummaryDTO, error) {
+	return dto.RestSummariesToDTO(from.Format(util.DateLayout), to.Format(util.DateLayout),
+		[]dw.RestSummary{{Exercise: benchPress, Average: 200 * time.Second, Samples: 4}}, locale), nil
+}
+
+func (fakeWorkoutUseCase) CreateWorkoutExercise(context.Context, string, []dto.CreateWorkoutExerciseItem) error {
+	return nil
+}
+
+func (fakeWorkoutUseCase) DeleteWorkoutExercise(context.Context, string, int64) error { return nil }
+
+func (fakeWorkoutUseCase) GetLastWorkoutRecord(_ context.Context, _ string, exerciseID int64, locale string, zone util.TimeZone, units dom.WeightUnits) (*dto.ExerciseDTO, error) {
+	if exerciseID != int64(benchPress.ID) {
+		return nil, nil
+	}
+	r := record(zone, units)
+	exercise := dto.WorkoutDomainToDTO(&r, locale, zone, units).Parts[0].Exercises[0]
+	exercise.Units = ptr(dto.UnitsToDTO(units))
+	return &exercise, nil
+}
+
+func (fakeWorkoutUseCase) SuggestProgression(_ context.Context, _ string, exerciseID int64, cfg dw.ProgressionConfig, locale string, units dom.WeightUnits) (dto.ProgressionSuggestionDTO, error) {
+	var records []dw.WorkoutRecord
+	if exerciseID == int64(benchPress.ID) {
+		records = append(records, record(util.TimeZone{}, units))
+	}
+	return dto.ProgressionSuggestionToDTO(exerciseID, dw.SuggestProgression(dom.ID(exerciseID), records, cfg), locale, units), nil
+}
+
+type fakeTemplateUseCase struct{ tu.TemplateUseCase }
+
+func (fakeTemplateUseCase) ListTemplates(_ context.Context, _ string, locale string, units dom.WeightUnits) ([]dto.TemplateDTO, error) {
+	return dto.TemplatesToDTO([]dt.Template{pushTemplate}, locale, units), nil
+}
+
+func (fakeTemplateUseCase) GetTemplate(_ context.Context, _ string, templateID int64, locale string, units dom.WeightUnits) (dto.TemplateDTO, error) {
+	if templateID != int64(pushTemplate.ID) {
+		return dto.TemplateDTO{}, dt.ErrTemplateNotFound
+	}
+	return dto.TemplateToDTO(&pushTemplate, locale, units), nil
+}
+
+func (fakeTemplateUseCase) CreateTemplate(_ context.Context, _ string, _ dto.SaveTemplateRequest, locale string, units dom.WeightUnits) (dto.TemplateDTO, error) {
+	return dto.TemplateToDTO(&pushTemplate, locale, units), nil
+}
+
+func (fakeTemplateUseCase) UpdateTemplate(_ context.Context, _ string, _ int64, _ dto.SaveTemplateRequest, locale string, units dom.WeightUnits) (dto.TemplateDTO, error) {
+	return dto.TemplateToDTO(&pushTemplate, locale, units), nil
+}
+
+func (fakeTemplateUseCase) DeleteTemplate(context.Context, string, int64) error { return nil }
+
+func (fakeTemplateUseCase) StartWorkout(_ context.Context, _ int64, base dw.WorkoutRecord, locale string, zone util.TimeZone, units dom.WeightUnits) (dto.WorkoutRecordDTO, error) {
+	r := record(zone, units)
+	r.StartedAt, r.EndedAt = base.StartedAt, nil
+	r.TemplateID = ptr(pushTemplate.ID)
+	return *dto.WorkoutDomainToDTO(&r, locale, zone, units), nil
+}
+
+type fakeProgramUseCase struct{ pu.ProgramUseCase }
+
+func (fakeProgramUseCase) ListPrograms(_ context.Context, _ string, locale string) ([]dto.ProgramDTO, error) {
+	return dto.ProgramsToDTO([]dp.Program{program531}, locale), nil
+}
+
+func (fakeProgramUseCase) GetProgram(_ context.Context, _ string, _ int64, locale string) (dto.ProgramDTO, error) {
+	return dto.ProgramToDTO(&program531, locale), nil
+}
+
+func (fakeProgramUseCase) CreateProgram(_ context.Context, _ string, _ dto.SaveProgramRequest, locale string) (dto.ProgramDTO, error) {
+	return dto.ProgramToDTO(&program531, locale), nil
+}
+
+func (fakeProgramUseCase) UpdateProgram(_ context.Context, _ string, _ int64, _ dto.SaveProgramRequest, locale string) (dto.ProgramDTO, error) {
+	return dto.ProgramToDTO(&program531, locale), nil
+}
+
+func (fakeProgramUseCase) DeleteProgram(context.Context, string, int64) error { return nil }
+
+func (fakeProgramUseCase) Enroll(context.Context, string, int64, time.Time) (dto.EnrollmentDTO, error) {
+	return dto.EnrollmentToDTO(&enrollment), nil
+}
+
+func (fakeProgramUseCase) GetEnrollment(context.Context, string) (dto.EnrollmentDTO, error) {
+	return dto.EnrollmentToDTO(&enrollment), nil
+}
+
+func (fakeProgramUseCase) EndEnrollment(context.Context, string) error { return nil }
+
+func (fakeProgramUseCase) GetToday(_ context.Context, _ string, date time.Time, locale string, units dom.WeightUnits) (dto.ProgramTodayDTO, error) {
+	plan := enrollment.PlanOn(date)
+	out := dto.ProgramTodayToDTO(&enrollment, plan, units)
+	if plan.Training != nil {
+		out.Workout = &dto.PlannedWorkoutDTO{
+			Template:      dto.TemplateToDTO(&pushTemplate, locale, units),
+			Prescriptions: dto.PrescribedExercisesToDTO(plan.Training.Prescriptions, map[dw.ID]float64{benchPress.ID: 100}, locale, units),
+			RecordID:      ptr(int64(1)),
+		}
+	}
+	return out, nil
+}
+
+func (fakeProgramUseCase) GetAdherence(_ context.Context, _ string, asOf time.Time) (dto.AdherenceDTO, error) {
+	r := record(util.TimeZone{}, dom.WeightUnits{})
+	r.TemplateID = ptr(pushTemplate.ID)
+	return dto.AdherenceToDTO(asOf, &enrollment, enrollment.Adherence([]dw.WorkoutRecord{r}, asOf)), nil
+}
+
+type fakeContactUseCase struct{ cu.ContactUseCase }
+
+func (fakeContactUseCase) IssueFormToken(context.Context) (dto.ContactFormTokenResponse, error) {
+	return dto.ContactFormTokenResponse{Token: "form-token", ExpiresAt: time.Now().Add(time.Hour)}, nil
+}
+
+func (fakeContactUseCase) SendContact(context.Context, cu.SendContactInput) error { return nil }
+
+func (fakeContactUseCase) ListContacts(context.Context, string, int, int) (dto.ContactMessageListResponse, error) {
+	m := contactMessage()
+	m.Status, m.ResolvedAt, m.ResolvedBy = dc.StatusOpen, nil, nil
+	return dto.ContactMessagesToListResponse([]dc.Message{m}, 1), nil
+}
+
+func (fakeContactUseCase) ResolveContact(context.Context, int64, string) (dto.ContactMessageResponse, error) {
+	m := contactMessage()
+	return dto.ContactMessageToResponse(&m), nil
+}
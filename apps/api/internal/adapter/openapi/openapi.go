@@ -0,0 +1,20 @@
+// Package openapi はバイナリに埋め込んだ API の OpenAPI 3.1 ドキュメントを配信する
+package openapi
+
+import (
+	_ "embed"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Spec は /api/v1 以下の全ルートの OpenAPI 3.1 ドキュメント（JSON）
+// ルート・DTO を変更したら合わせて更新する（contract_test.go で実際のレスポンスと照合する）
+//
+//go:embed openapi.json
+var Spec []byte
+
+// Handler は OpenAPI ドキュメントを返す
+func Handler(c echo.Context) error {
+	return c.Blob(http.StatusOK, echo.MIMEApplicationJSON, Spec)
+}
@@ -0,0 +1,85 @@
+package contact
+
+import (
+	"strings"
+
+	domain "gogym-api/internal/domain/entities/contact"
+)
+
+// ToEntity converts ContactMessage record to domain entity
+func ToEntity(r *ContactMessage) *domain.Message {
+	if r == nil {
+		return nil
+	}
+
+	return &domain.Message{
+		ID:                r.ID,
+		Email:             r.Email,
+		Body:              r.Message,
+		UserID:            r.UserID,
+		IP:                r.IP,
+		UserAgent:         r.UserAgent,
+		Status:            domain.Status(r.Status),
+		SpamScore:         r.SpamScore,
+		SpamReasons:       splitReasons(r.SpamReasons),
+		DeliveryStatus:    domain.DeliveryStatus(r.DeliveryStatus),
+		DeliveryAttempts:  r.DeliveryAttempts,
+		LastDeliveryError: r.LastDeliveryError,
+		DeliveredAt:       r.DeliveredAt,
+		ResolvedAt:        r.ResolvedAt,
+		ResolvedBy:        r.ResolvedBy,
+		CreatedAt:         r.CreatedAt,
+		UpdatedAt:         r.UpdatedAt,
+	}
+}
+
+// FromEntity converts domain entity to ContactMessage record
+func FromEntity(m *domain.Message) *ContactMessage {
+	if m == nil {
+		return nil
+	}
+
+	return &ContactMessage{
+		ID:                m.ID,
+		Email:             m.Email,
+		Message:           m.Body,
+		UserID:            m.UserID,
+		IP:                m.IP,
+		UserAgent:         m.UserAgent,
+		Status:            string(m.Status),
+		SpamScore:         m.SpamScore,
+		SpamReasons:       joinReasons(m.SpamReasons),
+		DeliveryStatus:    string(m.DeliveryStatus),
+		DeliveryAttempts:  m.DeliveryAttempts,
+		LastDeliveryError: m.LastDeliveryError,
+		DeliveredAt:       m.DeliveredAt,
+		ResolvedAt:        m.ResolvedAt,
+		ResolvedBy:        m.ResolvedBy,
+		CreatedAt:         m.CreatedAt,
+		UpdatedAt:         m.UpdatedAt,
+	}
+}
+
+// ToEntities converts slice of ContactMessage records to slice of domain entities
+func ToEntities(records []ContactMessage) []domain.Message {
+	entities := make([]domain.Message, 0, len(records))
+	for i := range records {
+		entities = append(entities, *ToEntity(&records[i]))
+	}
+	return entities
+}
+
+func splitReasons(s *string) []string {
+	if s == nil || *s == "" {
+		return nil
+	}
+	return strings.Split(*s, ",")
+}
+
+func joinReasons(reasons []string) *string {
+	if len(reasons) == 0 {
+		return nil
+	}
+	joined := strings.Join(reasons, ",")
+	return &joined
+}
@@ -0,0 +1,39 @@
+package contact
+
+import (
+	"time"
+)
+
+type ContactMessage struct {
+	ID                int64   `gorm:"primaryKey;autoIncrement"`
+	Email             string  `gorm:"size:255;not null"`
+	Message           string  `gorm:"type:text;not null"`
+	UserID            *string `gorm:"type:char(26)"`
+	IP                string  `gorm:"size:64;not null"`
+	UserAgent         string  `gorm:"size:512;not null"`
+	Status            string  `gorm:"size:20;not null;index:idx_contact_messages_status_created,priority:1"`
+	SpamScore         int     `gorm:"not null"`
+	SpamReasons       *string `gorm:"type:text"` // カンマ区切り
+	DeliveryStatus    string  `gorm:"size:20;not null;index:idx_contact_messages_delivery"`
+	DeliveryAttempts  int     `gorm:"not null"`
+	LastDeliveryError *string `gorm:"type:text"`
+	DeliveredAt       *time.Time
+	ResolvedAt        *time.Time
+	ResolvedBy        *string   `gorm:"type:char(26)"`
+	CreatedAt         time.Time `gorm:"autoCreateTime;index:idx_contact_messages_status_created,priority:2"`
+	UpdatedAt         time.Time `gorm:"autoUpdateTime"`
+}
+
+func (ContactMessage) TableName() string {
+	return "contact_messages"
+}
+
+// ContactFormNonce は使用済みのフォームトークンのノンス
+type ContactFormNonce struct {
+	Nonce     string    `gorm:"primaryKey;size:64"`
+	ExpiresAt time.Time `gorm:"not null;index:idx_contact_form_nonces_expires"`
+}
+
+func (ContactFormNonce) TableName() string {
+	return "contact_form_nonces"
+}
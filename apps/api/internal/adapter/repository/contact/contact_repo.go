@@ -0,0 +1,145 @@
+package contact
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	cu "gogym-api/internal/application/contact"
+	domain "gogym-api/internal/domain/entities/contact"
+	"gogym-api/internal/infra/db"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type contactRepository struct {
+	db *gorm.DB
+}
+
+func NewContactRepository(db *gorm.DB) cu.Repository {
+	return &contactRepository{db: db}
+}
+
+// Create は問い合わせを保存し、採番されたIDをエンティティに反映する
+func (r *contactRepository) Create(ctx context.Context, m *domain.Message) error {
+	record := FromEntity(m)
+	record.ID = 0
+
+	if err := db.Conn(ctx, r.db).Create(record).Error; err != nil {
+		return fmt.Errorf("failed to create contact message: %w", err)
+	}
+
+	m.ID = record.ID
+	m.CreatedAt = record.CreatedAt
+	m.UpdatedAt = record.UpdatedAt
+	return nil
+}
+
+// FindByID は問い合わせを1件取得
+func (r *contactRepository) FindByID(ctx context.Context, id int64) (*domain.Message, error) {
+	var record ContactMessage
+	err := db.Conn(ctx, r.db).First(&record, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to find contact message: %w", err)
+	}
+
+	return ToEntity(&record), nil
+}
+
+// List はステータスで絞り込んだ問い合わせを新しい順に取得し、総件数も返す
+func (r *contactRepository) List(ctx context.Context, filter cu.ListFilter) ([]domain.Message, int64, error) {
+	query := db.Conn(ctx, r.db).Model(&ContactMessage{})
+	if filter.Status != nil {
+		query = query.Where("status = ?", string(*filter.Status))
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count contact messages: %w", err)
+	}
+
+	var records []ContactMessage
+	err := query.
+		Order("created_at DESC, id DESC").
+		Limit(filter.Limit).
+		Offset(filter.Offset).
+		Find(&records).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list contact messages: %w", err)
+	}
+
+	return ToEntities(records), total, nil
+}
+
+// Update は配信状況・対応状況を更新
+func (r *contactRepository) Update(ctx context.Context, m *domain.Message) error {
+	updates := map[string]interface{}{
+		"status":              string(m.Status),
+		"delivery_status":     string(m.DeliveryStatus),
+		"delivery_attempts":   m.DeliveryAttempts,
+		"last_delivery_error": m.LastDeliveryError,
+		"delivered_at":        m.DeliveredAt,
+		"resolved_at":         m.ResolvedAt,
+		"resolved_by":         m.ResolvedBy,
+	}
+
+	err := db.Conn(ctx, r.db).
+		Model(&ContactMessage{ID: m.ID}).
+		Updates(updates).Error
+	if err != nil {
+		return fmt.Errorf("failed to update contact message: %w", err)
+	}
+
+	return nil
+}
+
+// CountByIPSince は指定時刻以降に同じIPから送信された件数を返す
+func (r *contactRepository) CountByIPSince(ctx context.Context, ip string, since time.Time) (int64, error) {
+	var count int64
+	err := db.Conn(ctx, r.db).
+		Model(&ContactMessage{}).
+		Where("ip = ? AND created_at >= ?", ip, since).
+		Count(&count).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to count contact messages by ip: %w", err)
+	}
+	return count, nil
+}
+
+// CountByEmailSince は指定時刻以降に同じメールアドレスから送信された件数を返す（大文字小文字は区別しない）
+func (r *contactRepository) CountByEmailSince(ctx context.Context, email string, since time.Time) (int64, error) {
+	var count int64
+	err := db.Conn(ctx, r.db).
+		Model(&ContactMessage{}).
+		Where("LOWER(email) = LOWER(?) AND created_at >= ?", email, since).
+		Count(&count).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to count contact messages by email: %w", err)
+	}
+	return count, nil
+}
+
+// ConsumeFormNonce は期限切れのノンスを削除してからノンスを登録する
+// 既に登録済み（使用済み）の場合は ErrInvalidFormToken を返す
+func (r *contactRepository) ConsumeFormNonce(ctx context.Context, nonce string, expiresAt time.Time) error {
+	conn := db.Conn(ctx, r.db)
+	if err := conn.Where("expires_at < ?", time.Now().UTC()).Delete(&ContactFormNonce{}).Error; err != nil {
+		return fmt.Errorf("failed to delete expired contact form nonces: %w", err)
+	}
+
+	res := conn.
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&ContactFormNonce{Nonce: nonce, ExpiresAt: expiresAt.UTC()})
+	if res.Error != nil {
+		return fmt.Errorf("failed to consume contact form nonce: %w", res.Error)
+	}
+	if res.RowsAffected == 0 {
+		return domain.ErrInvalidFormToken
+	}
+	return nil
+}
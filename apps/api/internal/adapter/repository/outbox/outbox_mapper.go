@@ -0,0 +1,47 @@
+package outbox
+
+import (
+	domain "gogym-api/internal/domain/entities/outbox"
+)
+
+// ToEntity converts OutboxMessage record to domain entity
+func ToEntity(r *OutboxMessage) *domain.Message {
+	if r == nil {
+		return nil
+	}
+
+	return &domain.Message{
+		ID:            r.ID,
+		Topic:         r.Topic,
+		Payload:       []byte(r.Payload),
+		Status:        domain.Status(r.Status),
+		Attempts:      r.Attempts,
+		MaxAttempts:   r.MaxAttempts,
+		NextAttemptAt: r.NextAttemptAt,
+		LastError:     r.LastError,
+		DeliveredAt:   r.DeliveredAt,
+		CreatedAt:     r.CreatedAt,
+		UpdatedAt:     r.UpdatedAt,
+	}
+}
+
+// FromEntity converts domain entity to OutboxMessage record
+func FromEntity(m *domain.Message) *OutboxMessage {
+	if m == nil {
+		return nil
+	}
+
+	return &OutboxMessage{
+		ID:            m.ID,
+		Topic:         m.Topic,
+		Payload:       string(m.Payload),
+		Status:        string(m.Status),
+		Attempts:      m.Attempts,
+		MaxAttempts:   m.MaxAttempts,
+		NextAttemptAt: m.NextAttemptAt,
+		LastError:     m.LastError,
+		DeliveredAt:   m.DeliveredAt,
+		CreatedAt:     m.CreatedAt,
+		UpdatedAt:     m.UpdatedAt,
+	}
+}
@@ -0,0 +1,24 @@
+package outbox
+
+import (
+	"time"
+)
+
+type OutboxMessage struct {
+	ID            int64     `gorm:"primaryKey;autoIncrement"`
+	Topic         string    `gorm:"size:100;not null;index:idx_outbox_messages_topic"`
+	Payload       string    `gorm:"type:jsonb;not null"`
+	Status        string    `gorm:"size:20;not null;index:idx_outbox_messages_due,priority:1"`
+	Attempts      int       `gorm:"not null"`
+	MaxAttempts   int       `gorm:"not null"`
+	NextAttemptAt time.Time `gorm:"not null;index:idx_outbox_messages_due,priority:2"`
+	LockedUntil   *time.Time
+	LastError     *string `gorm:"type:text"`
+	DeliveredAt   *time.Time
+	CreatedAt     time.Time `gorm:"autoCreateTime"`
+	UpdatedAt     time.Time `gorm:"autoUpdateTime"`
+}
+
+func (OutboxMessage) TableName() string {
+	return "outbox_messages"
+}
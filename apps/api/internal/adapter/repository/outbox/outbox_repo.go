@@ -0,0 +1,102 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ou "gogym-api/internal/application/outbox"
+	domain "gogym-api/internal/domain/entities/outbox"
+	"gogym-api/internal/infra/db"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type outboxRepository struct {
+	db *gorm.DB
+}
+
+func NewOutboxRepository(db *gorm.DB) ou.Repository {
+	return &outboxRepository{db: db}
+}
+
+// Enqueue はメッセージを登録する
+// コンテキストにトランザクションがあれば、呼び出し元の書き込みと同じトランザクションで登録される
+func (r *outboxRepository) Enqueue(ctx context.Context, m *domain.Message) error {
+	record := FromEntity(m)
+	record.ID = 0
+
+	if err := db.Conn(ctx, r.db).Create(record).Error; err != nil {
+		return fmt.Errorf("failed to enqueue outbox message: %w", err)
+	}
+
+	m.ID = record.ID
+	return nil
+}
+
+// ClaimDue は配信対象のメッセージを SKIP LOCKED で取得し、処理中に更新する
+// 複数インスタンスで動かしても同じメッセージを二重に処理しない
+func (r *outboxRepository) ClaimDue(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]domain.Message, error) {
+	var records []OutboxMessage
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.
+			Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("(status = ? AND next_attempt_at <= ?) OR (status = ? AND locked_until < ?)",
+				string(domain.StatusPending), now, string(domain.StatusProcessing), now).
+			Order("next_attempt_at ASC, id ASC").
+			Limit(limit).
+			Find(&records).Error
+		if err != nil {
+			return fmt.Errorf("failed to select due outbox messages: %w", err)
+		}
+		if len(records) == 0 {
+			return nil
+		}
+
+		ids := make([]int64, 0, len(records))
+		for _, rec := range records {
+			ids = append(ids, rec.ID)
+		}
+
+		err = tx.Model(&OutboxMessage{}).
+			Where("id IN ?", ids).
+			Updates(map[string]interface{}{
+				"status":       string(domain.StatusProcessing),
+				"locked_until": now.Add(lease),
+			}).Error
+		if err != nil {
+			return fmt.Errorf("failed to lock outbox messages: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	messages := make([]domain.Message, 0, len(records))
+	for i := range records {
+		messages = append(messages, *ToEntity(&records[i]))
+	}
+	return messages, nil
+}
+
+// Save は配信結果（状態・試行回数・次回試行時刻）を保存し、ロックを解放する
+func (r *outboxRepository) Save(ctx context.Context, m *domain.Message) error {
+	err := r.db.WithContext(ctx).
+		Model(&OutboxMessage{ID: m.ID}).
+		Updates(map[string]interface{}{
+			"status":          string(m.Status),
+			"attempts":        m.Attempts,
+			"next_attempt_at": m.NextAttemptAt,
+			"locked_until":    nil,
+			"last_error":      m.LastError,
+			"delivered_at":    m.DeliveredAt,
+		}).Error
+	if err != nil {
+		return fmt.Errorf("failed to save outbox message: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package program
+
+import (
+	workoutrepo "gogym-api/internal/adapter/repository/workout"
+	dom "gogym-api/internal/domain/entities"
+	dp "gogym-api/internal/domain/entities/program"
+)
+
+// ToEntity converts TrainingProgram to dp.Program
+func ToEntity(rec *TrainingProgram) dp.Program {
+	p := dp.Program{
+		ID:        dom.ID(rec.ID),
+		UserID:    dom.ULID(rec.UserID),
+		Name:      rec.Name,
+		Note:      rec.Note,
+		Weeks:     rec.Weeks,
+		Days:      make([]dp.ProgramDay, 0, len(rec.Days)),
+		CreatedAt: rec.CreatedAt,
+		UpdatedAt: rec.UpdatedAt,
+	}
+	for _, d := range rec.Days {
+		day := dp.ProgramDay{
+			ID:            dom.ID(d.ID),
+			Week:          d.Week,
+			Day:           d.Day,
+			TemplateID:    dom.ID(d.WorkoutTemplateID),
+			TemplateName:  d.Template.Name,
+			Prescriptions: make([]dp.Prescription, 0, len(d.Prescriptions)),
+		}
+		for _, rx := range d.Prescriptions {
+			exercise := workoutrepo.WorkoutExerciseToDomain(&rx.Exercise)
+			exercise.ID = dom.ID(rx.WorkoutExerciseID)
+			day.Prescriptions = append(day.Prescriptions, dp.Prescription{
+				ID:           dom.ID(rx.ID),
+				Exercise:     exercise,
+				SetNumber:    rx.SetNumber,
+				PercentOfMax: rx.PercentOfMax,
+				Reps:         rx.Reps,
+				AMRAP:        rx.AMRAP,
+			})
+		}
+		p.Days = append(p.Days, day)
+	}
+	return p
+}
+
+// FromEntity converts dp.Program to TrainingProgram（日程は含めない）
+func FromEntity(p dp.Program) TrainingProgram {
+	return TrainingProgram{
+		ID:     int(p.ID),
+		UserID: string(p.UserID),
+		Name:   p.Name,
+		Note:   p.Note,
+		Weeks:  p.Weeks,
+	}
+}
+
+// PrescriptionsFromEntity converts dp.Prescription list to TrainingProgramPrescription list
+func PrescriptionsFromEntity(dayID int, prescriptions []dp.Prescription) []TrainingProgramPrescription {
+	recs := make([]TrainingProgramPrescription, 0, len(prescriptions))
+	for _, rx := range prescriptions {
+		recs = append(recs, TrainingProgramPrescription{
+			TrainingProgramDayID: dayID,
+			WorkoutExerciseID:    int(rx.Exercise.ID),
+			SetNumber:            rx.SetNumber,
+			PercentOfMax:         rx.PercentOfMax,
+			Reps:                 rx.Reps,
+			AMRAP:                rx.AMRAP,
+		})
+	}
+	return recs
+}
+
+// EnrollmentToEntity converts ProgramEnrollment to dp.Enrollment
+func EnrollmentToEntity(rec *ProgramEnrollment) dp.Enrollment {
+	return dp.Enrollment{
+		ID:        dom.ID(rec.ID),
+		UserID:    dom.ULID(rec.UserID),
+		Program:   ToEntity(&rec.Program),
+		StartDate: rec.StartDate,
+		EndedAt:   rec.EndedAt,
+		CreatedAt: rec.CreatedAt,
+	}
+}
@@ -0,0 +1,79 @@
+package program
+
+import (
+	"time"
+
+	templaterepo "gogym-api/internal/adapter/repository/template"
+	workoutrepo "gogym-api/internal/adapter/repository/workout"
+
+	"gorm.io/gorm"
+)
+
+type TrainingProgram struct {
+	ID        int    `gorm:"primaryKey;autoIncrement"`
+	UserID    string `gorm:"index"`
+	Name      string
+	Note      *string
+	Weeks     int
+	CreatedAt time.Time      `gorm:"autoCreateTime"`
+	UpdatedAt time.Time      `gorm:"autoUpdateTime"`
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+
+	Days []TrainingProgramDay `gorm:"foreignKey:TrainingProgramID"`
+}
+
+func (TrainingProgram) TableName() string {
+	return "training_programs"
+}
+
+type TrainingProgramDay struct {
+	ID                int `gorm:"primaryKey;autoIncrement"`
+	TrainingProgramID int `gorm:"index"`
+	Week              int
+	Day               int
+	WorkoutTemplateID int
+	CreatedAt         time.Time `gorm:"autoCreateTime"`
+	UpdatedAt         time.Time `gorm:"autoUpdateTime"`
+
+	Template      templaterepo.WorkoutTemplate  `gorm:"foreignKey:WorkoutTemplateID"`
+	Prescriptions []TrainingProgramPrescription `gorm:"foreignKey:TrainingProgramDayID"`
+}
+
+func (TrainingProgramDay) TableName() string {
+	return "training_program_days"
+}
+
+type TrainingProgramPrescription struct {
+	ID                   int `gorm:"primaryKey;autoIncrement"`
+	TrainingProgramDayID int `gorm:"index"`
+	WorkoutExerciseID    int
+	SetNumber            int
+	PercentOfMax         float64
+	Reps                 int
+	AMRAP                bool      `gorm:"column:amrap"`
+	CreatedAt            time.Time `gorm:"autoCreateTime"`
+	UpdatedAt            time.Time `gorm:"autoUpdateTime"`
+
+	Exercise workoutrepo.WorkoutExercise `gorm:"foreignKey:WorkoutExerciseID"`
+}
+
+func (TrainingProgramPrescription) TableName() string {
+	return "training_program_prescriptions"
+}
+
+// ProgramEnrollment はプログラムへの参加（ended_at が NULL のものが進行中）
+type ProgramEnrollment struct {
+	ID                int    `gorm:"primaryKey;autoIncrement"`
+	UserID            string `gorm:"index"`
+	TrainingProgramID int
+	StartDate         time.Time `gorm:"type:date"`
+	EndedAt           *time.Time
+	CreatedAt         time.Time `gorm:"autoCreateTime"`
+	UpdatedAt         time.Time `gorm:"autoUpdateTime"`
+
+	Program TrainingProgram `gorm:"foreignKey:TrainingProgramID"`
+}
+
+func (ProgramEnrollment) TableName() string {
+	return "program_enrollments"
+}
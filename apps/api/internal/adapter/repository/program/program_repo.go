@@ -0,0 +1,215 @@
+package program
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	pu "gogym-api/internal/application/program"
+	dom "gogym-api/internal/domain/entities"
+	dp "gogym-api/internal/domain/entities/program"
+
+	"gorm.io/gorm"
+)
+
+type programRepository struct {
+	db *gorm.DB
+}
+
+func NewProgramRepository(db *gorm.DB) pu.Repository {
+	return &programRepository{db: db}
+}
+
+// preloadProgram はプログラムの表示に必要な日程・テンプレート名・処方の種目と翻訳をプリロードする
+// prefix はプログラムを関連として読む場合の関連名（"Program." など）
+func preloadProgram(db *gorm.DB, prefix string) *gorm.DB {
+	return db.
+		Preload(prefix+"Days", func(db *gorm.DB) *gorm.DB {
+			return db.Order("training_program_days.week ASC, training_program_days.day ASC")
+		}).
+		Preload(prefix+"Days.Template").
+		Preload(prefix+"Days.Prescriptions", func(db *gorm.DB) *gorm.DB {
+			return db.Order("training_program_prescriptions.id ASC")
+		}).
+		Preload(prefix + "Days.Prescriptions.Exercise").
+		Preload(prefix + "Days.Prescriptions.Exercise.Translations")
+}
+
+// ListPrograms はユーザーのプログラムを名前順に取得
+func (r *programRepository) ListPrograms(ctx context.Context, userID string) ([]dp.Program, error) {
+	var recs []TrainingProgram
+	if err := preloadProgram(r.db.WithContext(ctx), "").
+		Where("user_id = ?", userID).
+		Order("name ASC, id ASC").
+		Find(&recs).Error; err != nil {
+		return nil, fmt.Errorf("error fetching training programs: %w", err)
+	}
+
+	programs := make([]dp.Program, 0, len(recs))
+	for i := range recs {
+		programs = append(programs, ToEntity(&recs[i]))
+	}
+	return programs, nil
+}
+
+// FindProgram はユーザーのプログラムを1件取得
+func (r *programRepository) FindProgram(ctx context.Context, userID string, programID dom.ID) (dp.Program, error) {
+	var rec TrainingProgram
+	err := preloadProgram(r.db.WithContext(ctx), "").
+		Where("user_id = ? AND id = ?", userID, int(programID)).
+		First(&rec).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return dp.Program{}, dp.ErrProgramNotFound
+	}
+	if err != nil {
+		return dp.Program{}, fmt.Errorf("error fetching training program: %w", err)
+	}
+	return ToEntity(&rec), nil
+}
+
+// CreateProgram はプログラムと日程・処方を作成し、採番されたIDを返す
+func (r *programRepository) CreateProgram(ctx context.Context, program dp.Program) (dom.ID, error) {
+	rec := FromEntity(program)
+	rec.ID = 0
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Days").Create(&rec).Error; err != nil {
+			return fmt.Errorf("failed to create training program: %w", err)
+		}
+		return insertDays(tx, rec.ID, program.Days)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return dom.ID(rec.ID), nil
+}
+
+// UpdateProgram はプログラムの名前・メモ・週数を更新し、日程を置き換える
+func (r *programRepository) UpdateProgram(ctx context.Context, program dp.Program) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Model(&TrainingProgram{}).
+			Where("user_id = ? AND id = ?", string(program.UserID), int(program.ID)).
+			Updates(map[string]interface{}{"name": program.Name, "note": program.Note, "weeks": program.Weeks})
+		if res.Error != nil {
+			return fmt.Errorf("failed to update training program: %w", res.Error)
+		}
+		if res.RowsAffected == 0 {
+			return dp.ErrProgramNotFound
+		}
+
+		// 処方は ON DELETE CASCADE で日程と一緒に消える
+		if err := tx.Where("training_program_id = ?", int(program.ID)).
+			Delete(&TrainingProgramDay{}).Error; err != nil {
+			return fmt.Errorf("failed to delete program days: %w", err)
+		}
+		return insertDays(tx, int(program.ID), program.Days)
+	})
+}
+
+// DeleteProgram はユーザーのプログラムを論理削除し、進行中なら終了する
+func (r *programRepository) DeleteProgram(ctx context.Context, userID string, programID dom.ID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Where("user_id = ? AND id = ?", userID, int(programID)).
+			Delete(&TrainingProgram{})
+		if res.Error != nil {
+			return fmt.Errorf("error deleting training program: %w", res.Error)
+		}
+		if res.RowsAffected == 0 {
+			return dp.ErrProgramNotFound
+		}
+
+		if err := tx.Model(&ProgramEnrollment{}).
+			Where("user_id = ? AND training_program_id = ? AND ended_at IS NULL", userID, int(programID)).
+			Update("ended_at", time.Now().UTC()).Error; err != nil {
+			return fmt.Errorf("failed to end program enrollment: %w", err)
+		}
+		return nil
+	})
+}
+
+// FindActiveEnrollment はユーザーの進行中のプログラムを取得
+func (r *programRepository) FindActiveEnrollment(ctx context.Context, userID string) (dp.Enrollment, error) {
+	var rec ProgramEnrollment
+	err := preloadProgram(r.db.WithContext(ctx).Preload("Program"), "Program.").
+		Where("user_id = ? AND ended_at IS NULL", userID).
+		First(&rec).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return dp.Enrollment{}, dp.ErrEnrollmentNotFound
+	}
+	if err != nil {
+		return dp.Enrollment{}, fmt.Errorf("error fetching program enrollment: %w", err)
+	}
+	return EnrollmentToEntity(&rec), nil
+}
+
+// Enroll は進行中のプログラムを終了し、新しい参加を作成する
+func (r *programRepository) Enroll(ctx context.Context, enrollment dp.Enrollment) (dom.ID, error) {
+	rec := ProgramEnrollment{
+		UserID:            string(enrollment.UserID),
+		TrainingProgramID: int(enrollment.Program.ID),
+		StartDate:         enrollment.StartDate,
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := endActiveEnrollment(tx, rec.UserID); err != nil {
+			return err
+		}
+		if err := tx.Omit("Program").Create(&rec).Error; err != nil {
+			return fmt.Errorf("failed to create program enrollment: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return dom.ID(rec.ID), nil
+}
+
+// EndEnrollment はユーザーの進行中のプログラムを終了する
+func (r *programRepository) EndEnrollment(ctx context.Context, userID string) error {
+	res := r.db.WithContext(ctx).Model(&ProgramEnrollment{}).
+		Where("user_id = ? AND ended_at IS NULL", userID).
+		Update("ended_at", time.Now().UTC())
+	if res.Error != nil {
+		return fmt.Errorf("failed to end program enrollment: %w", res.Error)
+	}
+	if res.RowsAffected == 0 {
+		return dp.ErrEnrollmentNotFound
+	}
+	return nil
+}
+
+func endActiveEnrollment(tx *gorm.DB, userID string) error {
+	if err := tx.Model(&ProgramEnrollment{}).
+		Where("user_id = ? AND ended_at IS NULL", userID).
+		Update("ended_at", time.Now().UTC()).Error; err != nil {
+		return fmt.Errorf("failed to end program enrollment: %w", err)
+	}
+	return nil
+}
+
+func insertDays(tx *gorm.DB, programID int, days []dp.ProgramDay) error {
+	for _, d := range days {
+		rec := TrainingProgramDay{
+			TrainingProgramID: programID,
+			Week:              d.Week,
+			Day:               d.Day,
+			WorkoutTemplateID: int(d.TemplateID),
+		}
+		if err := tx.Omit("Template", "Prescriptions").Create(&rec).Error; err != nil {
+			return fmt.Errorf("failed to insert program day: %w", err)
+		}
+
+		prescriptions := PrescriptionsFromEntity(rec.ID, d.Prescriptions)
+		if len(prescriptions) == 0 {
+			continue
+		}
+		if err := tx.Omit("Exercise").Create(&prescriptions).Error; err != nil {
+			return fmt.Errorf("failed to insert program prescriptions: %w", err)
+		}
+	}
+	return nil
+}
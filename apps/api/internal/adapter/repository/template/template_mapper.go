@@ -0,0 +1,63 @@
+package template
+
+import (
+	workoutrepo "gogym-api/internal/adapter/repository/workout"
+	dom "gogym-api/internal/domain/entities"
+	dt "gogym-api/internal/domain/entities/template"
+)
+
+// ToEntity converts WorkoutTemplate to dt.Template
+func ToEntity(rec *WorkoutTemplate) dt.Template {
+	t := dt.Template{
+		ID:        dom.ID(rec.ID),
+		UserID:    dom.ULID(rec.UserID),
+		Name:      rec.Name,
+		Note:      rec.Note,
+		Exercises: make([]dt.TemplateExercise, 0, len(rec.Exercises)),
+		CreatedAt: rec.CreatedAt,
+		UpdatedAt: rec.UpdatedAt,
+	}
+	for _, ex := range rec.Exercises {
+		exercise := workoutrepo.WorkoutExerciseToDomain(&ex.Exercise)
+		exercise.ID = dom.ID(ex.WorkoutExerciseID)
+		t.Exercises = append(t.Exercises, dt.TemplateExercise{
+			ID:             dom.ID(ex.ID),
+			Exercise:       exercise,
+			Position:       ex.Position,
+			TargetSets:     ex.TargetSets,
+			RepsMin:        ex.RepsMin,
+			RepsMax:        ex.RepsMax,
+			TargetWeightKg: ex.TargetWeightKg,
+			Note:           ex.Note,
+		})
+	}
+	return t
+}
+
+// FromEntity converts dt.Template to WorkoutTemplate（種目は含めない）
+func FromEntity(t dt.Template) WorkoutTemplate {
+	return WorkoutTemplate{
+		ID:     int(t.ID),
+		UserID: string(t.UserID),
+		Name:   t.Name,
+		Note:   t.Note,
+	}
+}
+
+// ExercisesFromEntity converts dt.TemplateExercise list to WorkoutTemplateExercise list
+func ExercisesFromEntity(templateID int, exercises []dt.TemplateExercise) []WorkoutTemplateExercise {
+	recs := make([]WorkoutTemplateExercise, 0, len(exercises))
+	for _, ex := range exercises {
+		recs = append(recs, WorkoutTemplateExercise{
+			WorkoutTemplateID: templateID,
+			WorkoutExerciseID: int(ex.Exercise.ID),
+			Position:          ex.Position,
+			TargetSets:        ex.TargetSets,
+			RepsMin:           ex.RepsMin,
+			RepsMax:           ex.RepsMax,
+			TargetWeightKg:    ex.TargetWeightKg,
+			Note:              ex.Note,
+		})
+	}
+	return recs
+}
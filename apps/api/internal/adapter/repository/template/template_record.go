@@ -0,0 +1,45 @@
+package template
+
+import (
+	"time"
+
+	workoutrepo "gogym-api/internal/adapter/repository/workout"
+
+	"gorm.io/gorm"
+)
+
+type WorkoutTemplate struct {
+	ID        int    `gorm:"primaryKey;autoIncrement"`
+	UserID    string `gorm:"index"`
+	Name      string
+	Note      *string
+	CreatedAt time.Time      `gorm:"autoCreateTime"`
+	UpdatedAt time.Time      `gorm:"autoUpdateTime"`
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+
+	Exercises []WorkoutTemplateExercise `gorm:"foreignKey:WorkoutTemplateID"`
+}
+
+func (WorkoutTemplate) TableName() string {
+	return "workout_templates"
+}
+
+type WorkoutTemplateExercise struct {
+	ID                int `gorm:"primaryKey;autoIncrement"`
+	WorkoutTemplateID int `gorm:"index"`
+	WorkoutExerciseID int
+	Position          int
+	TargetSets        int
+	RepsMin           int
+	RepsMax           int
+	TargetWeightKg    *float64
+	Note              *string
+	CreatedAt         time.Time `gorm:"autoCreateTime"`
+	UpdatedAt         time.Time `gorm:"autoUpdateTime"`
+
+	Exercise workoutrepo.WorkoutExercise `gorm:"foreignKey:WorkoutExerciseID"`
+}
+
+func (WorkoutTemplateExercise) TableName() string {
+	return "workout_template_exercises"
+}
@@ -0,0 +1,155 @@
+package template
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	tu "gogym-api/internal/application/template"
+	dom "gogym-api/internal/domain/entities"
+	dt "gogym-api/internal/domain/entities/template"
+	dw "gogym-api/internal/domain/entities/workout"
+
+	workoutrepo "gogym-api/internal/adapter/repository/workout"
+
+	"gorm.io/gorm"
+)
+
+type templateRepository struct {
+	db *gorm.DB
+}
+
+func NewTemplateRepository(db *gorm.DB) tu.Repository {
+	return &templateRepository{db: db}
+}
+
+// preloadTemplate はテンプレートの表示に必要な種目と翻訳をプリロードする
+func preloadTemplate(db *gorm.DB) *gorm.DB {
+	return db.
+		Preload("Exercises", func(db *gorm.DB) *gorm.DB {
+			return db.Order("workout_template_exercises.position ASC")
+		}).
+		Preload("Exercises.Exercise").
+		Preload("Exercises.Exercise.Translations")
+}
+
+// ListTemplates はユーザーのテンプレートを名前順に取得
+func (r *templateRepository) ListTemplates(ctx context.Context, userID string) ([]dt.Template, error) {
+	var recs []WorkoutTemplate
+	if err := preloadTemplate(r.db.WithContext(ctx)).
+		Where("user_id = ?", userID).
+		Order("name ASC, id ASC").
+		Find(&recs).Error; err != nil {
+		return nil, fmt.Errorf("error fetching workout templates: %w", err)
+	}
+
+	templates := make([]dt.Template, 0, len(recs))
+	for i := range recs {
+		templates = append(templates, ToEntity(&recs[i]))
+	}
+	return templates, nil
+}
+
+// FindTemplate はユーザーのテンプレートを1件取得
+func (r *templateRepository) FindTemplate(ctx context.Context, userID string, templateID dom.ID) (dt.Template, error) {
+	var rec WorkoutTemplate
+	err := preloadTemplate(r.db.WithContext(ctx)).
+		Where("user_id = ? AND id = ?", userID, int(templateID)).
+		First(&rec).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return dt.Template{}, dt.ErrTemplateNotFound
+	}
+	if err != nil {
+		return dt.Template{}, fmt.Errorf("error fetching workout template: %w", err)
+	}
+	return ToEntity(&rec), nil
+}
+
+// CreateTemplate はテンプレートと種目を作成し、採番されたIDを返す
+func (r *templateRepository) CreateTemplate(ctx context.Context, template dt.Template) (dom.ID, error) {
+	rec := FromEntity(template)
+	rec.ID = 0
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := checkExercises(tx, string(template.UserID), template.ExerciseIDs()); err != nil {
+			return err
+		}
+		if err := tx.Create(&rec).Error; err != nil {
+			return fmt.Errorf("failed to create workout template: %w", err)
+		}
+		return insertExercises(tx, rec.ID, template.Exercises)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return dom.ID(rec.ID), nil
+}
+
+// UpdateTemplate はテンプレートの名前・メモを更新し、種目を置き換える
+func (r *templateRepository) UpdateTemplate(ctx context.Context, template dt.Template) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Model(&WorkoutTemplate{}).
+			Where("user_id = ? AND id = ?", string(template.UserID), int(template.ID)).
+			Updates(map[string]interface{}{"name": template.Name, "note": template.Note})
+		if res.Error != nil {
+			return fmt.Errorf("failed to update workout template: %w", res.Error)
+		}
+		if res.RowsAffected == 0 {
+			return dt.ErrTemplateNotFound
+		}
+
+		if err := checkExercises(tx, string(template.UserID), template.ExerciseIDs()); err != nil {
+			return err
+		}
+		if err := tx.Where("workout_template_id = ?", int(template.ID)).
+			Delete(&WorkoutTemplateExercise{}).Error; err != nil {
+			return fmt.Errorf("failed to delete template exercises: %w", err)
+		}
+		return insertExercises(tx, int(template.ID), template.Exercises)
+	})
+}
+
+// DeleteTemplate はユーザーのテンプレートを論理削除
+func (r *templateRepository) DeleteTemplate(ctx context.Context, userID string, templateID dom.ID) error {
+	res := r.db.WithContext(ctx).
+		Where("user_id = ? AND id = ?", userID, int(templateID)).
+		Delete(&WorkoutTemplate{})
+	if res.Error != nil {
+		return fmt.Errorf("error deleting workout template: %w", res.Error)
+	}
+	if res.RowsAffected == 0 {
+		return dt.ErrTemplateNotFound
+	}
+	return nil
+}
+
+// checkExercises はテンプレートの種目がプリセットまたはユーザー自身の種目であることを確認する
+func checkExercises(tx *gorm.DB, userID string, exerciseIDs []dom.ID) error {
+	ids := make([]int, 0, len(exerciseIDs))
+	for _, id := range exerciseIDs {
+		ids = append(ids, int(id))
+	}
+
+	var count int64
+	if err := tx.Model(&workoutrepo.WorkoutExercise{}).
+		Where("id IN ? AND (user_id IS NULL OR user_id = ?)", ids, userID).
+		Count(&count).Error; err != nil {
+		return fmt.Errorf("error finding workout exercises: %w", err)
+	}
+	if int(count) != len(ids) {
+		return dw.ErrExerciseNotFound
+	}
+	return nil
+}
+
+func insertExercises(tx *gorm.DB, templateID int, exercises []dt.TemplateExercise) error {
+	recs := ExercisesFromEntity(templateID, exercises)
+	if len(recs) == 0 {
+		return nil
+	}
+	if err := tx.Omit("Exercise").Create(&recs).Error; err != nil {
+		return fmt.Errorf("failed to insert template exercises: %w", err)
+	}
+	return nil
+}
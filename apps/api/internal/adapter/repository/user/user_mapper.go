@@ -1,6 +1,7 @@
 package user
 
 import (
+	dom "gogym-api/internal/domain/entities"
 	domain "gogym-api/internal/domain/entities/user"
 
 	"github.com/oklog/ulid/v2"
@@ -17,13 +18,26 @@ func ToEntity(r *User) (*domain.User, error) {
 		return nil, err
 	}
 
-	return domain.NewUser(
+	u := domain.NewUser(
 		id,
 		r.Name,
 		r.Email,
 		r.PasswordHash,
 		r.CreatedAt,
-	), nil
+	)
+	if u != nil && r.Locale != nil {
+		u.Locale = *r.Locale
+	}
+	if u != nil && r.TimeZone != nil {
+		u.TimeZone = *r.TimeZone
+	}
+	if u != nil && r.WeightUnit != nil {
+		u.WeightUnits.Unit = dom.WeightUnit(*r.WeightUnit)
+	}
+	if u != nil && r.WeightIncrement != nil {
+		u.WeightUnits.Increment = *r.WeightIncrement
+	}
+	return u, nil
 }
 
 // FromEntity converts domain entity to User record
@@ -32,13 +46,38 @@ func FromEntity(u *domain.User) *User {
 		return nil
 	}
 
+	var locale *string
+	if u.Locale != "" {
+		l := u.Locale
+		locale = &l
+	}
+	var timeZone *string
+	if u.TimeZone != "" {
+		tz := u.TimeZone
+		timeZone = &tz
+	}
+	var weightUnit *string
+	if u.WeightUnits.Unit != "" {
+		wu := string(u.WeightUnits.Unit)
+		weightUnit = &wu
+	}
+	var weightIncrement *float64
+	if u.WeightUnits.Increment > 0 {
+		inc := u.WeightUnits.Increment
+		weightIncrement = &inc
+	}
+
 	return &User{
-		ID:           u.ID.String(),
-		Email:        u.Email,
-		PasswordHash: u.PasswordHash,
-		Name:         u.Name,
-		CreatedAt:    u.CreatedAt,
-		UpdatedAt:    u.UpdatedAt,
+		ID:              u.ID.String(),
+		Email:           u.Email,
+		PasswordHash:    u.PasswordHash,
+		Name:            u.Name,
+		Locale:          locale,
+		TimeZone:        timeZone,
+		WeightUnit:      weightUnit,
+		WeightIncrement: weightIncrement,
+		CreatedAt:       u.CreatedAt,
+		UpdatedAt:       u.UpdatedAt,
 	}
 }
 
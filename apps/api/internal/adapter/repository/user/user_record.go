@@ -7,13 +7,17 @@ import (
 )
 
 type User struct {
-	ID           string         `gorm:"primaryKey;type:char(26)"` // ULID用
-	Email        string         `gorm:"unique;not null;index"`
-	PasswordHash string         `gorm:"not null"`
-	Name         string         `gorm:"not null;column:name"`
-	CreatedAt    time.Time      `gorm:"autoCreateTime"`
-	UpdatedAt    time.Time      `gorm:"autoUpdateTime"`
-	DeletedAt    gorm.DeletedAt `gorm:"index"`
+	ID              string         `gorm:"primaryKey;type:char(26)"` // ULID用
+	Email           string         `gorm:"unique;not null;index"`
+	PasswordHash    string         `gorm:"not null"`
+	Name            string         `gorm:"not null;column:name"`
+	Locale          *string        // NULL なら Accept-Language に従う
+	TimeZone        *string        // NULL ならクライアントの指定・デフォルトに従う
+	WeightUnit      *string        // NULL なら kg
+	WeightIncrement *float64       // weight_unit での丸め幅（NULL なら単位ごとの既定値）
+	CreatedAt       time.Time      `gorm:"autoCreateTime"`
+	UpdatedAt       time.Time      `gorm:"autoUpdateTime"`
+	DeletedAt       gorm.DeletedAt `gorm:"index"`
 }
 
 // TableName specifies the table name for GORM
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	dom "gogym-api/internal/domain/entities/user"
+	"gogym-api/internal/infra/db"
 
 	"github.com/oklog/ulid/v2"
 	"gorm.io/gorm"
@@ -21,7 +22,7 @@ func NewUserRepository(db *gorm.DB) *UserRepository {
 func (r *UserRepository) Create(ctx context.Context, user *dom.User) error {
 	recordUser := FromEntity(user)
 
-	result := r.db.WithContext(ctx).Create(recordUser)
+	result := db.Conn(ctx, r.db).Create(recordUser)
 	if result.Error != nil {
 		return result.Error
 	}
@@ -63,6 +64,21 @@ func (r *UserRepository) FindByEmail(ctx context.Context, email string) (*dom.Us
 	return ToEntity(&recordUser)
 }
 
+// UpdatePreferences はユーザーの表示設定を更新する
+func (r *UserRepository) UpdatePreferences(ctx context.Context, user *dom.User) error {
+	recordUser := FromEntity(user)
+
+	return db.Conn(ctx, r.db).
+		Model(&User{ID: recordUser.ID}).
+		Updates(map[string]interface{}{
+			"locale":           recordUser.Locale,
+			"time_zone":        recordUser.TimeZone,
+			"weight_unit":      recordUser.WeightUnit,
+			"weight_increment": recordUser.WeightIncrement,
+			"updated_at":       recordUser.UpdatedAt,
+		}).Error
+}
+
 func (r *UserRepository) ExistsByEmail(ctx context.Context, email string) (bool, error) {
 	var count int64
 	result := r.db.WithContext(ctx).
@@ -1,8 +1,10 @@
 package workout
 
 import (
-	dw "gogym-api/internal/domain/entities/workout"
+	"strings"
+
 	dom "gogym-api/internal/domain/entities"
+	dw "gogym-api/internal/domain/entities/workout"
 )
 
 func ToEntity(rec *WorkoutRecord) *dw.WorkoutRecord {
@@ -20,6 +22,7 @@ func ToEntity(rec *WorkoutRecord) *dw.WorkoutRecord {
 		UserID:        dom.ULID(rec.UserID),
 		GymID:         int64PtrToDomainIDPtr(rec.GymID),
 		GymName:       gymName,
+		TemplateID:    int64PtrToDomainIDPtr(rec.TemplateID),
 		PerformedDate: rec.PerformedDate,
 		StartedAt:     rec.StartedAt,
 		EndedAt:       rec.EndedAt,
@@ -34,28 +37,40 @@ func ToEntity(rec *WorkoutRecord) *dw.WorkoutRecord {
 	for _, s := range rec.Sets {
 		domainRecord.Sets = append(domainRecord.Sets, WorkoutSetToDomain(&s))
 	}
+	domainRecord.DeriveRest()
 
 	return domainRecord
 }
 
 func WorkoutSetToDomain(s *WorkoutSet) dw.WorkoutSet {
-	exerciseRef := dw.WorkoutExerciseRef{
-		ID:     dom.ID(s.WorkoutExerciseID),
-		Name:   s.Exercise.Name,
-		PartID: intPtrToDomainIDPtr(s.Exercise.WorkoutPartID),
-		Owner:  stringPtrToULIDPtr(s.Exercise.UserID),
+	exerciseRef := WorkoutExerciseToDomain(&s.Exercise)
+	exerciseRef.ID = dom.ID(s.WorkoutExerciseID)
+
+	var bodyweight *dw.WeightKg
+	if s.BodyweightKg != nil {
+		bw := dw.WeightKg(*s.BodyweightKg)
+		bodyweight = &bw
 	}
 
 	return dw.WorkoutSet{
-		ID:           ptrInt64ToDomainID(int64(s.ID)),
-		Exercise:     exerciseRef,
-		SetNumber:    s.SetNumber,
-		Weight:       dw.WeightKg(s.WeightKg),
-		Reps:         dw.Reps(s.Reps),
-		EstimatedMax: s.EstimatedMax,
-		Note:         s.Note,
-		CreatedAt:    s.CreatedAt,
-		UpdatedAt:    s.UpdatedAt,
+		ID:            ptrInt64ToDomainID(int64(s.ID)),
+		Exercise:      exerciseRef,
+		SetNumber:     s.SetNumber,
+		Kind:          dw.SetKind(s.SetKind),
+		Weight:        dw.WeightKg(s.WeightKg),
+		Reps:          dw.Reps(s.Reps),
+		DurationSec:   s.DurationSec,
+		DistanceM:     s.DistanceM,
+		BodyweightKg:  bodyweight,
+		Type:          dw.SetType(s.SetType),
+		RPE:           s.RPE,
+		RIR:           s.RIR,
+		SupersetGroup: s.SupersetGroup,
+		EstimatedMax:  s.EstimatedMax,
+		CompletedAt:   s.CompletedAt,
+		Note:          s.Note,
+		CreatedAt:     s.CreatedAt,
+		UpdatedAt:     s.UpdatedAt,
 	}
 }
 
@@ -68,6 +83,7 @@ func FromEntity(domainRecord *dw.WorkoutRecord) *WorkoutRecord {
 	rec := &WorkoutRecord{
 		UserID:          string(domainRecord.UserID),
 		GymID:           domainIDPtrToInt64Ptr(domainRecord.GymID),
+		TemplateID:      domainIDPtrToInt64Ptr(domainRecord.TemplateID),
 		PerformedDate:   domainRecord.PerformedDate,
 		StartedAt:       domainRecord.StartedAt,
 		EndedAt:         domainRecord.EndedAt,
@@ -93,12 +109,25 @@ func WorkoutSetToRecord(domainSet *dw.WorkoutSet, workoutRecordID int) WorkoutSe
 		WorkoutRecordID:   workoutRecordID,
 		WorkoutExerciseID: int(domainSet.Exercise.ID),
 		SetNumber:         domainSet.SetNumber,
+		SetKind:           string(domainSet.KindOrDefault()),
 		WeightKg:          float64(domainSet.Weight),
 		Reps:              int(domainSet.Reps),
+		DurationSec:       domainSet.DurationSec,
+		DistanceM:         domainSet.DistanceM,
+		SetType:           string(domainSet.TypeOrDefault()),
+		RPE:               domainSet.RPE,
+		RIR:               domainSet.RIR,
+		SupersetGroup:     domainSet.SupersetGroup,
 		EstimatedMax:      domainSet.EstimatedMax,
+		CompletedAt:       domainSet.CompletedAt,
 		Note:              domainSet.Note,
 	}
 
+	if domainSet.BodyweightKg != nil {
+		bw := float64(*domainSet.BodyweightKg)
+		recSet.BodyweightKg = &bw
+	}
+
 	if domainSet.ID != nil {
 		recSet.ID = int(*domainSet.ID)
 	}
@@ -125,25 +154,8 @@ func WorkoutPartToDomain(rec *WorkoutPart) *dw.WorkoutPart {
 
 	// Exercisesを変換
 	exercises := make([]dw.WorkoutExerciseRef, 0, len(rec.Exercises))
-	for _, ex := range rec.Exercises {
-		var partIDPtr *dom.ID
-		if ex.WorkoutPartID != nil {
-			pid := dom.ID(*ex.WorkoutPartID)
-			partIDPtr = &pid
-		}
-
-		var ownerPtr *dom.ULID
-		if ex.UserID != nil {
-			owner := dom.ULID(*ex.UserID)
-			ownerPtr = &owner
-		}
-
-		exercises = append(exercises, dw.WorkoutExerciseRef{
-			ID:     dom.ID(ex.ID),
-			Name:   ex.Name,
-			PartID: partIDPtr,
-			Owner:  ownerPtr,
-		})
+	for i := range rec.Exercises {
+		exercises = append(exercises, WorkoutExerciseToDomain(&rec.Exercises[i]))
 	}
 
 	return &dw.WorkoutPart{
@@ -155,6 +167,55 @@ func WorkoutPartToDomain(rec *WorkoutPart) *dw.WorkoutPart {
 	}
 }
 
+// WorkoutExerciseToDomain converts WorkoutExercise to dw.WorkoutExerciseRef
+func WorkoutExerciseToDomain(ex *WorkoutExercise) dw.WorkoutExerciseRef {
+	translations := make([]dw.WorkoutExerciseTranslation, 0, len(ex.Translations))
+	for _, t := range ex.Translations {
+		translations = append(translations, dw.WorkoutExerciseTranslation{
+			ID:                dom.ID(t.ID),
+			WorkoutExerciseID: dom.ID(t.WorkoutExerciseID),
+			Locale:            t.Locale,
+			Name:              t.Name,
+		})
+	}
+
+	return dw.WorkoutExerciseRef{
+		ID:               dom.ID(ex.ID),
+		Key:              stringPtrToString(ex.Key),
+		Name:             ex.Name,
+		Translations:     translations,
+		PartID:           intPtrToDomainIDPtr(ex.WorkoutPartID),
+		Owner:            stringPtrToULIDPtr(ex.UserID),
+		PrimaryMuscles:   splitMuscles(ex.PrimaryMuscles),
+		SecondaryMuscles: splitMuscles(ex.SecondaryMuscles),
+		SecondaryParts:   splitList(ex.SecondaryParts),
+		Equipment:        dw.Equipment(stringPtrToString(ex.Equipment)),
+		MovementPattern:  dw.MovementPattern(stringPtrToString(ex.MovementPattern)),
+		Unilateral:       ex.IsUnilateral,
+	}
+}
+
+// WorkoutExerciseFromDomain converts dw.WorkoutExerciseRef to WorkoutExercise (翻訳は含めない)
+func WorkoutExerciseFromDomain(ex dw.WorkoutExerciseRef) WorkoutExercise {
+	rec := WorkoutExercise{
+		ID:               int(ex.ID),
+		Key:              stringToPtr(ex.Key),
+		Name:             ex.Name,
+		WorkoutPartID:    domainIDPtrToIntPtr(ex.PartID),
+		PrimaryMuscles:   joinMuscles(ex.PrimaryMuscles),
+		SecondaryMuscles: joinMuscles(ex.SecondaryMuscles),
+		SecondaryParts:   strings.Join(ex.SecondaryParts, ","),
+		Equipment:        stringToPtr(string(ex.Equipment)),
+		MovementPattern:  stringToPtr(string(ex.MovementPattern)),
+		IsUnilateral:     ex.Unilateral,
+	}
+	if ex.Owner != nil {
+		owner := string(*ex.Owner)
+		rec.UserID = &owner
+	}
+	return rec
+}
+
 // WorkoutPartsToDomain converts slice of WorkoutPart to slice of dw.WorkoutPart
 func WorkoutPartsToDomain(recs []WorkoutPart) []dw.WorkoutPart {
 	result := make([]dw.WorkoutPart, len(recs))
@@ -166,6 +227,44 @@ func WorkoutPartsToDomain(recs []WorkoutPart) []dw.WorkoutPart {
 
 // Helper functions
 
+func stringPtrToString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func stringToPtr(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func splitList(s string) []string {
+	if s == "" {
+		return []string{}
+	}
+	return strings.Split(s, ",")
+}
+
+func splitMuscles(s string) []dw.Muscle {
+	values := splitList(s)
+	muscles := make([]dw.Muscle, 0, len(values))
+	for _, v := range values {
+		muscles = append(muscles, dw.Muscle(v))
+	}
+	return muscles
+}
+
+func joinMuscles(muscles []dw.Muscle) string {
+	values := make([]string, 0, len(muscles))
+	for _, m := range muscles {
+		values = append(values, string(m))
+	}
+	return strings.Join(values, ",")
+}
+
 func ptrInt64ToDomainID(i int64) *dom.ID {
 	if i == 0 {
 		return nil
@@ -198,6 +297,14 @@ func domainIDPtrToInt64Ptr(id *dom.ID) *int64 {
 	return &i
 }
 
+func domainIDPtrToIntPtr(id *dom.ID) *int {
+	if id == nil {
+		return nil
+	}
+	i := int(*id)
+	return &i
+}
+
 func stringPtrToULIDPtr(s *string) *dom.ULID {
 	if s == nil {
 		return nil
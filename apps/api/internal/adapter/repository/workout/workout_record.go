@@ -10,6 +10,7 @@ type WorkoutRecord struct {
 	ID              int    `gorm:"primaryKey;autoIncrement"`
 	UserID          string `gorm:"index"`
 	GymID           *int64 `gorm:"index"` // gym_id追加
+	TemplateID      *int64 // テンプレートから開始したセッション
 	PerformedDate   time.Time
 	StartedAt       *time.Time
 	EndedAt         *time.Time
@@ -43,9 +44,18 @@ type WorkoutSet struct {
 	WorkoutRecordID   int `gorm:"index"`
 	WorkoutExerciseID int `gorm:"index"`
 	SetNumber         int
+	SetKind           string
 	WeightKg          float64
 	Reps              int
+	DurationSec       *int
+	DistanceM         *float64
+	BodyweightKg      *float64
+	SetType           string
+	RPE               *float64 `gorm:"column:rpe"`
+	RIR               *int     `gorm:"column:rir"`
+	SupersetGroup     *string
 	EstimatedMax      *float64
+	CompletedAt       *time.Time
 	Note              *string
 	CreatedAt         time.Time      `gorm:"autoCreateTime"`
 	UpdatedAt         time.Time      `gorm:"autoUpdateTime"`
@@ -59,16 +69,24 @@ func (WorkoutSet) TableName() string {
 }
 
 type WorkoutExercise struct {
-	ID            int `gorm:"primaryKey;autoIncrement"`
-	Name          string
-	WorkoutPartID *int           `gorm:"index"`
-	UserID        *string        `gorm:"index"` // nil ならプリセット
-	CreatedAt     time.Time      `gorm:"autoCreateTime"`
-	UpdatedAt     time.Time      `gorm:"autoUpdateTime"`
-	DeletedAt     gorm.DeletedAt `gorm:"index"`
+	ID               int     `gorm:"primaryKey;autoIncrement"`
+	Key              *string // プリセットの識別子
+	Name             string
+	WorkoutPartID    *int    `gorm:"index"`
+	UserID           *string `gorm:"index"` // nil ならプリセット
+	PrimaryMuscles   string  // カンマ区切り
+	SecondaryMuscles string  // カンマ区切り
+	SecondaryParts   string  // 部位キーのカンマ区切り
+	Equipment        *string
+	MovementPattern  *string
+	IsUnilateral     bool
+	CreatedAt        time.Time      `gorm:"autoCreateTime"`
+	UpdatedAt        time.Time      `gorm:"autoUpdateTime"`
+	DeletedAt        gorm.DeletedAt `gorm:"index"`
 
 	// Exercise → Part（N:1）
-	Part *WorkoutPart `gorm:"foreignKey:WorkoutPartID"`
+	Part         *WorkoutPart                 `gorm:"foreignKey:WorkoutPartID"`
+	Translations []WorkoutExerciseTranslation `gorm:"foreignKey:WorkoutExerciseID"`
 }
 
 func (WorkoutExercise) TableName() string {
@@ -103,3 +121,41 @@ type WorkoutPartTranslation struct {
 func (WorkoutPartTranslation) TableName() string {
 	return "workout_part_translations"
 }
+
+type WorkoutExerciseTranslation struct {
+	ID                int `gorm:"primaryKey;autoIncrement"`
+	WorkoutExerciseID int `gorm:"index"`
+	Locale            string
+	Name              string
+	CreatedAt         time.Time `gorm:"autoCreateTime"`
+	UpdatedAt         time.Time `gorm:"autoUpdateTime"`
+}
+
+func (WorkoutExerciseTranslation) TableName() string {
+	return "workout_exercise_translations"
+}
+
+// UserHiddenExercise はユーザーが非表示にしたプリセット種目
+type UserHiddenExercise struct {
+	UserID            string    `gorm:"primaryKey"`
+	WorkoutExerciseID int       `gorm:"primaryKey"`
+	CreatedAt         time.Time `gorm:"autoCreateTime"`
+}
+
+func (UserHiddenExercise) TableName() string {
+	return "user_hidden_exercises"
+}
+
+// UserExerciseSetting はユーザーごとの種目設定（プリセット種目にも設定できる）
+type UserExerciseSetting struct {
+	UserID            string `gorm:"primaryKey"`
+	WorkoutExerciseID int    `gorm:"primaryKey"`
+	DefaultRestSec    *int
+	TrainingMaxKg     *float64
+	CreatedAt         time.Time `gorm:"autoCreateTime"`
+	UpdatedAt         time.Time `gorm:"autoUpdateTime"`
+}
+
+func (UserExerciseSetting) TableName() string {
+	return "user_exercise_settings"
+}
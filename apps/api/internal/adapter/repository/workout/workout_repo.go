@@ -35,9 +35,18 @@ func (r *workoutRepository) insertWorkoutSets(tx *gorm.DB, recordID int, sets []
 			WorkoutRecordID:   recordID,
 			WorkoutExerciseID: sets[i].WorkoutExerciseID,
 			SetNumber:         sets[i].SetNumber,
+			SetKind:           sets[i].SetKind,
 			WeightKg:          sets[i].WeightKg,
 			Reps:              sets[i].Reps,
+			DurationSec:       sets[i].DurationSec,
+			DistanceM:         sets[i].DistanceM,
+			BodyweightKg:      sets[i].BodyweightKg,
+			SetType:           sets[i].SetType,
+			RPE:               sets[i].RPE,
+			RIR:               sets[i].RIR,
+			SupersetGroup:     sets[i].SupersetGroup,
 			EstimatedMax:      sets[i].EstimatedMax,
+			CompletedAt:       sets[i].CompletedAt,
 			Note:              sets[i].Note,
 		}
 		if err := tx.Create(&newSet).Error; err != nil {
@@ -47,95 +56,114 @@ func (r *workoutRepository) insertWorkoutSets(tx *gorm.DB, recordID int, sets []
 	return nil
 }
 
-// GetRecordsByDate は指定日付のワークアウトレコードを取得（全部位）
-// レコードが存在しない場合は空のドメインモデルを返す
-func (r *workoutRepository) GetRecordsByDate(ctx context.Context, userID string, date time.Time) (dw.WorkoutRecord, error) {
-	var record WorkoutRecord
-	err := r.db.WithContext(ctx).
+// preloadRecord は記録の表示に必要な関連をプリロードする
+func preloadRecord(db *gorm.DB) *gorm.DB {
+	return db.
 		Preload("Gym").
 		Preload("Sets", func(db *gorm.DB) *gorm.DB {
 			return db.Order("workout_sets.set_number ASC")
 		}).
 		Preload("Sets.Exercise").
+		Preload("Sets.Exercise.Translations").
 		Preload("Sets.Exercise.Part").
-		Preload("Sets.Exercise.Part.Translations").
+		Preload("Sets.Exercise.Part.Translations")
+}
+
+// GetRecordsByDate は指定日付のワークアウトセッションを開始時刻順に取得（全部位）
+// レコードが存在しない場合は空のスライスを返す
+func (r *workoutRepository) GetRecordsByDate(ctx context.Context, userID string, date time.Time) ([]dw.WorkoutRecord, error) {
+	var records []WorkoutRecord
+	err := preloadRecord(r.db.WithContext(ctx)).
 		Where("user_id = ? AND performed_date = ?", userID, date).
-		First(&record).Error
+		Order("started_at ASC NULLS LAST, id ASC").
+		Find(&records).Error
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			// レコードが存在しない場合は空のドメインエンティティを返す
-			return dw.WorkoutRecord{}, nil
-		}
-		return dw.WorkoutRecord{}, fmt.Errorf("error fetching workout records: %w", err)
+		return nil, fmt.Errorf("error fetching workout records: %w", err)
 	}
 
 	// リポジトリモデルをドメインエンティティに変換
-	domainRecord := ToEntity(&record)
-	if domainRecord == nil {
-		return dw.WorkoutRecord{}, fmt.Errorf("failed to convert record to domain entity")
+	domainRecords := make([]dw.WorkoutRecord, 0, len(records))
+	for i := range records {
+		domainRecords = append(domainRecords, *ToEntity(&records[i]))
 	}
 
-	return *domainRecord, nil
+	return domainRecords, nil
 }
 
-// CreateWorkoutRecord は新規ワークアウトレコードを作成
-// トランザクション内で Record と Sets を別々に作成し、ID の重複を防ぐ
-func (r *workoutRepository) CreateWorkoutRecord(ctx context.Context, workout dw.WorkoutRecord) error {
-	recordWorkout := FromEntity(&workout)
-	if recordWorkout == nil {
-		return fmt.Errorf("failed to convert domain workout record to repository record")
+// GetRecordsInRange は期間内（from〜to、両端を含む）のワークアウトセッションを日付・開始時刻順に取得
+func (r *workoutRepository) GetRecordsInRange(ctx context.Context, userID string, from, to time.Time) ([]dw.WorkoutRecord, error) {
+	var records []WorkoutRecord
+	err := preloadRecord(r.db.WithContext(ctx)).
+		Where("user_id = ? AND performed_date BETWEEN ? AND ?", userID, from, to).
+		Order("performed_date ASC, started_at ASC NULLS LAST, id ASC").
+		Find(&records).Error
+	if err != nil {
+		return nil, fmt.Errorf("error fetching workout records: %w", err)
 	}
 
-	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		// Sets を一時保存し、Record のみ作成（Sets の自動作成を防ぐ）
-		sets := recordWorkout.Sets
-		recordWorkout.Sets = nil
+	domainRecords := make([]dw.WorkoutRecord, 0, len(records))
+	for i := range records {
+		domainRecords = append(domainRecords, *ToEntity(&records[i]))
+	}
+
+	return domainRecords, nil
+}
 
-		if err := tx.Create(recordWorkout).Error; err != nil {
-			return fmt.Errorf("failed to create workout record: %w", err)
+// GetRecordByID はユーザーのワークアウトセッションを1件取得
+func (r *workoutRepository) GetRecordByID(ctx context.Context, userID string, recordID dw.ID) (dw.WorkoutRecord, error) {
+	var record WorkoutRecord
+	err := preloadRecord(r.db.WithContext(ctx)).
+		Where("user_id = ? AND id = ?", userID, int(recordID)).
+		First(&record).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return dw.WorkoutRecord{}, dw.ErrRecordNotFound
 		}
+		return dw.WorkoutRecord{}, fmt.Errorf("error fetching workout record: %w", err)
+	}
+
+	return *ToEntity(&record), nil
+}
+
+// CreateWorkoutRecord は新規ワークアウトセッションを作成し、採番されたIDを返す
+// トランザクション内で Record と Sets を別々に作成し、ID の重複を防ぐ
+func (r *workoutRepository) CreateWorkoutRecord(ctx context.Context, workout dw.WorkoutRecord) (dw.ID, error) {
+	recordWorkout := FromEntity(&workout)
+	if recordWorkout == nil {
+		return 0, fmt.Errorf("failed to convert domain workout record to repository record")
+	}
 
-		// Sets を手動で作成
-		return r.insertWorkoutSets(tx, recordWorkout.ID, sets)
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return r.createRecordWithSets(tx, recordWorkout)
 	})
+	if err != nil {
+		return 0, err
+	}
+
+	return dw.ID(recordWorkout.ID), nil
 }
 
-// UpsertWorkoutRecord は同日のレコードがあれば更新、なければ新規作成
-// - 同じ日付のレコードが存在: メタデータを更新し、セットを追加/置き換え
-// - 存在しない: 新規作成
-func (r *workoutRepository) UpsertWorkoutRecord(ctx context.Context, workout dw.WorkoutRecord) error {
+// UpdateWorkoutRecord はIDで指定したセッションのメタデータを更新し、
+// 送信された部位のセットを置き換える（他の部位のセットは残す）
+func (r *workoutRepository) UpdateWorkoutRecord(ctx context.Context, workout dw.WorkoutRecord) error {
 	recordWorkout := FromEntity(&workout)
 	if recordWorkout == nil {
 		return fmt.Errorf("failed to convert domain workout record to repository record")
 	}
 
 	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		// 同日のレコードを検索（部位に関係なく）
 		var existingRecord WorkoutRecord
 		err := tx.
-			Preload("Sets.Exercise").
-			Where("user_id = ? AND performed_date = ?", recordWorkout.UserID, recordWorkout.PerformedDate).
+			Where("user_id = ? AND id = ?", recordWorkout.UserID, recordWorkout.ID).
 			First(&existingRecord).Error
-
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			// 新規作成パス
-			return r.createRecordWithSets(tx, recordWorkout)
+			return dw.ErrRecordNotFound
 		}
 		if err != nil {
-			return fmt.Errorf("failed to check existing record: %w", err)
+			return fmt.Errorf("failed to find workout record: %w", err)
 		}
 
-		// 更新パス：メタデータを更新し、新しいセットを追加
-		// 最初のセットから部位IDを取得（既存の同部位セットを削除するため）
-		var partID *int
-		if len(recordWorkout.Sets) > 0 {
-			var exercise WorkoutExercise
-			if err := tx.First(&exercise, recordWorkout.Sets[0].WorkoutExerciseID).Error; err == nil {
-				partID = exercise.WorkoutPartID
-			}
-		}
-
-		return r.updateRecordAndReplaceSets(tx, &existingRecord, recordWorkout, partID)
+		return r.updateRecordAndReplaceSets(tx, &existingRecord, recordWorkout)
 	})
 }
 
@@ -153,21 +181,27 @@ func (r *workoutRepository) createRecordWithSets(tx *gorm.DB, recordWorkout *Wor
 }
 
 // updateRecordAndReplaceSets は既存レコードのメタデータを更新し、セットを置き換え
-func (r *workoutRepository) updateRecordAndReplaceSets(tx *gorm.DB, existing *WorkoutRecord, new *WorkoutRecord, partID *int) error {
-	// メタデータ（時刻・コンディション・ノート・ジムID）を更新
+func (r *workoutRepository) updateRecordAndReplaceSets(tx *gorm.DB, existing *WorkoutRecord, new *WorkoutRecord) error {
+	// メタデータ（日付・時刻・コンディション・ノート・ジムID）を更新
 	updates := map[string]interface{}{
-		"started_at":      new.StartedAt,
-		"ended_at":        new.EndedAt,
-		"note":            new.Note,
-		"condition_level": new.ConditionLevel,
-		"gym_id":          new.GymID,
+		"performed_date":   new.PerformedDate,
+		"started_at":       new.StartedAt,
+		"ended_at":         new.EndedAt,
+		"duration_minutes": new.DurationMinutes,
+		"note":             new.Note,
+		"condition_level":  new.ConditionLevel,
+		"gym_id":           new.GymID,
 	}
 	if err := tx.Model(existing).Updates(updates).Error; err != nil {
 		return fmt.Errorf("failed to update workout record metadata: %w", err)
 	}
 
-	// 同じ部位のセットを削除
-	if err := r.deleteSetsByPart(tx, existing.ID, partID); err != nil {
+	// 送信された種目が属する部位のセットを削除
+	partIDs, err := r.partIDsOfSets(tx, new.Sets)
+	if err != nil {
+		return err
+	}
+	if err := r.deleteSetsByParts(tx, existing.ID, partIDs); err != nil {
 		return fmt.Errorf("failed to delete existing sets: %w", err)
 	}
 
@@ -180,10 +214,33 @@ func (r *workoutRepository) updateRecordAndReplaceSets(tx *gorm.DB, existing *Wo
 	return r.insertWorkoutSets(tx, existing.ID, new.Sets)
 }
 
-// deleteSetsByPart は指定部位のセットを物理削除
+// partIDsOfSets はセットの種目が属する部位IDをDBから取得する
+func (r *workoutRepository) partIDsOfSets(tx *gorm.DB, sets []WorkoutSet) ([]int, error) {
+	exerciseIDs := make([]int, 0, len(sets))
+	for _, s := range sets {
+		if s.WorkoutExerciseID > 0 {
+			exerciseIDs = append(exerciseIDs, s.WorkoutExerciseID)
+		}
+	}
+	if len(exerciseIDs) == 0 {
+		return nil, nil
+	}
+
+	var partIDs []int
+	if err := tx.Model(&WorkoutExercise{}).
+		Distinct("workout_part_id").
+		Where("id IN ? AND workout_part_id IS NOT NULL", exerciseIDs).
+		Pluck("workout_part_id", &partIDs).Error; err != nil {
+		return nil, fmt.Errorf("failed to get part IDs: %w", err)
+	}
+
+	return partIDs, nil
+}
+
+// deleteSetsByParts は指定部位のセットを物理削除
 // 物理削除を使用してユニーク制約の問題を回避
-func (r *workoutRepository) deleteSetsByPart(tx *gorm.DB, recordID int, partID *int) error {
-	if partID == nil {
+func (r *workoutRepository) deleteSetsByParts(tx *gorm.DB, recordID int, partIDs []int) error {
+	if len(partIDs) == 0 {
 		return nil
 	}
 
@@ -191,7 +248,7 @@ func (r *workoutRepository) deleteSetsByPart(tx *gorm.DB, recordID int, partID *
 	var exerciseIDs []int
 	if err := tx.Model(&WorkoutExercise{}).
 		Select("id").
-		Where("workout_part_id = ?", *partID).
+		Where("workout_part_id IN ?", partIDs).
 		Pluck("id", &exerciseIDs).Error; err != nil {
 		return fmt.Errorf("failed to get exercise IDs: %w", err)
 	}
@@ -211,12 +268,16 @@ func (r *workoutRepository) deleteSetsByPart(tx *gorm.DB, recordID int, partID *
 	return nil
 }
 
-// deleteInvalidSets は無効なセット（weight=0 かつ reps=0、またはexerciseID=0）を物理削除
+// deleteInvalidSets は無効なセット（種類ごとの記録値が空、またはexerciseID=0）を物理削除
+// 空の判定は dw.WorkoutSet.IsEmpty と合わせる
 func (r *workoutRepository) deleteInvalidSets(tx *gorm.DB, recordID int) error {
-	// weight_kg=0 かつ reps=0 のセット、またはexerciseID=0のセットを削除
 	if err := tx.Unscoped().
 		Where("workout_record_id = ?", recordID).
-		Where("(weight_kg = 0 AND reps = 0) OR workout_exercise_id = 0 OR workout_exercise_id IS NULL").
+		Where(`(set_kind = 'weight_reps' AND weight_kg = 0 AND reps = 0)
+			OR (set_kind IN ('bodyweight', 'assisted') AND reps = 0)
+			OR (set_kind = 'timed' AND COALESCE(duration_sec, 0) = 0)
+			OR (set_kind = 'distance' AND COALESCE(distance_m, 0) = 0)
+			OR workout_exercise_id = 0 OR workout_exercise_id IS NULL`).
 		Delete(&WorkoutSet{}).Error; err != nil {
 		return fmt.Errorf("failed to delete invalid sets: %w", err)
 	}
@@ -224,60 +285,246 @@ func (r *workoutRepository) deleteInvalidSets(tx *gorm.DB, recordID int) error {
 	return nil
 }
 
-// GetWorkoutParts はユーザーのワークアウト部位一覧を取得
-// 各部位に紐づく種目と翻訳データもプリロード
+// GetWorkoutParts はプリセットとユーザーの部位一覧を取得
+// 各部位に紐づくプリセット・ユーザー作成の種目と翻訳データもプリロードし、
+// ユーザーが非表示にしたプリセット種目には Hidden を立てる
 func (r *workoutRepository) GetWorkoutParts(ctx context.Context, userID string) ([]dw.WorkoutPart, error) {
 	var parts []WorkoutPart
 
 	err := r.db.WithContext(ctx).
 		Preload("Translations").
-		Preload("Exercises", "user_id = ?", userID).
-		Where("user_id = ?", userID).
+		Preload("Exercises", func(db *gorm.DB) *gorm.DB {
+			return db.Where("user_id IS NULL OR user_id = ?", userID).Order("id ASC")
+		}).
+		Preload("Exercises.Translations").
+		Where("user_id IS NULL OR user_id = ?", userID).
 		Order("key ASC").
 		Find(&parts).Error
 	if err != nil {
 		return nil, fmt.Errorf("error fetching workout parts: %w", err)
 	}
 
-	return WorkoutPartsToDomain(parts), nil
+	var hiddenIDs []int
+	if err := r.db.WithContext(ctx).
+		Model(&UserHiddenExercise{}).
+		Where("user_id = ?", userID).
+		Pluck("workout_exercise_id", &hiddenIDs).Error; err != nil {
+		return nil, fmt.Errorf("error fetching hidden exercises: %w", err)
+	}
+	hidden := make(map[dw.ID]bool, len(hiddenIDs))
+	for _, id := range hiddenIDs {
+		hidden[dw.ID(id)] = true
+	}
+
+	restDefaults, err := r.GetDefaultRests(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	trainingMaxes, err := r.GetTrainingMaxes(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	domainParts := WorkoutPartsToDomain(parts)
+	for i := range domainParts {
+		for j := range domainParts[i].Exercises {
+			ex := &domainParts[i].Exercises[j]
+			ex.Hidden = ex.IsPreset() && hidden[ex.ID]
+			if sec, ok := restDefaults[ex.ID]; ok {
+				ex.DefaultRestSec = &sec
+			}
+			if kg, ok := trainingMaxes[ex.ID]; ok {
+				ex.TrainingMaxKg = &kg
+			}
+		}
+	}
+
+	return domainParts, nil
 }
 
-// CountUserWorkoutParts はユーザーのワークアウト部位数をカウント
-func (r *workoutRepository) CountUserWorkoutParts(ctx context.Context, userID string) (int64, error) {
+// SyncPresets はカタログのプリセット部位・種目をキーで upsert する
+// カタログから消えた種目は過去の記録が参照しているため削除しない
+func (r *workoutRepository) SyncPresets(ctx context.Context, parts []dw.WorkoutPart) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, part := range parts {
+			partRecord := WorkoutPart{Key: part.Key}
+			if err := tx.
+				Where("key = ? AND user_id IS NULL", part.Key).
+				FirstOrCreate(&partRecord).Error; err != nil {
+				return fmt.Errorf("failed to upsert preset part %q: %w", part.Key, err)
+			}
+
+			if len(part.Translations) > 0 {
+				translations := make([]WorkoutPartTranslation, 0, len(part.Translations))
+				for _, trans := range part.Translations {
+					translations = append(translations, WorkoutPartTranslation{
+						WorkoutPartID: partRecord.ID,
+						Locale:        trans.Locale,
+						Name:          trans.Name,
+					})
+				}
+				if err := tx.
+					Clauses(clause.OnConflict{
+						Columns:   []clause.Column{{Name: "workout_part_id"}, {Name: "locale"}},
+						DoUpdates: clause.AssignmentColumns([]string{"name", "updated_at"}),
+					}).
+					Create(&translations).Error; err != nil {
+					return fmt.Errorf("failed to upsert preset part translations %q: %w", part.Key, err)
+				}
+			}
+
+			if len(part.Exercises) == 0 {
+				continue
+			}
+			partID := dw.ID(partRecord.ID)
+			exercises := make([]WorkoutExercise, 0, len(part.Exercises))
+			for _, ex := range part.Exercises {
+				ex.ID = 0
+				ex.Owner = nil
+				ex.PartID = &partID
+				exercises = append(exercises, WorkoutExerciseFromDomain(ex))
+			}
+			if err := tx.
+				Clauses(clause.OnConflict{
+					Columns:     []clause.Column{{Name: "key"}},
+					TargetWhere: clause.Where{Exprs: []clause.Expression{clause.Expr{SQL: "user_id IS NULL"}}},
+					DoUpdates: clause.AssignmentColumns([]string{
+						"name", "workout_part_id", "primary_muscles", "secondary_muscles", "secondary_parts",
+						"equipment", "movement_pattern", "is_unilateral", "updated_at", "deleted_at",
+					}),
+				}).
+				Create(&exercises).Error; err != nil {
+				return fmt.Errorf("failed to upsert preset exercises for part %q: %w", part.Key, err)
+			}
+
+			// upsert 後は exercises に採番済みの ID が入る
+			var translations []WorkoutExerciseTranslation
+			for j, ex := range part.Exercises {
+				for _, trans := range ex.Translations {
+					translations = append(translations, WorkoutExerciseTranslation{
+						WorkoutExerciseID: exercises[j].ID,
+						Locale:            trans.Locale,
+						Name:              trans.Name,
+					})
+				}
+			}
+			if len(translations) == 0 {
+				continue
+			}
+			if err := tx.
+				Clauses(clause.OnConflict{
+					Columns:   []clause.Column{{Name: "workout_exercise_id"}, {Name: "locale"}},
+					DoUpdates: clause.AssignmentColumns([]string{"name", "updated_at"}),
+				}).
+				Create(&translations).Error; err != nil {
+				return fmt.Errorf("failed to upsert preset exercise translations for part %q: %w", part.Key, err)
+			}
+		}
+		return nil
+	})
+}
+
+// HideExercise はプリセット種目をユーザーの一覧から非表示にする（冪等）
+func (r *workoutRepository) HideExercise(ctx context.Context, userID string, exerciseID int64) error {
 	var count int64
+	if err := r.db.WithContext(ctx).
+		Model(&WorkoutExercise{}).
+		Where("id = ? AND user_id IS NULL", exerciseID).
+		Count(&count).Error; err != nil {
+		return fmt.Errorf("error finding preset exercise: %w", err)
+	}
+	if count == 0 {
+		return dw.ErrExerciseNotFound
+	}
+
 	err := r.db.WithContext(ctx).
-		Model(&WorkoutPart{}).
-		Where("user_id = ?", userID).
-		Count(&count).Error
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&UserHiddenExercise{UserID: userID, WorkoutExerciseID: int(exerciseID)}).Error
 	if err != nil {
-		return 0, fmt.Errorf("error counting workout parts: %w", err)
+		return fmt.Errorf("error hiding workout exercise: %w", err)
 	}
 
-	return count, nil
+	return nil
 }
 
-// CreateWorkoutParts は複数のワークアウト部位を一括作成（翻訳データも含む）
-func (r *workoutRepository) CreateWorkoutParts(ctx context.Context, userID string, parts []dw.WorkoutPart) error {
-	recordParts := make([]WorkoutPart, len(parts))
-	for i, part := range parts {
-		translations := make([]WorkoutPartTranslation, len(part.Translations))
-		for j, trans := range part.Translations {
-			translations[j] = WorkoutPartTranslation{
-				Locale: trans.Locale,
-				Name:   trans.Name,
-			}
-		}
+// UnhideExercise はプリセット種目の非表示を解除する（冪等）
+func (r *workoutRepository) UnhideExercise(ctx context.Context, userID string, exerciseID int64) error {
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND workout_exercise_id = ?", userID, exerciseID).
+		Delete(&UserHiddenExercise{}).Error
+	if err != nil {
+		return fmt.Errorf("error unhiding workout exercise: %w", err)
+	}
 
-		recordParts[i] = WorkoutPart{
-			Key:          part.Key,
-			UserID:       &userID,
-			Translations: translations,
-		}
+	return nil
+}
+
+// GetDefaultRests はユーザーが種目ごとに設定したセット間の休憩（秒）を種目IDごとに返す
+func (r *workoutRepository) GetDefaultRests(ctx context.Context, userID string) (map[dw.ID]int, error) {
+	var settings []UserExerciseSetting
+	if err := r.db.WithContext(ctx).
+		Where("user_id = ? AND default_rest_sec IS NOT NULL", userID).
+		Find(&settings).Error; err != nil {
+		return nil, fmt.Errorf("error fetching exercise settings: %w", err)
 	}
 
-	err := r.db.WithContext(ctx).Create(&recordParts).Error
+	rests := make(map[dw.ID]int, len(settings))
+	for _, s := range settings {
+		rests[dw.ID(s.WorkoutExerciseID)] = *s.DefaultRestSec
+	}
+	return rests, nil
+}
+
+// SetDefaultRest はプリセットまたはユーザー自身の種目にセット間の休憩（秒）を設定する
+// restSec が nil の場合は設定を解除する
+func (r *workoutRepository) SetDefaultRest(ctx context.Context, userID string, exerciseID int64, restSec *int) error {
+	return r.saveExerciseSetting(ctx, UserExerciseSetting{UserID: userID, WorkoutExerciseID: int(exerciseID), DefaultRestSec: restSec}, "default_rest_sec")
+}
+
+// GetTrainingMaxes はユーザーが種目ごとに設定したトレーニングマックス（kg）を種目IDごとに返す
+func (r *workoutRepository) GetTrainingMaxes(ctx context.Context, userID string) (map[dw.ID]float64, error) {
+	var settings []UserExerciseSetting
+	if err := r.db.WithContext(ctx).
+		Where("user_id = ? AND training_max_kg IS NOT NULL", userID).
+		Find(&settings).Error; err != nil {
+		return nil, fmt.Errorf("error fetching exercise settings: %w", err)
+	}
+
+	maxes := make(map[dw.ID]float64, len(settings))
+	for _, s := range settings {
+		maxes[dw.ID(s.WorkoutExerciseID)] = *s.TrainingMaxKg
+	}
+	return maxes, nil
+}
+
+// SetTrainingMax はプリセットまたはユーザー自身の種目にトレーニングマックス（kg）を設定する
+// weightKg が nil の場合は設定を解除する
+func (r *workoutRepository) SetTrainingMax(ctx context.Context, userID string, exerciseID int64, weightKg *float64) error {
+	return r.saveExerciseSetting(ctx, UserExerciseSetting{UserID: userID, WorkoutExerciseID: int(exerciseID), TrainingMaxKg: weightKg}, "training_max_kg")
+}
+
+// saveExerciseSetting は種目設定の column だけを upsert する（他の設定は変えない）
+// 種目はプリセットまたはユーザー自身の種目に限る
+func (r *workoutRepository) saveExerciseSetting(ctx context.Context, setting UserExerciseSetting, column string) error {
+	var count int64
+	if err := r.db.WithContext(ctx).
+		Model(&WorkoutExercise{}).
+		Where("id = ? AND (user_id IS NULL OR user_id = ?)", setting.WorkoutExerciseID, setting.UserID).
+		Count(&count).Error; err != nil {
+		return fmt.Errorf("error finding workout exercise: %w", err)
+	}
+	if count == 0 {
+		return dw.ErrExerciseNotFound
+	}
+
+	err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}, {Name: "workout_exercise_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{column, "updated_at"}),
+		}).
+		Create(&setting).Error
 	if err != nil {
-		return fmt.Errorf("error creating workout parts: %w", err)
+		return fmt.Errorf("error saving exercise setting: %w", err)
 	}
 
 	return nil
@@ -297,33 +544,27 @@ func (r *workoutRepository) DeleteWorkoutExercise(ctx context.Context, userID st
 
 // UpsertWorkoutExercises はワークアウト種目を一括 upsert
 // - ID が指定されていれば更新、なければ新規作成
-// - OnConflict で ID 衝突時は name と workout_part_id を更新
+// - OnConflict で ID 衝突時は name・workout_part_id とメタデータを更新
+// - 更新はユーザー自身の種目に限る（プリセットは上書きしない）
 func (r *workoutRepository) UpsertWorkoutExercises(ctx context.Context, userID string, exercises []dw.WorkoutExerciseRef) error {
 	recordExercises := make([]WorkoutExercise, 0, len(exercises))
 	for _, exercise := range exercises {
-		var partID *int
-		if exercise.PartID != nil {
-			pid := int(*exercise.PartID)
-			partID = &pid
-		}
-
-		recordExercise := WorkoutExercise{
-			Name:          exercise.Name,
-			WorkoutPartID: partID,
-			UserID:        &userID,
-		}
-
-		if exercise.ID != 0 {
-			recordExercise.ID = int(exercise.ID)
-		}
-
+		recordExercise := WorkoutExerciseFromDomain(exercise)
+		recordExercise.Key = nil // ユーザー作成の種目はキーを持たない
+		recordExercise.UserID = &userID
 		recordExercises = append(recordExercises, recordExercise)
 	}
 
 	err := r.db.WithContext(ctx).
 		Clauses(clause.OnConflict{
-			Columns:   []clause.Column{{Name: "id"}},
-			DoUpdates: clause.AssignmentColumns([]string{"name", "workout_part_id"}),
+			Columns: []clause.Column{{Name: "id"}},
+			DoUpdates: clause.AssignmentColumns([]string{
+				"name", "workout_part_id", "primary_muscles", "secondary_muscles", "secondary_parts",
+				"equipment", "movement_pattern", "is_unilateral",
+			}),
+			Where: clause.Where{Exprs: []clause.Expression{
+				clause.Eq{Column: clause.Column{Table: "workout_exercises", Name: "user_id"}, Value: userID},
+			}},
 		}).
 		Create(&recordExercises).Error
 	if err != nil {
@@ -333,36 +574,53 @@ func (r *workoutRepository) UpsertWorkoutExercises(ctx context.Context, userID s
 	return nil
 }
 
+// GetLastWorkoutRecord は指定した種目を含む最新のセッションを取得（セットはその種目のみ）
+// 記録がない場合は空の WorkoutRecord を返す
 func (r *workoutRepository) GetLastWorkoutRecord(ctx context.Context, userID string, exerciseID int64) (dw.WorkoutRecord, error) {
-	var rec WorkoutRecord
+	records, err := r.GetRecentWorkoutRecords(ctx, userID, exerciseID, 1)
+	if err != nil {
+		return dw.WorkoutRecord{}, err
+	}
+	if len(records) == 0 {
+		return dw.WorkoutRecord{}, nil
+	}
+	return records[0], nil
+}
+
+// GetRecentWorkoutRecords は指定した種目を含む直近 limit 件のセッションを新しい順に取得（セットはその種目のみ）
+func (r *workoutRepository) GetRecentWorkoutRecords(ctx context.Context, userID string, exerciseID int64, limit int) ([]dw.WorkoutRecord, error) {
+	// performed_date（実施日）、同日の複数セッションは started_at と id で新しさを判定
+	const order = "workout_records.performed_date DESC, workout_records.started_at DESC NULLS LAST, workout_records.id DESC"
 
-	// サブクエリ: 指定したエクササイズを含む最新のレコードIDを取得
-	// performed_date（実施日）とid（より新しいレコード）で最新を判定
-	subQuery := r.db.Table("workout_records").
+	// サブクエリ: 指定したエクササイズを含む直近のレコードIDを取得
+	subQuery := r.db.WithContext(ctx).Table("workout_records").
 		Select("workout_records.id").
-		Joins("INNER JOIN workout_sets ON workout_sets.workout_record_id = workout_records.id").
-		Where("workout_records.user_id = ? AND workout_sets.workout_exercise_id = ?", userID, exerciseID).
-		Order("workout_records.performed_date DESC, workout_records.id DESC").
-		Limit(1)
+		Where("workout_records.user_id = ? AND workout_records.deleted_at IS NULL", userID).
+		Where("EXISTS (SELECT 1 FROM workout_sets WHERE workout_sets.workout_record_id = workout_records.id AND workout_sets.workout_exercise_id = ?)", exerciseID).
+		Order(order).
+		Limit(limit)
 
+	var recs []WorkoutRecord
 	err := r.db.WithContext(ctx).
 		Preload("Gym").
-		Preload("Sets", "workout_exercise_id = ?", exerciseID). // 指定エクササイズのセットのみ取得
+		// 指定エクササイズのセットのみ、preloadRecord と同じくセット番号順に取得
+		Preload("Sets", func(db *gorm.DB) *gorm.DB {
+			return db.Where("workout_sets.workout_exercise_id = ?", exerciseID).Order("workout_sets.set_number ASC")
+		}).
 		Preload("Sets.Exercise").
+		Preload("Sets.Exercise.Translations").
 		Preload("Sets.Exercise.Part").
-		Where("user_id = ? AND id = (?)", userID, subQuery).
-		First(&rec).Error
+		Preload("Sets.Exercise.Part.Translations").
+		Where("user_id = ? AND id IN (?)", userID, subQuery).
+		Order(order).
+		Find(&recs).Error
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return dw.WorkoutRecord{}, nil
-		}
-		return dw.WorkoutRecord{}, fmt.Errorf("error fetching last workout record: %w", err)
+		return nil, fmt.Errorf("error fetching recent workout records: %w", err)
 	}
 
-	domainRecord := ToEntity(&rec)
-	if domainRecord == nil {
-		return dw.WorkoutRecord{}, fmt.Errorf("failed to convert record to domain")
+	records := make([]dw.WorkoutRecord, 0, len(recs))
+	for i := range recs {
+		records = append(records, *ToEntity(&recs[i]))
 	}
-
-	return *domainRecord, nil
+	return records, nil
 }
@@ -13,19 +13,33 @@ func RegisterRoutes(
 	userHandler *handler.UserHandler,
 	sessionHandler *handler.SessionHandler,
 	workoutHandler *handler.WorkoutHandler,
+	templateHandler *handler.TemplateHandler,
+	programHandler *handler.ProgramHandler,
 	contactHandler *handler.ContactHandler,
+	preferencesFinder middleware.PreferencesFinder,
 	jwtSecret string,
+	adminUserIDs []string,
 ) {
 	v1 := e.Group("/api/v1")
 
-	// 認証不要なルート
-	UserRoutes(v1, userHandler)
-	SessionRoutes(v1, sessionHandler)
-	ContactRoutes(v1, contactHandler)
+	// 認証不要なルート（検証エラーのメッセージのロケールは Accept-Language で決定）
+	publicGroup := v1.Group("", middleware.PreferencesMiddleware(nil))
+	UserRoutes(publicGroup, userHandler)
+	SessionRoutes(publicGroup, sessionHandler)
+	ContactRoutes(publicGroup, contactHandler, middleware.OptionalAuthMiddleware(jwtSecret))
+	OpenAPIRoutes(publicGroup)
 
-	// 認証が必要なルート
+	// 認証が必要なルート（表示ロケールはユーザー設定 → Accept-Language、タイムゾーンはユーザー設定 → X-Time-Zone の順で決定）
+	// 重量の単位はユーザー設定（未設定なら kg）
 	authMiddleware := middleware.AuthMiddleware(jwtSecret)
-	authGroup := v1.Group("", authMiddleware)
+	authGroup := v1.Group("", authMiddleware, middleware.PreferencesMiddleware(preferencesFinder))
+	UserMeRoutes(authGroup, userHandler)
 	GymRoutes(authGroup, gymHandler)
 	WorkoutRoutes(authGroup, workoutHandler)
+	TemplateRoutes(authGroup, templateHandler)
+	ProgramRoutes(authGroup, programHandler)
+
+	// 管理者専用ルート
+	adminGroup := authGroup.Group("", middleware.AdminMiddleware(adminUserIDs))
+	AdminContactRoutes(adminGroup, contactHandler)
 }
@@ -6,6 +6,15 @@ import (
 	"github.com/labstack/echo/v4"
 )
 
-func ContactRoutes(e *echo.Group, ch *handler.ContactHandler) {
-	e.POST("/contact", ch.PostContact)
+// ContactRoutes は未ログインでも使える問い合わせルート
+// optionalAuth はログイン中のユーザーを問い合わせに紐付けるための任意認証
+func ContactRoutes(e *echo.Group, ch *handler.ContactHandler, optionalAuth echo.MiddlewareFunc) {
+	e.GET("/contact/token", ch.GetContactToken)
+	e.POST("/contact", ch.PostContact, optionalAuth)
+}
+
+// AdminContactRoutes は管理者専用の問い合わせ管理ルート
+func AdminContactRoutes(e *echo.Group, ch *handler.ContactHandler) {
+	e.GET("/admin/contacts", ch.ListContacts)
+	e.PUT("/admin/contacts/:id/resolve", ch.ResolveContact)
 }
@@ -0,0 +1,12 @@
+package router
+
+import (
+	"gogym-api/internal/adapter/openapi"
+
+	"github.com/labstack/echo/v4"
+)
+
+// OpenAPIRoutes は API の OpenAPI ドキュメントのルート
+func OpenAPIRoutes(e *echo.Group) {
+	e.GET("/openapi.json", openapi.Handler)
+}
@@ -0,0 +1,20 @@
+package router
+
+import (
+	"gogym-api/internal/adapter/handler"
+
+	"github.com/labstack/echo/v4"
+)
+
+func ProgramRoutes(e *echo.Group, ph *handler.ProgramHandler) {
+	e.GET("/workouts/programs", ph.ListPrograms)
+	e.POST("/workouts/programs", ph.CreateProgram)
+	e.GET("/workouts/programs/enrollment", ph.GetEnrollment)
+	e.DELETE("/workouts/programs/enrollment", ph.EndEnrollment)
+	e.GET("/workouts/programs/today", ph.GetProgramToday)
+	e.GET("/workouts/programs/adherence", ph.GetAdherence)
+	e.GET("/workouts/programs/:id", ph.GetProgram)
+	e.PUT("/workouts/programs/:id", ph.UpdateProgram)
+	e.DELETE("/workouts/programs/:id", ph.DeleteProgram)
+	e.POST("/workouts/programs/:id/enroll", ph.EnrollProgram)
+}
@@ -0,0 +1,16 @@
+package router
+
+import (
+	"gogym-api/internal/adapter/handler"
+
+	"github.com/labstack/echo/v4"
+)
+
+func TemplateRoutes(e *echo.Group, th *handler.TemplateHandler) {
+	e.GET("/workouts/templates", th.ListTemplates)
+	e.POST("/workouts/templates", th.CreateTemplate)
+	e.GET("/workouts/templates/:id", th.GetTemplate)
+	e.PUT("/workouts/templates/:id", th.UpdateTemplate)
+	e.DELETE("/workouts/templates/:id", th.DeleteTemplate)
+	e.POST("/workouts/templates/:id/start", th.StartWorkout)
+}
@@ -9,3 +9,9 @@ import (
 func UserRoutes(e *echo.Group, uh *handler.UserHandler) {
 	e.POST("/users", uh.SignUp)
 }
+
+// UserMeRoutes はログインユーザー自身の設定ルート
+func UserMeRoutes(e *echo.Group, uh *handler.UserHandler) {
+	e.GET("/users/me/preferences", uh.GetPreferences)
+	e.PUT("/users/me/preferences", uh.UpdatePreferences)
+}
@@ -8,11 +8,18 @@ import (
 
 func WorkoutRoutes(e *echo.Group, wh *handler.WorkoutHandler) {
 	e.GET("/workouts/records", wh.GetWorkoutRecords)
+	e.GET("/workouts/records/:id", wh.GetWorkoutRecord)
 	e.POST("/workouts/records", wh.CreateWorkoutRecord)
 	e.PUT("/workouts/records/:id", wh.UpdateWorkoutRecord)
 	e.GET("/workouts/parts", wh.GetWorkoutParts)
 	e.POST("/workouts/seed", wh.SeedWorkoutParts)
 	e.POST("/workouts/exercises", wh.CreateWorkoutExercise)
 	e.DELETE("/workouts/exercises/:id", wh.DeleteWorkoutExercise)
+	e.PUT("/workouts/exercises/:id/hide", wh.HideExercise)
+	e.DELETE("/workouts/exercises/:id/hide", wh.UnhideExercise)
+	e.PUT("/workouts/exercises/:id/rest", wh.UpdateExerciseRest)
+	e.PUT("/workouts/exercises/:id/training-max", wh.UpdateExerciseTrainingMax)
+	e.GET("/workouts/exercises/rest-summary", wh.GetRestSummary)
 	e.GET("/workouts/exercises/:id/last", wh.GetLastWorkoutRecord)
+	e.GET("/workouts/exercises/:id/suggestion", wh.SuggestProgression)
 }
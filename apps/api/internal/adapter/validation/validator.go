@@ -0,0 +1,171 @@
+// Package validation はリクエストの DTO を validate タグの規則で検証する Echo の Validator を提供する
+package validation
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"unicode/utf8"
+
+	dom "gogym-api/internal/domain/entities"
+
+	"github.com/go-playground/locales/en"
+	"github.com/go-playground/locales/ja"
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+	en_translations "github.com/go-playground/validator/v10/translations/en"
+	ja_translations "github.com/go-playground/validator/v10/translations/ja"
+)
+
+const (
+	minPasswordLength = 8
+	maxPasswordLength = 72 // bcrypt が扱える最大バイト数
+)
+
+// customTranslations は独自の規則のメッセージ（{0} は項目名）
+var customTranslations = map[string]map[string]string{
+	"password": {
+		dom.LocaleJa: "{0}は8〜72文字で、英大文字・英小文字・数字をそれぞれ1文字以上含む必要があります",
+		dom.LocaleEn: "{0} must be 8-72 characters and contain an uppercase letter, a lowercase letter and a digit",
+	},
+}
+
+// Validator は echo.Validator の実装
+type Validator struct {
+	validate    *validator.Validate
+	translators map[string]ut.Translator // ロケール → メッセージの翻訳
+}
+
+// New は検証の規則とロケールごとのメッセージを登録した Validator を作る
+func New() *Validator {
+	validate := validator.New(validator.WithRequiredStructEnabled())
+	// 項目名はリクエストの JSON のキーにする
+	validate.RegisterTagNameFunc(jsonFieldName)
+	if err := validate.RegisterValidation("password", validPassword); err != nil {
+		panic(err)
+	}
+
+	uni := ut.New(ja.New(), ja.New(), en.New())
+	translators := make(map[string]ut.Translator, len(dom.SupportedLocales))
+	for _, locale := range dom.SupportedLocales {
+		trans, _ := uni.GetTranslator(locale)
+		if err := registerTranslations(validate, trans, locale); err != nil {
+			panic(err)
+		}
+		translators[locale] = trans
+	}
+
+	return &Validator{validate: validate, translators: translators}
+}
+
+// Validate は i の validate タグの規則を検証する
+// 規則を満たさない項目があれば *Error を返す
+func (v *Validator) Validate(i any) error {
+	err := v.validate.Struct(i)
+	var errs validator.ValidationErrors
+	if errors.As(err, &errs) {
+		return &Error{errs: errs, translators: v.translators}
+	}
+	return err
+}
+
+// Error は規則を満たさなかった項目の一覧（メッセージは Localize でリクエストのロケールにする）
+type Error struct {
+	errs        validator.ValidationErrors
+	translators map[string]ut.Translator
+}
+
+func (e *Error) Error() string {
+	return e.Localize(dom.LocaleEn).Error()
+}
+
+func (e *Error) Unwrap() error {
+	return dom.ErrValidation
+}
+
+// Localize は項目ごとのメッセージを locale（未対応ならデフォルトロケール）にした検証エラーを返す
+// 項目名は JSON のパス（例: "parts[0].exercises[1].sets[2].reps"）
+func (e *Error) Localize(locale string) *dom.ValidationError {
+	trans, ok := e.translators[locale]
+	if !ok {
+		trans = e.translators[dom.DefaultLocale]
+	}
+
+	fields := make([]dom.FieldError, 0, len(e.errs))
+	for _, fe := range e.errs {
+		fields = append(fields, dom.FieldError{
+			Field:   fieldPath(fe.Namespace()),
+			Message: fe.Translate(trans),
+		})
+	}
+	return dom.NewValidationError(fields...)
+}
+
+// fieldPath は "SignUpRequest.email" のような名前空間から先頭の型名を除く
+func fieldPath(namespace string) string {
+	if _, path, ok := strings.Cut(namespace, "."); ok {
+		return path
+	}
+	return namespace
+}
+
+func jsonFieldName(f reflect.StructField) string {
+	name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+	switch name {
+	case "-":
+		return ""
+	case "":
+		return f.Name
+	}
+	return name
+}
+
+// validPassword はパスワードの規則（8〜72文字、英大文字・英小文字・数字をそれぞれ含む）
+func validPassword(fl validator.FieldLevel) bool {
+	s := fl.Field().String()
+	if utf8.RuneCountInString(s) < minPasswordLength || len(s) > maxPasswordLength {
+		return false
+	}
+	var upper, lower, digit bool
+	for _, r := range s {
+		switch {
+		case r >= 'A' && r <= 'Z':
+			upper = true
+		case r >= 'a' && r <= 'z':
+			lower = true
+		case r >= '0' && r <= '9':
+			digit = true
+		}
+	}
+	return upper && lower && digit
+}
+
+func registerTranslations(validate *validator.Validate, trans ut.Translator, locale string) error {
+	var err error
+	switch locale {
+	case dom.LocaleJa:
+		err = ja_translations.RegisterDefaultTranslations(validate, trans)
+	default:
+		err = en_translations.RegisterDefaultTranslations(validate, trans)
+	}
+	if err != nil {
+		return err
+	}
+
+	for tag, messages := range customTranslations {
+		message := messages[locale]
+		err := validate.RegisterTranslation(tag, trans,
+			func(ut ut.Translator) error {
+				return ut.Add(tag, message, true)
+			},
+			func(ut ut.Translator, fe validator.FieldError) string {
+				t, _ := ut.T(tag, fe.Field())
+				return t
+			},
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package validation
+
+import (
+	"errors"
+	"testing"
+
+	"gogym-api/internal/adapter/dto"
+	dom "gogym-api/internal/domain/entities"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidator_Validate(t *testing.T) {
+	t.Parallel()
+
+	v := New()
+	ptr := func(s string) *string { return &s }
+	intPtr := func(n int) *int { return &n }
+
+	fieldsOf := func(t *testing.T, err error, locale string) map[string]string {
+		t.Helper()
+		var verr *Error
+		require.ErrorAs(t, err, &verr)
+		require.ErrorIs(t, err, dom.ErrValidation)
+
+		fields := map[string]string{}
+		for _, f := range verr.Localize(locale).Fields {
+			fields[f.Field] = f.Message
+		}
+		return fields
+	}
+
+	t.Run("正常系: 規則を満たすリクエストはエラーにならない", func(t *testing.T) {
+		t.Parallel()
+		require.NoError(t, v.Validate(&dto.SignUpRequest{Name: "Taro", Email: "taro@example.com", Password: "Passw0rd"}))
+		require.NoError(t, v.Validate(&dto.WorkoutRecordDTO{
+			PerformedDate:  "2026-10-19",
+			StartedAt:      ptr("07:30"),
+			ConditionLevel: intPtr(5),
+			Parts: []dto.WorkoutPartGroupDTO{{Exercises: []dto.ExerciseDTO{{
+				Name: "Bench Press",
+				Sets: []dto.SetDTO{{SetNumber: 1, Reps: intPtr(0)}},
+			}}}},
+		}))
+	})
+
+	t.Run("異常系: メールアドレスとパスワードの規則をロケールごとのメッセージで返す", func(t *testing.T) {
+		t.Parallel()
+		err := v.Validate(&dto.SignUpRequest{Name: "Taro", Email: "taro", Password: "password"})
+
+		ja := fieldsOf(t, err, dom.LocaleJa)
+		require.Equal(t, map[string]string{
+			"email":    "emailは正しいメールアドレスでなければなりません",
+			"password": "passwordは8〜72文字で、英大文字・英小文字・数字をそれぞれ1文字以上含む必要があります",
+		}, ja)
+
+		en := fieldsOf(t, err, dom.LocaleEn)
+		require.Equal(t, "email must be a valid email address", en["email"])
+		require.Equal(t, "password must be 8-72 characters and contain an uppercase letter, a lowercase letter and a digit", en["password"])
+	})
+
+	t.Run("異常系: 未対応のロケールはデフォルトロケールのメッセージになる", func(t *testing.T) {
+		t.Parallel()
+		err := v.Validate(&dto.RefreshRequest{})
+		require.Equal(t, map[string]string{"refresh_token": "refresh_tokenは必須フィールドです"}, fieldsOf(t, err, "fr"))
+	})
+
+	t.Run("異常系: ネストした項目は JSON のパスで返す", func(t *testing.T) {
+		t.Parallel()
+		err := v.Validate(&dto.WorkoutRecordDTO{
+			PerformedDate:  "2026/10/19",
+			ConditionLevel: intPtr(6),
+			Parts: []dto.WorkoutPartGroupDTO{{Exercises: []dto.ExerciseDTO{
+				{Name: "Squat", Sets: []dto.SetDTO{{SetNumber: 1}}},
+				{Name: "Bench Press", Sets: []dto.SetDTO{{SetNumber: 1}, {SetNumber: -1, Reps: intPtr(-5)}}},
+			}}},
+		})
+
+		fields := fieldsOf(t, err, dom.LocaleEn)
+		require.Len(t, fields, 4)
+		require.Contains(t, fields, "performed_date")
+		require.Contains(t, fields, "condition_level")
+		require.Contains(t, fields, "parts[0].exercises[1].sets[1].set_number")
+		require.Contains(t, fields, "parts[0].exercises[1].sets[1].reps")
+	})
+
+	t.Run("正常系: 設定の更新は空文字で未設定に戻せる", func(t *testing.T) {
+		t.Parallel()
+		require.NoError(t, v.Validate(&dto.UpdateUserPreferencesRequest{Locale: ptr(""), WeightUnit: ptr("")}))
+		require.NoError(t, v.Validate(&dto.UpdateUserPreferencesRequest{Locale: ptr("en"), WeightUnit: ptr("lb")}))
+
+		err := v.Validate(&dto.UpdateUserPreferencesRequest{Locale: ptr("fr"), WeightUnit: ptr("st")})
+		require.Len(t, fieldsOf(t, err, dom.LocaleEn), 2)
+	})
+
+	t.Run("異常系: 検証対象でない値は型付きのエラーにしない", func(t *testing.T) {
+		t.Parallel()
+		err := v.Validate("not a struct")
+		require.Error(t, err)
+		var verr *Error
+		require.False(t, errors.As(err, &verr))
+	})
+}
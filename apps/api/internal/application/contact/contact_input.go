@@ -1,7 +1,34 @@
 package contact
 
-import "context"
+import (
+	"context"
+	"gogym-api/internal/adapter/dto"
+
+	do "gogym-api/internal/domain/entities/outbox"
+)
+
+// TopicContactNotify は問い合わせ通知のアウトボックストピック
+const TopicContactNotify = "contact.notify"
+
+// SendContactInput は問い合わせフォームの送信内容
+type SendContactInput struct {
+	Email        string
+	Message      string
+	UserID       *string // 未ログインの場合は nil
+	IP           string
+	UserAgent    string
+	Honeypot     string // 人間には見えない入力欄（値があればボットとみなす）
+	FormToken    string // IssueFormToken で発行したトークン
+	CaptchaToken string
+}
 
 type ContactUseCase interface {
-	SendContact(ctx context.Context, email, message string, userID *string, ip, ua string) error
+	// IssueFormToken はフォーム表示時に渡す、入力時間の検証用トークンを発行する
+	IssueFormToken(ctx context.Context) (dto.ContactFormTokenResponse, error)
+	SendContact(ctx context.Context, in SendContactInput) error
+	ListContacts(ctx context.Context, status string, limit, offset int) (dto.ContactMessageListResponse, error)
+	ResolveContact(ctx context.Context, id int64, adminUserID string) (dto.ContactMessageResponse, error)
+
+	// DeliverContact はアウトボックスのディスパッチャから呼ばれ、通知先（Slack等）へ送信する
+	DeliverContact(ctx context.Context, msg do.Message) error
 }
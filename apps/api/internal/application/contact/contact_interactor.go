@@ -2,44 +2,274 @@ package contact
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
-	"strings"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"gogym-api/internal/adapter/dto"
+	"gogym-api/internal/application/outbox"
+	dom "gogym-api/internal/domain/entities/contact"
+	dn "gogym-api/internal/domain/entities/notification"
+	do "gogym-api/internal/domain/entities/outbox"
+	"gogym-api/internal/util"
+
+	"go.opentelemetry.io/otel"
+)
+
+var tracer = otel.Tracer("gogym-api/internal/application/contact")
+
+const (
+	defaultListLimit = 50
+	maxListLimit     = 200
 )
 
+// contactNotifyPayload はアウトボックスに積む通知内容（本文はDBから再取得する）
+type contactNotifyPayload struct {
+	ContactID int64 `json:"contact_id"`
+}
+
 type contactInteractor struct {
-	sg SlackGateway
+	repo      Repository
+	tx        Transactor
+	publisher outbox.Publisher
+	notifier  Notifier
+	captcha   CaptchaVerifier
+	policy    SpamPolicy
+	now       func() time.Time
 }
 
-func NewContactInteractor(sg SlackGateway) ContactUseCase {
+func NewContactInteractor(repo Repository, tx Transactor, publisher outbox.Publisher, notifier Notifier, captcha CaptchaVerifier, policy SpamPolicy) ContactUseCase {
 	return &contactInteractor{
-		sg: sg,
+		repo:      repo,
+		tx:        tx,
+		publisher: publisher,
+		notifier:  notifier,
+		captcha:   captcha,
+		policy:    policy,
+		now:       func() time.Time { return time.Now().UTC() },
 	}
 }
 
-func (i *contactInteractor) SendContact(ctx context.Context, email, message string, userID *string, ip, ua string) error {
-	// ビジネスロジック: バリデーション
-	email = strings.TrimSpace(email)
-	message = strings.TrimSpace(message)
+// IssueFormToken はフォーム表示時刻を署名した使い捨てのトークンを発行する
+func (i *contactInteractor) IssueFormToken(ctx context.Context) (dto.ContactFormTokenResponse, error) {
+	now := i.now()
+	token, err := issueFormToken(i.policy.FormSecret, now)
+	if err != nil {
+		return dto.ContactFormTokenResponse{}, err
+	}
+	return dto.ContactFormTokenResponse{
+		Token:     token,
+		ExpiresAt: now.Add(i.policy.FormTokenTTL),
+	}, nil
+}
+
+// SendContact は問い合わせの保存と通知の予約を同一トランザクションで行う
+// 通知先への配信はアウトボックスのディスパッチャが非同期・リトライ付きで行う
+// スパムの疑いがあるものは保存のみ行い（隔離）、通知はしない
+func (i *contactInteractor) SendContact(ctx context.Context, in SendContactInput) (err error) {
+	ctx, span := tracer.Start(ctx, "ContactUseCase.SendContact")
+	defer func() { util.EndSpan(span, err) }()
 
-	if email == "" || message == "" {
-		return errors.New("email and message are required")
+	// ハニーポットに入力があるのはボットなので、成功を装って破棄する
+	if in.Honeypot != "" {
+		slog.WarnContext(ctx, "Contact submission dropped by honeypot", "ip", in.IP)
+		return nil
 	}
-	if len(email) > 255 || len(message) > 2000 {
-		return errors.New("email or message is too long")
+
+	now := i.now()
+	msg, err := dom.NewMessage(in.Email, in.Message, in.UserID, in.IP, in.UserAgent, now)
+	if err != nil {
+		return err
 	}
 
-	// メッセージの整形（ビジネスロジック）
-	userIDStr := "anonymous"
-	if userID != nil && *userID != "" {
-		userIDStr = *userID
+	token, err := i.checkAbuse(ctx, in, msg, now)
+	if err != nil {
+		return err
+	}
+
+	if score, reasons := scoreSpam(msg.Body, i.policy.MaxLinks, i.policy.Keywords); score > 0 {
+		msg.Quarantine(score, reasons)
+		slog.WarnContext(ctx, "Contact submission quarantined", "ip", in.IP, "score", score, "reasons", reasons)
+	}
+
+	// ノンスの消費は保存と同じトランザクションで行い、保存に失敗したトークンは再利用できるようにする
+	return i.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if token != nil {
+			if err := i.repo.ConsumeFormNonce(ctx, token.Nonce, token.IssuedAt.Add(i.policy.FormTokenTTL)); err != nil {
+				return err
+			}
+		}
+		if err := i.repo.Create(ctx, msg); err != nil {
+			return err
+		}
+		if msg.IsQuarantined() {
+			return nil
+		}
+		return i.publisher.Publish(ctx, TopicContactNotify, contactNotifyPayload{ContactID: msg.ID})
+	})
+}
+
+// checkAbuse はフォームトークン・CAPTCHA・送信数の上限を検証し、検証したトークンを返す（未送信なら nil）
+func (i *contactInteractor) checkAbuse(ctx context.Context, in SendContactInput, msg *dom.Message, now time.Time) (*formToken, error) {
+	var token *formToken
+	if in.FormToken != "" {
+		t, err := verifyFormToken(i.policy.FormSecret, in.FormToken, i.policy.MinFillTime, i.policy.FormTokenTTL, now)
+		if err != nil {
+			return nil, err
+		}
+		token = &t
+	} else if i.policy.RequireFormToken {
+		return nil, dom.ErrInvalidFormToken
+	}
+
+	if i.captcha != nil && i.captcha.Enabled() {
+		if in.CaptchaToken == "" {
+			return nil, dom.ErrCaptchaFailed
+		}
+		ok, err := i.captcha.Verify(ctx, in.CaptchaToken, in.IP)
+		if err != nil {
+			return nil, fmt.Errorf("failed to verify captcha: %w", err)
+		}
+		if !ok {
+			return nil, dom.ErrCaptchaFailed
+		}
+	}
+
+	since := now.Add(-i.policy.QuotaWindow)
+	if i.policy.MaxPerIP > 0 && msg.IP != "" {
+		count, err := i.repo.CountByIPSince(ctx, msg.IP, since)
+		if err != nil {
+			return nil, err
+		}
+		if count >= int64(i.policy.MaxPerIP) {
+			return nil, dom.ErrRateLimited
+		}
+	}
+	if i.policy.MaxPerEmail > 0 {
+		count, err := i.repo.CountByEmailSince(ctx, msg.Email, since)
+		if err != nil {
+			return nil, err
+		}
+		if count >= int64(i.policy.MaxPerEmail) {
+			return nil, dom.ErrRateLimited
+		}
+	}
+
+	return token, nil
+}
+
+// DeliverContact は通知先へ送信し、配信結果を問い合わせに記録する
+// エラーを返すとディスパッチャがバックオフ後にリトライする
+// 通知先が未設定の場合はリトライしても成功しないため、未配信（skipped）として記録し完了扱いにする
+func (i *contactInteractor) DeliverContact(ctx context.Context, om do.Message) (err error) {
+	ctx, span := tracer.Start(ctx, "ContactUseCase.DeliverContact")
+	defer func() { util.EndSpan(span, err) }()
+
+	var payload contactNotifyPayload
+	if err := json.Unmarshal(om.Payload, &payload); err != nil {
+		return fmt.Errorf("invalid contact notify payload: %w", err)
+	}
+
+	msg, err := i.repo.FindByID(ctx, payload.ContactID)
+	if err != nil {
+		return err
+	}
+	if msg.DeliveryStatus == dom.DeliveryDelivered || msg.IsQuarantined() {
+		return nil
+	}
+
+	err = i.notifier.Notify(ctx, buildContactNotification(msg))
+	if errors.Is(err, dn.ErrChannelNotConfigured) {
+		slog.DebugContext(ctx, "Contact notification channel not configured, skipped", "contactID", msg.ID)
+		msg.MarkDeliverySkipped(i.now())
+		return i.repo.Update(ctx, msg)
 	}
+	if err != nil {
+		msg.MarkDeliveryFailed(err, om.IsFinalAttempt(), i.now())
+		if uerr := i.repo.Update(ctx, msg); uerr != nil {
+			slog.ErrorContext(ctx, "Failed to record contact delivery failure", "contactID", msg.ID, "error", uerr)
+		}
+		return err
+	}
+
+	msg.MarkDelivered(i.now())
+	return i.repo.Update(ctx, msg)
+}
 
-	text := "📩 Contact Form Submission\n\n" +
-		"From: " + email + "\n" +
-		"User ID: " + userIDStr + "\n" +
-		"IP: " + ip + "\n" +
-		"User Agent: " + ua + "\n\n" +
-		"Message:\n" + message
+// ListContacts は管理者向けに問い合わせ一覧を返す
+func (i *contactInteractor) ListContacts(ctx context.Context, status string, limit, offset int) (_ dto.ContactMessageListResponse, err error) {
+	ctx, span := tracer.Start(ctx, "ContactUseCase.ListContacts")
+	defer func() { util.EndSpan(span, err) }()
 
-	return i.sg.NotifyContact(ctx, text)
+	filter := ListFilter{Limit: limit, Offset: offset}
+	if status != "" {
+		s := dom.Status(status)
+		if !s.Valid() {
+			return dto.ContactMessageListResponse{}, dom.ErrInvalidStatus
+		}
+		filter.Status = &s
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = defaultListLimit
+	}
+	if filter.Limit > maxListLimit {
+		filter.Limit = maxListLimit
+	}
+	if filter.Offset < 0 {
+		filter.Offset = 0
+	}
+
+	messages, total, err := i.repo.List(ctx, filter)
+	if err != nil {
+		return dto.ContactMessageListResponse{}, err
+	}
+
+	return dto.ContactMessagesToListResponse(messages, total), nil
+}
+
+// ResolveContact は問い合わせを対応済みにする
+func (i *contactInteractor) ResolveContact(ctx context.Context, id int64, adminUserID string) (_ dto.ContactMessageResponse, err error) {
+	ctx, span := tracer.Start(ctx, "ContactUseCase.ResolveContact")
+	defer func() { util.EndSpan(span, err) }()
+
+	msg, err := i.repo.FindByID(ctx, id)
+	if err != nil {
+		return dto.ContactMessageResponse{}, err
+	}
+
+	if err := msg.Resolve(adminUserID, i.now()); err != nil {
+		return dto.ContactMessageResponse{}, err
+	}
+
+	if err := i.repo.Update(ctx, msg); err != nil {
+		return dto.ContactMessageResponse{}, err
+	}
+
+	return dto.ContactMessageToResponse(msg), nil
+}
+
+// buildContactNotification は問い合わせ内容を通知に変換する
+func buildContactNotification(msg *dom.Message) dn.Notification {
+	userIDStr := "anonymous"
+	if msg.UserID != nil && *msg.UserID != "" {
+		userIDStr = *msg.UserID
+	}
+
+	return dn.Notification{
+		Channel: dn.ChannelContact,
+		Title:   "📩 Contact Form Submission",
+		Text:    msg.Body,
+		Fields: []dn.Field{
+			{Label: "Contact ID", Value: strconv.FormatInt(msg.ID, 10)},
+		},
+		Context: []dn.Field{
+			{Label: "Email", Value: msg.Email},
+			{Label: "User ID", Value: userIDStr},
+			{Label: "IP", Value: msg.IP},
+			{Label: "User Agent", Value: msg.UserAgent},
+		},
+	}
 }
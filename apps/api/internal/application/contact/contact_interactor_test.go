@@ -0,0 +1,441 @@
+package contact
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	dom "gogym-api/internal/domain/entities/contact"
+	dn "gogym-api/internal/domain/entities/notification"
+	do "gogym-api/internal/domain/entities/outbox"
+	"gogym-api/internal/infra/captcha/captchatest"
+)
+
+// memoryRepository はテスト用のインメモリ実装
+type memoryRepository struct {
+	mu     sync.Mutex
+	msgs   []dom.Message
+	nonces map[string]time.Time
+}
+
+func (r *memoryRepository) Create(_ context.Context, m *dom.Message) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	m.ID = int64(len(r.msgs) + 1)
+	r.msgs = append(r.msgs, *m)
+	return nil
+}
+
+func (r *memoryRepository) FindByID(_ context.Context, id int64) (*dom.Message, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i := range r.msgs {
+		if r.msgs[i].ID == id {
+			m := r.msgs[i]
+			return &m, nil
+		}
+	}
+	return nil, dom.ErrNotFound
+}
+
+func (r *memoryRepository) List(_ context.Context, _ ListFilter) ([]dom.Message, int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]dom.Message(nil), r.msgs...), int64(len(r.msgs)), nil
+}
+
+func (r *memoryRepository) Update(_ context.Context, m *dom.Message) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i := range r.msgs {
+		if r.msgs[i].ID == m.ID {
+			r.msgs[i] = *m
+		}
+	}
+	return nil
+}
+
+func (r *memoryRepository) CountByIPSince(_ context.Context, ip string, since time.Time) (int64, error) {
+	return r.count(func(m dom.Message) bool { return m.IP == ip && !m.CreatedAt.Before(since) }), nil
+}
+
+func (r *memoryRepository) CountByEmailSince(_ context.Context, email string, since time.Time) (int64, error) {
+	return r.count(func(m dom.Message) bool { return strings.EqualFold(m.Email, email) && !m.CreatedAt.Before(since) }), nil
+}
+
+func (r *memoryRepository) ConsumeFormNonce(_ context.Context, nonce string, expiresAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, used := r.nonces[nonce]; used {
+		return dom.ErrInvalidFormToken
+	}
+	if r.nonces == nil {
+		r.nonces = map[string]time.Time{}
+	}
+	r.nonces[nonce] = expiresAt
+	return nil
+}
+
+func (r *memoryRepository) count(match func(dom.Message) bool) int64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var n int64
+	for _, m := range r.msgs {
+		if match(m) {
+			n++
+		}
+	}
+	return n
+}
+
+type passthroughTx struct{}
+
+func (passthroughTx) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// recordingPublisher は発行されたトピックを記録する
+type recordingPublisher struct {
+	mu     sync.Mutex
+	topics []string
+}
+
+func (p *recordingPublisher) Publish(_ context.Context, topic string, _ any) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.topics = append(p.topics, topic)
+	return nil
+}
+
+// stubNotifier は err を返し、送信された通知を記録する
+type stubNotifier struct {
+	mu   sync.Mutex
+	err  error
+	sent []dn.Notification
+}
+
+func (n *stubNotifier) Notify(_ context.Context, notification dn.Notification) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.sent = append(n.sent, notification)
+	return n.err
+}
+
+type fixture struct {
+	repo      *memoryRepository
+	publisher *recordingPublisher
+	notifier  *stubNotifier
+	captcha   *captchatest.Verifier
+	uc        *contactInteractor
+	now       time.Time
+}
+
+func newFixture(policy SpamPolicy) *fixture {
+	f := &fixture{
+		repo:      &memoryRepository{},
+		publisher: &recordingPublisher{},
+		notifier:  &stubNotifier{},
+		captcha:   &captchatest.Verifier{ValidToken: "human", Disabled: true},
+		now:       time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC),
+	}
+	if policy.FormSecret == "" {
+		policy.FormSecret = "test-secret"
+	}
+	uc := NewContactInteractor(f.repo, passthroughTx{}, f.publisher, f.notifier, f.captcha, policy).(*contactInteractor)
+	uc.now = func() time.Time { return f.now }
+	f.uc = uc
+	return f
+}
+
+func validInput() SendContactInput {
+	return SendContactInput{
+		Email:     "user@example.com",
+		Message:   "ベンチプレスの記録が保存できません",
+		IP:        "203.0.113.1",
+		UserAgent: "test",
+	}
+}
+
+func TestContactInteractor_SendContact(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("正常系: 問題のない問い合わせは保存され通知が予約される", func(t *testing.T) {
+		t.Parallel()
+
+		f := newFixture(SpamPolicy{MaxLinks: 2})
+		require.NoError(t, f.uc.SendContact(ctx, validInput()))
+
+		require.Len(t, f.repo.msgs, 1)
+		require.Equal(t, dom.StatusOpen, f.repo.msgs[0].Status)
+		require.Equal(t, []string{TopicContactNotify}, f.publisher.topics)
+	})
+
+	t.Run("正常系: ハニーポットに入力がある場合は成功扱いで破棄する", func(t *testing.T) {
+		t.Parallel()
+
+		f := newFixture(SpamPolicy{})
+		in := validInput()
+		in.Honeypot = "https://spam.example.com"
+
+		require.NoError(t, f.uc.SendContact(ctx, in))
+		require.Empty(t, f.repo.msgs)
+		require.Empty(t, f.publisher.topics)
+	})
+
+	t.Run("正常系: リンクが多すぎる・キーワードを含む場合は隔離し通知しない", func(t *testing.T) {
+		t.Parallel()
+
+		f := newFixture(SpamPolicy{MaxLinks: 1, Keywords: []string{"Casino"}})
+		in := validInput()
+		in.Message = "casino http://a.example.com https://b.example.com"
+
+		require.NoError(t, f.uc.SendContact(ctx, in))
+		require.Len(t, f.repo.msgs, 1)
+		got := f.repo.msgs[0]
+		require.Equal(t, dom.StatusQuarantined, got.Status)
+		require.Equal(t, linkScore+keywordScore, got.SpamScore)
+		require.Equal(t, []string{"links:2", "keyword:casino"}, got.SpamReasons)
+		require.Empty(t, f.publisher.topics)
+	})
+
+	t.Run("正常系: 最短入力時間を過ぎたフォームトークンは受け付ける", func(t *testing.T) {
+		t.Parallel()
+
+		f := newFixture(SpamPolicy{RequireFormToken: true, MinFillTime: 3 * time.Second, FormTokenTTL: time.Hour})
+		token, err := f.uc.IssueFormToken(ctx)
+		require.NoError(t, err)
+
+		f.now = f.now.Add(5 * time.Second)
+		in := validInput()
+		in.FormToken = token.Token
+		require.NoError(t, f.uc.SendContact(ctx, in))
+		require.Len(t, f.repo.nonces, 1)
+	})
+
+	t.Run("異常系: 同じフォームトークンでの再送信は拒否する", func(t *testing.T) {
+		t.Parallel()
+
+		f := newFixture(SpamPolicy{RequireFormToken: true, FormTokenTTL: time.Hour})
+		token, err := f.uc.IssueFormToken(ctx)
+		require.NoError(t, err)
+		other, err := f.uc.IssueFormToken(ctx)
+		require.NoError(t, err)
+		require.NotEqual(t, token.Token, other.Token)
+
+		in := validInput()
+		in.FormToken = token.Token
+		require.NoError(t, f.uc.SendContact(ctx, in))
+		require.ErrorIs(t, f.uc.SendContact(ctx, in), dom.ErrInvalidFormToken)
+		require.Len(t, f.repo.msgs, 1)
+
+		in.FormToken = other.Token
+		require.NoError(t, f.uc.SendContact(ctx, in))
+	})
+
+	t.Run("異常系: トークン発行直後の送信は拒否する", func(t *testing.T) {
+		t.Parallel()
+
+		f := newFixture(SpamPolicy{MinFillTime: 3 * time.Second, FormTokenTTL: time.Hour})
+		token, err := f.uc.IssueFormToken(ctx)
+		require.NoError(t, err)
+
+		f.now = f.now.Add(time.Second)
+		in := validInput()
+		in.FormToken = token.Token
+		require.ErrorIs(t, f.uc.SendContact(ctx, in), dom.ErrSubmittedTooFast)
+	})
+
+	t.Run("異常系: 改ざん・期限切れ・未送信のトークンは拒否する", func(t *testing.T) {
+		t.Parallel()
+
+		f := newFixture(SpamPolicy{RequireFormToken: true, FormTokenTTL: time.Hour})
+		token, err := f.uc.IssueFormToken(ctx)
+		require.NoError(t, err)
+
+		in := validInput()
+		in.FormToken = token.Token + "x"
+		require.ErrorIs(t, f.uc.SendContact(ctx, in), dom.ErrInvalidFormToken)
+
+		// 別の鍵で署名されたトークン
+		in.FormToken, err = issueFormToken("other-secret", f.now)
+		require.NoError(t, err)
+		require.ErrorIs(t, f.uc.SendContact(ctx, in), dom.ErrInvalidFormToken)
+
+		f.now = f.now.Add(2 * time.Hour)
+		in.FormToken = token.Token
+		require.ErrorIs(t, f.uc.SendContact(ctx, in), dom.ErrInvalidFormToken)
+
+		in.FormToken = ""
+		require.ErrorIs(t, f.uc.SendContact(ctx, in), dom.ErrInvalidFormToken)
+	})
+
+	t.Run("異常系: CAPTCHAが有効な場合は検証に失敗すると拒否する", func(t *testing.T) {
+		t.Parallel()
+
+		f := newFixture(SpamPolicy{})
+		f.captcha.Disabled = false
+
+		in := validInput()
+		require.ErrorIs(t, f.uc.SendContact(ctx, in), dom.ErrCaptchaFailed)
+
+		in.CaptchaToken = "bot"
+		require.ErrorIs(t, f.uc.SendContact(ctx, in), dom.ErrCaptchaFailed)
+
+		in.CaptchaToken = "human"
+		require.NoError(t, f.uc.SendContact(ctx, in))
+		require.Equal(t, []string{"bot", "human"}, f.captcha.Calls())
+	})
+
+	t.Run("異常系: CAPTCHAプロバイダーのエラーはそのまま返す", func(t *testing.T) {
+		t.Parallel()
+
+		f := newFixture(SpamPolicy{})
+		f.captcha.Disabled = false
+		f.captcha.Err = errors.New("provider down")
+
+		in := validInput()
+		in.CaptchaToken = "human"
+		err := f.uc.SendContact(ctx, in)
+		require.Error(t, err)
+		require.NotErrorIs(t, err, dom.ErrCaptchaFailed)
+	})
+
+	t.Run("異常系: 同じIP・メールアドレスからの送信数が上限を超えると拒否する", func(t *testing.T) {
+		t.Parallel()
+
+		f := newFixture(SpamPolicy{QuotaWindow: time.Hour, MaxPerIP: 2, MaxPerEmail: 1})
+
+		require.NoError(t, f.uc.SendContact(ctx, validInput()))
+
+		sameEmail := validInput()
+		sameEmail.Email = "USER@example.com"
+		sameEmail.IP = "198.51.100.1"
+		require.ErrorIs(t, f.uc.SendContact(ctx, sameEmail), dom.ErrRateLimited)
+
+		sameIP := validInput()
+		sameIP.Email = "other@example.com"
+		require.NoError(t, f.uc.SendContact(ctx, sameIP))
+
+		sameIP.Email = "third@example.com"
+		require.ErrorIs(t, f.uc.SendContact(ctx, sameIP), dom.ErrRateLimited)
+
+		// 期間を過ぎれば再び送信できる
+		f.now = f.now.Add(2 * time.Hour)
+		require.NoError(t, f.uc.SendContact(ctx, sameIP))
+	})
+}
+
+// outboxMessage は問い合わせ id の通知メッセージを attempts 回目の試行として返す
+func outboxMessage(t *testing.T, id int64, attempts, maxAttempts int) do.Message {
+	t.Helper()
+
+	payload, err := json.Marshal(contactNotifyPayload{ContactID: id})
+	require.NoError(t, err)
+	return do.Message{Topic: TopicContactNotify, Payload: payload, Attempts: attempts, MaxAttempts: maxAttempts}
+}
+
+func TestContactInteractor_DeliverContact(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("正常系: 送信に成功すると配信済みにする", func(t *testing.T) {
+		t.Parallel()
+
+		f := newFixture(SpamPolicy{})
+		require.NoError(t, f.uc.SendContact(ctx, validInput()))
+
+		require.NoError(t, f.uc.DeliverContact(ctx, outboxMessage(t, 1, 0, 3)))
+
+		got := f.repo.msgs[0]
+		require.Equal(t, dom.DeliveryDelivered, got.DeliveryStatus)
+		require.Equal(t, 1, got.DeliveryAttempts)
+		require.NotNil(t, got.DeliveredAt)
+		require.Nil(t, got.LastDeliveryError)
+		require.Len(t, f.notifier.sent, 1)
+		require.Equal(t, dn.ChannelContact, f.notifier.sent[0].Channel)
+	})
+
+	t.Run("正常系: 配信済み・隔離済みの問い合わせは再送しない", func(t *testing.T) {
+		t.Parallel()
+
+		f := newFixture(SpamPolicy{MaxLinks: 1})
+		require.NoError(t, f.uc.SendContact(ctx, validInput()))
+		require.NoError(t, f.uc.DeliverContact(ctx, outboxMessage(t, 1, 0, 3)))
+
+		spam := validInput()
+		spam.Message = "http://a.example.com https://b.example.com"
+		require.NoError(t, f.uc.SendContact(ctx, spam))
+
+		require.NoError(t, f.uc.DeliverContact(ctx, outboxMessage(t, 1, 1, 3)))
+		require.NoError(t, f.uc.DeliverContact(ctx, outboxMessage(t, 2, 0, 3)))
+		require.Len(t, f.notifier.sent, 1)
+		require.Equal(t, 1, f.repo.msgs[0].DeliveryAttempts)
+	})
+
+	t.Run("正常系: 通知先が未設定の場合はリトライせず未配信として記録する", func(t *testing.T) {
+		t.Parallel()
+
+		f := newFixture(SpamPolicy{})
+		f.notifier.err = dn.ErrChannelNotConfigured
+		require.NoError(t, f.uc.SendContact(ctx, validInput()))
+
+		require.NoError(t, f.uc.DeliverContact(ctx, outboxMessage(t, 1, 0, 3)))
+
+		got := f.repo.msgs[0]
+		require.Equal(t, dom.DeliverySkipped, got.DeliveryStatus)
+		require.Zero(t, got.DeliveryAttempts)
+		require.Nil(t, got.LastDeliveryError)
+	})
+
+	t.Run("異常系: 送信に失敗するとエラーを返し、リトライ中は配信待ちのままにする", func(t *testing.T) {
+		t.Parallel()
+
+		f := newFixture(SpamPolicy{})
+		f.notifier.err = errors.New("slack: 500")
+		require.NoError(t, f.uc.SendContact(ctx, validInput()))
+
+		require.ErrorIs(t, f.uc.DeliverContact(ctx, outboxMessage(t, 1, 0, 3)), f.notifier.err)
+		require.ErrorIs(t, f.uc.DeliverContact(ctx, outboxMessage(t, 1, 1, 3)), f.notifier.err)
+
+		got := f.repo.msgs[0]
+		require.Equal(t, dom.DeliveryPending, got.DeliveryStatus)
+		require.Equal(t, 2, got.DeliveryAttempts)
+		require.NotNil(t, got.LastDeliveryError)
+		require.Equal(t, "slack: 500", *got.LastDeliveryError)
+		require.Nil(t, got.DeliveredAt)
+	})
+
+	t.Run("異常系: 最後の試行に失敗すると配信失敗にする", func(t *testing.T) {
+		t.Parallel()
+
+		f := newFixture(SpamPolicy{})
+		f.notifier.err = errors.New("slack: 500")
+		require.NoError(t, f.uc.SendContact(ctx, validInput()))
+
+		require.ErrorIs(t, f.uc.DeliverContact(ctx, outboxMessage(t, 1, 2, 3)), f.notifier.err)
+		require.Equal(t, dom.DeliveryFailed, f.repo.msgs[0].DeliveryStatus)
+
+		// 失敗後に再送が成功すれば配信済みに戻る
+		f.notifier.err = nil
+		require.NoError(t, f.uc.DeliverContact(ctx, outboxMessage(t, 1, 0, 3)))
+		require.Equal(t, dom.DeliveryDelivered, f.repo.msgs[0].DeliveryStatus)
+	})
+
+	t.Run("異常系: 不正なペイロード・存在しない問い合わせはエラーを返す", func(t *testing.T) {
+		t.Parallel()
+
+		f := newFixture(SpamPolicy{})
+		require.Error(t, f.uc.DeliverContact(ctx, do.Message{Topic: TopicContactNotify, Payload: []byte("{")}))
+		require.ErrorIs(t, f.uc.DeliverContact(ctx, outboxMessage(t, 99, 0, 3)), dom.ErrNotFound)
+		require.Empty(t, f.notifier.sent)
+	})
+}
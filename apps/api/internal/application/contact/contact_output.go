@@ -1,7 +1,47 @@
 package contact
 
-import "context"
+import (
+	"context"
+	"time"
 
-type SlackGateway interface {
-	NotifyContact(ctx context.Context, text string) error
+	dom "gogym-api/internal/domain/entities/contact"
+	dn "gogym-api/internal/domain/entities/notification"
+)
+
+// Notifier は Slack / Discord / 汎用Webhook などの通知先
+type Notifier interface {
+	Notify(ctx context.Context, n dn.Notification) error
+}
+
+// CaptchaVerifier は CAPTCHA トークンを検証する（Turnstile / hCaptcha 等を差し替え可能）
+type CaptchaVerifier interface {
+	// Enabled が false の場合は検証をスキップする
+	Enabled() bool
+	Verify(ctx context.Context, token, remoteIP string) (bool, error)
+}
+
+// Transactor は複数リポジトリへの書き込みを1トランザクションにまとめる
+type Transactor interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// ListFilter は管理画面の一覧取得条件
+type ListFilter struct {
+	Status *dom.Status // nil の場合は全件
+	Limit  int
+	Offset int
+}
+
+// Repository は問い合わせの永続化を担当
+type Repository interface {
+	Create(ctx context.Context, m *dom.Message) error
+	FindByID(ctx context.Context, id int64) (*dom.Message, error)
+	List(ctx context.Context, filter ListFilter) ([]dom.Message, int64, error)
+	Update(ctx context.Context, m *dom.Message) error
+	// 送信数の上限チェック用
+	CountByIPSince(ctx context.Context, ip string, since time.Time) (int64, error)
+	CountByEmailSince(ctx context.Context, email string, since time.Time) (int64, error)
+	// ConsumeFormNonce はフォームトークンのノンスを使用済みにする（使用済みなら dom.ErrInvalidFormToken）
+	// expiresAt を過ぎたノンスは削除してよい（期限切れのトークンは署名の検証で拒否される）
+	ConsumeFormNonce(ctx context.Context, nonce string, expiresAt time.Time) error
 }
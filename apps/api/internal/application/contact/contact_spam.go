@@ -0,0 +1,111 @@
+package contact
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	dom "gogym-api/internal/domain/entities/contact"
+)
+
+const (
+	linkScore    = 2 // リンク数が上限を超えた場合のスコア
+	keywordScore = 1 // スパムキーワード1件あたりのスコア
+)
+
+var linkPattern = regexp.MustCompile(`(?i)(https?://|www\.)\S+`)
+
+// SpamPolicy は問い合わせフォームのスパム・濫用対策の設定
+type SpamPolicy struct {
+	FormSecret       string        // フォームトークンの署名鍵
+	RequireFormToken bool          // true の場合トークンなしの送信を拒否
+	MinFillTime      time.Duration // トークン発行から送信までの最短時間
+	FormTokenTTL     time.Duration // トークンの有効期限（使用済みのノンスもこの期間だけ保持する）
+	QuotaWindow      time.Duration // 送信数を数える期間
+	MaxPerIP         int           // 期間内のIPごとの上限（0以下で無制限）
+	MaxPerEmail      int           // 期間内のメールアドレスごとの上限（0以下で無制限）
+	MaxLinks         int           // 本文中のリンク数の上限（超えると隔離）
+	Keywords         []string      // 本文に含まれていたら隔離するキーワード
+}
+
+// formToken は検証済みのフォームトークン
+type formToken struct {
+	Nonce    string    // 使い捨て判定に使う乱数
+	IssuedAt time.Time // 発行時刻
+}
+
+// issueFormToken は発行時刻とノンスを署名したトークンを生成する（"<unix>.<nonce>.<署名>"）
+func issueFormToken(secret string, now time.Time) (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate form token nonce: %w", err)
+	}
+	payload := strconv.FormatInt(now.Unix(), 10) + "." + base64.RawURLEncoding.EncodeToString(b)
+	return payload + "." + signFormToken(secret, payload), nil
+}
+
+// verifyFormToken はトークンの署名と経過時間を検証する
+// 使用済みかどうかは確認しない（Repository.ConsumeFormNonce で判定する）
+func verifyFormToken(secret, token string, minAge, ttl time.Duration, now time.Time) (formToken, error) {
+	i := strings.LastIndex(token, ".")
+	if i < 0 {
+		return formToken{}, dom.ErrInvalidFormToken
+	}
+	payload, sig := token[:i], token[i+1:]
+	if !hmac.Equal([]byte(sig), []byte(signFormToken(secret, payload))) {
+		return formToken{}, dom.ErrInvalidFormToken
+	}
+
+	ts, nonce, ok := strings.Cut(payload, ".")
+	if !ok || nonce == "" {
+		return formToken{}, dom.ErrInvalidFormToken
+	}
+	unix, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		return formToken{}, dom.ErrInvalidFormToken
+	}
+
+	issuedAt := time.Unix(unix, 0)
+	age := now.Sub(issuedAt)
+	if ttl > 0 && age > ttl {
+		return formToken{}, dom.ErrInvalidFormToken
+	}
+	if age < minAge {
+		return formToken{}, dom.ErrSubmittedTooFast
+	}
+	return formToken{Nonce: nonce, IssuedAt: issuedAt}, nil
+}
+
+func signFormToken(secret, payload string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte("contact-form:" + payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// scoreSpam は本文のリンク数とキーワードからスパムスコアを算出する
+func scoreSpam(body string, maxLinks int, keywords []string) (int, []string) {
+	score := 0
+	var reasons []string
+
+	if links := len(linkPattern.FindAllString(body, -1)); links > maxLinks {
+		score += linkScore
+		reasons = append(reasons, fmt.Sprintf("links:%d", links))
+	}
+
+	lower := strings.ToLower(body)
+	for _, kw := range keywords {
+		kw = strings.ToLower(strings.TrimSpace(kw))
+		if kw != "" && strings.Contains(lower, kw) {
+			score += keywordScore
+			reasons = append(reasons, "keyword:"+kw)
+		}
+	}
+
+	return score, reasons
+}
@@ -2,13 +2,12 @@ package gym
 
 import (
 	"context"
-	"errors"
-
+	domain "gogym-api/internal/domain/entities"
 	dom "gogym-api/internal/domain/entities/gym"
 )
 
 // ErrNotFound is returned when a gym is not found
-var ErrNotFound = errors.New("gym not found")
+var ErrNotFound = domain.NewError(domain.KindNotFound, "gym_not_found", "gym not found")
 
 type Repository interface {
 	// FindByNormalizedName finds a gym by normalized name and creator
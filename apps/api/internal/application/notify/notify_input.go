@@ -0,0 +1,17 @@
+package notify
+
+import (
+	"context"
+
+	do "gogym-api/internal/domain/entities/outbox"
+)
+
+// TopicNotification は汎用通知のアウトボックストピック（payload は notification.Notification）
+const TopicNotification = "notification.send"
+
+type NotifyUseCase interface {
+	// Deliver はアウトボックスのディスパッチャから呼ばれ、通知を送信する
+	Deliver(ctx context.Context, msg do.Message) error
+	// NotifyDeadLetter はリトライ上限に達したメッセージをエラーチャンネルに通知する
+	NotifyDeadLetter(ctx context.Context, msg do.Message)
+}
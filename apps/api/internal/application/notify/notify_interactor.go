@@ -0,0 +1,73 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+
+	dn "gogym-api/internal/domain/entities/notification"
+	do "gogym-api/internal/domain/entities/outbox"
+	"gogym-api/internal/util"
+
+	"go.opentelemetry.io/otel"
+)
+
+var tracer = otel.Tracer("gogym-api/internal/application/notify")
+
+type notifyInteractor struct {
+	notifier Notifier
+}
+
+func NewNotifyInteractor(notifier Notifier) NotifyUseCase {
+	return &notifyInteractor{
+		notifier: notifier,
+	}
+}
+
+// Deliver は payload の通知を送信する
+// 送信先が未設定のチャンネルはリトライしても成功しないため、スキップして完了扱いにする
+func (i *notifyInteractor) Deliver(ctx context.Context, msg do.Message) (err error) {
+	ctx, span := tracer.Start(ctx, "NotifyUseCase.Deliver")
+	defer func() { util.EndSpan(span, err) }()
+
+	var n dn.Notification
+	if err := json.Unmarshal(msg.Payload, &n); err != nil {
+		return fmt.Errorf("invalid notification payload: %w", err)
+	}
+
+	err = i.notifier.Notify(ctx, n)
+	if errors.Is(err, dn.ErrChannelNotConfigured) {
+		slog.DebugContext(ctx, "Notification channel not configured, skipped", "channel", n.Channel)
+		return nil
+	}
+	return err
+}
+
+// NotifyDeadLetter はデッドレターになったメッセージをエラーチャンネルに送る
+// ここでの失敗はアウトボックスに積まない（通知のループを避ける）
+func (i *notifyInteractor) NotifyDeadLetter(ctx context.Context, msg do.Message) {
+	ctx, span := tracer.Start(ctx, "NotifyUseCase.NotifyDeadLetter")
+	defer span.End()
+
+	lastError := ""
+	if msg.LastError != nil {
+		lastError = *msg.LastError
+	}
+
+	err := i.notifier.Notify(ctx, dn.Notification{
+		Channel: dn.ChannelErrors,
+		Title:   "⚠️ Outbox message dead-lettered",
+		Text:    "```" + lastError + "```",
+		Fields: []dn.Field{
+			{Label: "Topic", Value: msg.Topic},
+			{Label: "Outbox ID", Value: strconv.FormatInt(msg.ID, 10)},
+			{Label: "Attempts", Value: strconv.Itoa(msg.Attempts)},
+		},
+	})
+	if err != nil && !errors.Is(err, dn.ErrChannelNotConfigured) {
+		slog.ErrorContext(ctx, "Failed to notify dead-lettered message", "outboxID", msg.ID, "error", err)
+	}
+}
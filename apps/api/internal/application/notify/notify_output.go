@@ -0,0 +1,12 @@
+package notify
+
+import (
+	"context"
+
+	dn "gogym-api/internal/domain/entities/notification"
+)
+
+// Notifier は Slack / Discord / 汎用Webhook などの通知先
+type Notifier interface {
+	Notify(ctx context.Context, n dn.Notification) error
+}
@@ -0,0 +1,193 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	dom "gogym-api/internal/domain/entities/outbox"
+)
+
+// Options はディスパッチャの動作設定
+type Options struct {
+	PollInterval   time.Duration // ポーリング間隔
+	BatchSize      int           // 1回に取得する件数
+	MaxAttempts    int           // デッドレターまでの最大試行回数
+	BaseBackoff    time.Duration // 初回リトライまでの待機時間（以降2倍ずつ）
+	MaxBackoff     time.Duration // リトライ待機時間の上限
+	Lease          time.Duration // 処理中ロックの有効期間（プロセスが落ちた場合の再取得用）
+	HandlerTimeout time.Duration // 1メッセージあたりの処理タイムアウト
+}
+
+func (o Options) withDefaults() Options {
+	if o.PollInterval <= 0 {
+		o.PollInterval = 2 * time.Second
+	}
+	if o.BatchSize <= 0 {
+		o.BatchSize = 20
+	}
+	if o.MaxAttempts <= 0 {
+		o.MaxAttempts = 8
+	}
+	if o.BaseBackoff <= 0 {
+		o.BaseBackoff = 5 * time.Second
+	}
+	if o.MaxBackoff <= 0 {
+		o.MaxBackoff = 30 * time.Minute
+	}
+	if o.Lease <= 0 {
+		o.Lease = time.Minute
+	}
+	if o.HandlerTimeout <= 0 {
+		o.HandlerTimeout = 10 * time.Second
+	}
+	return o
+}
+
+var errNoHandler = errors.New("no handler registered for topic")
+
+// Dispatcher はアウトボックスをポーリングしてトピックごとのハンドラーに配信する
+type Dispatcher struct {
+	repo     Repository
+	opts     Options
+	handlers map[string]Handler
+	onDead   func(ctx context.Context, msg dom.Message)
+
+	startOnce sync.Once
+	stopOnce  sync.Once
+	stopCh    chan struct{}
+	doneCh    chan struct{}
+}
+
+func NewDispatcher(repo Repository, opts Options) *Dispatcher {
+	return &Dispatcher{
+		repo:     repo,
+		opts:     opts.withDefaults(),
+		handlers: map[string]Handler{},
+		stopCh:   make(chan struct{}),
+		doneCh:   make(chan struct{}),
+	}
+}
+
+// Register はトピックのハンドラーを登録する（Start 前に呼ぶこと）
+func (d *Dispatcher) Register(topic string, h Handler) {
+	d.handlers[topic] = h
+}
+
+// OnDeadLetter はメッセージがデッドレターになったときに呼ばれる関数を登録する（Start 前に呼ぶこと）
+func (d *Dispatcher) OnDeadLetter(fn func(ctx context.Context, msg dom.Message)) {
+	d.onDead = fn
+}
+
+// Start はバックグラウンドでポーリングを開始する
+func (d *Dispatcher) Start(ctx context.Context) {
+	d.startOnce.Do(func() {
+		go d.run(ctx)
+	})
+}
+
+// Shutdown はポーリングを止め、配信時刻を過ぎたメッセージを処理しきってから戻る
+// ctx の期限を過ぎた場合は残りを次回起動時に任せて戻る
+func (d *Dispatcher) Shutdown(ctx context.Context) error {
+	d.stopOnce.Do(func() { close(d.stopCh) })
+
+	d.startOnce.Do(func() { close(d.doneCh) }) // 未起動の場合
+	select {
+	case <-d.doneCh:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	if err := d.DispatchDue(ctx); err != nil {
+		return fmt.Errorf("outbox drain failed: %w", err)
+	}
+	return nil
+}
+
+func (d *Dispatcher) run(ctx context.Context) {
+	defer close(d.doneCh)
+
+	ticker := time.NewTicker(d.opts.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-d.stopCh:
+			return
+		case <-ticker.C:
+			if err := d.DispatchDue(ctx); err != nil {
+				slog.ErrorContext(ctx, "Outbox dispatch failed", "error", err)
+			}
+		}
+	}
+}
+
+// DispatchDue は配信時刻を過ぎたメッセージがなくなるまでバッチ処理する
+func (d *Dispatcher) DispatchDue(ctx context.Context) error {
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		msgs, err := d.repo.ClaimDue(ctx, time.Now().UTC(), d.opts.BatchSize, d.opts.Lease)
+		if err != nil {
+			return err
+		}
+
+		for i := range msgs {
+			d.dispatch(ctx, &msgs[i])
+		}
+
+		if len(msgs) < d.opts.BatchSize {
+			return nil
+		}
+	}
+}
+
+// dispatch は1件配信して結果を保存する
+func (d *Dispatcher) dispatch(ctx context.Context, msg *dom.Message) {
+	err := d.handle(ctx, *msg)
+
+	now := time.Now().UTC()
+	if err == nil {
+		msg.MarkDelivered(now)
+	} else {
+		msg.MarkFailed(err, now, d.opts.BaseBackoff, d.opts.MaxBackoff)
+		if msg.Status == dom.StatusDead {
+			slog.ErrorContext(ctx, "Outbox message dead-lettered", "outboxID", msg.ID, "topic", msg.Topic, "attempts", msg.Attempts, "error", err)
+		} else {
+			slog.WarnContext(ctx, "Outbox delivery failed, will retry", "outboxID", msg.ID, "topic", msg.Topic, "attempts", msg.Attempts, "nextAttemptAt", msg.NextAttemptAt, "error", err)
+		}
+	}
+
+	if err := d.repo.Save(ctx, msg); err != nil {
+		slog.ErrorContext(ctx, "Failed to save outbox message", "outboxID", msg.ID, "error", err)
+	}
+
+	if msg.Status == dom.StatusDead && d.onDead != nil {
+		d.onDead(ctx, *msg)
+	}
+}
+
+func (d *Dispatcher) handle(ctx context.Context, msg dom.Message) (err error) {
+	h, ok := d.handlers[msg.Topic]
+	if !ok {
+		return fmt.Errorf("%w: %s", errNoHandler, msg.Topic)
+	}
+
+	// ハンドラーのpanicでディスパッチャ全体が止まらないようにする
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("outbox handler panic: %v", r)
+		}
+	}()
+
+	hctx, cancel := context.WithTimeout(ctx, d.opts.HandlerTimeout)
+	defer cancel()
+	return h(hctx, msg)
+}
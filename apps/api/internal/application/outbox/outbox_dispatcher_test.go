@@ -0,0 +1,179 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	dom "gogym-api/internal/domain/entities/outbox"
+)
+
+// memoryRepository はテスト用のインメモリ実装
+type memoryRepository struct {
+	mu   sync.Mutex
+	seq  int64
+	msgs map[int64]*dom.Message
+}
+
+func newMemoryRepository() *memoryRepository {
+	return &memoryRepository{msgs: map[int64]*dom.Message{}}
+}
+
+func (r *memoryRepository) Enqueue(_ context.Context, msg *dom.Message) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.seq++
+	msg.ID = r.seq
+	cp := *msg
+	r.msgs[msg.ID] = &cp
+	return nil
+}
+
+func (r *memoryRepository) ClaimDue(_ context.Context, now time.Time, limit int, _ time.Duration) ([]dom.Message, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []dom.Message
+	for id := int64(1); id <= r.seq && len(out) < limit; id++ {
+		m, ok := r.msgs[id]
+		if !ok || m.Status != dom.StatusPending || m.NextAttemptAt.After(now) {
+			continue
+		}
+		m.Status = dom.StatusProcessing
+		out = append(out, *m)
+	}
+	return out, nil
+}
+
+func (r *memoryRepository) Save(_ context.Context, msg *dom.Message) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	cp := *msg
+	r.msgs[msg.ID] = &cp
+	return nil
+}
+
+func (r *memoryRepository) get(id int64) dom.Message {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return *r.msgs[id]
+}
+
+func TestDispatcher_DispatchDue(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("正常系: 配信に成功したメッセージは delivered になる", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newMemoryRepository()
+		opts := Options{MaxAttempts: 3, BaseBackoff: time.Nanosecond}
+		d := NewDispatcher(repo, opts)
+
+		var got []byte
+		d.Register("test.topic", func(_ context.Context, msg dom.Message) error {
+			got = msg.Payload
+			return nil
+		})
+
+		require.NoError(t, NewPublisher(repo, opts).Publish(ctx, "test.topic", map[string]int{"id": 1}))
+		require.NoError(t, d.DispatchDue(ctx))
+
+		msg := repo.get(1)
+		require.Equal(t, dom.StatusDelivered, msg.Status)
+		require.Equal(t, 1, msg.Attempts)
+		require.NotNil(t, msg.DeliveredAt)
+		require.JSONEq(t, `{"id":1}`, string(got))
+	})
+
+	t.Run("異常系: 失敗が続くとバックオフ後にリトライし、上限でデッドレターになる", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newMemoryRepository()
+		opts := Options{MaxAttempts: 3, BaseBackoff: time.Hour, MaxBackoff: 2 * time.Hour}
+		d := NewDispatcher(repo, opts)
+
+		calls := 0
+		d.Register("test.topic", func(context.Context, dom.Message) error {
+			calls++
+			return errors.New("webhook down")
+		})
+
+		require.NoError(t, NewPublisher(repo, opts).Publish(ctx, "test.topic", "payload"))
+
+		// 1回目: 失敗して1時間後に再試行予定
+		require.NoError(t, d.DispatchDue(ctx))
+		msg := repo.get(1)
+		require.Equal(t, dom.StatusPending, msg.Status)
+		require.Equal(t, 1, msg.Attempts)
+		require.WithinDuration(t, time.Now().Add(time.Hour), msg.NextAttemptAt, time.Minute)
+
+		// 再試行時刻前は処理されない
+		require.NoError(t, d.DispatchDue(ctx))
+		require.Equal(t, 1, calls)
+
+		// 2回目: バックオフが2倍になる
+		repo.msgs[1].NextAttemptAt = time.Now()
+		require.NoError(t, d.DispatchDue(ctx))
+		msg = repo.get(1)
+		require.Equal(t, dom.StatusPending, msg.Status)
+		require.WithinDuration(t, time.Now().Add(2*time.Hour), msg.NextAttemptAt, time.Minute)
+
+		// 3回目: 上限到達でデッドレター
+		repo.msgs[1].NextAttemptAt = time.Now()
+		require.NoError(t, d.DispatchDue(ctx))
+		msg = repo.get(1)
+		require.Equal(t, dom.StatusDead, msg.Status)
+		require.Equal(t, 3, msg.Attempts)
+		require.NotNil(t, msg.LastError)
+		require.Equal(t, 3, calls)
+	})
+
+	t.Run("異常系: ハンドラー未登録のトピックは失敗として扱う", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newMemoryRepository()
+		opts := Options{MaxAttempts: 1}
+		d := NewDispatcher(repo, opts)
+
+		require.NoError(t, NewPublisher(repo, opts).Publish(ctx, "unknown.topic", "payload"))
+		require.NoError(t, d.DispatchDue(ctx))
+
+		msg := repo.get(1)
+		require.Equal(t, dom.StatusDead, msg.Status)
+		require.Contains(t, *msg.LastError, "unknown.topic")
+	})
+}
+
+func TestDispatcher_Shutdown(t *testing.T) {
+	t.Parallel()
+
+	t.Run("正常系: 停止時に配信待ちのメッセージを処理しきる", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newMemoryRepository()
+		opts := Options{PollInterval: time.Hour, BatchSize: 2}
+		d := NewDispatcher(repo, opts)
+
+		delivered := 0
+		d.Register("test.topic", func(context.Context, dom.Message) error {
+			delivered++
+			return nil
+		})
+		d.Start(context.Background())
+
+		pub := NewPublisher(repo, opts)
+		for i := 0; i < 5; i++ {
+			require.NoError(t, pub.Publish(context.Background(), "test.topic", i))
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		require.NoError(t, d.Shutdown(ctx))
+		require.Equal(t, 5, delivered)
+	})
+}
@@ -0,0 +1,17 @@
+package outbox
+
+import (
+	"context"
+
+	dom "gogym-api/internal/domain/entities/outbox"
+)
+
+// Publisher は任意のユースケースから外部送信メッセージを積むための入力ポート
+// 呼び出し側のトランザクション内で呼べば、業務データの書き込みと同時にコミットされる
+type Publisher interface {
+	Publish(ctx context.Context, topic string, payload any) error
+}
+
+// Handler はトピックごとの配信処理
+// エラーを返すとバックオフ後にリトライされ、上限到達でデッドレターになる
+type Handler func(ctx context.Context, msg dom.Message) error
@@ -0,0 +1,16 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	dom "gogym-api/internal/domain/entities/outbox"
+)
+
+// Repository は送信待ちメッセージの永続化を担当
+type Repository interface {
+	Enqueue(ctx context.Context, msg *dom.Message) error
+	// ClaimDue は配信時刻を過ぎたメッセージを最大 limit 件ロックし、lease の間処理中にする
+	ClaimDue(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]dom.Message, error)
+	Save(ctx context.Context, msg *dom.Message) error
+}
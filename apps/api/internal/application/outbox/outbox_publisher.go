@@ -0,0 +1,37 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	dom "gogym-api/internal/domain/entities/outbox"
+)
+
+type publisher struct {
+	repo        Repository
+	maxAttempts int
+}
+
+func NewPublisher(repo Repository, opts Options) Publisher {
+	return &publisher{
+		repo:        repo,
+		maxAttempts: opts.withDefaults().MaxAttempts,
+	}
+}
+
+// Publish は payload をJSONにしてアウトボックスに登録する
+func (p *publisher) Publish(ctx context.Context, topic string, payload any) error {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal outbox payload: %w", err)
+	}
+
+	msg, err := dom.NewMessage(topic, b, p.maxAttempts, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+
+	return p.repo.Enqueue(ctx, msg)
+}
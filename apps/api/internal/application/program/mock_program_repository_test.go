@@ -0,0 +1,248 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: program_output.go
+
+// Package program is a generated GoMock package.
+package program
+
+import (
+	context "context"
+	dom "gogym-api/internal/domain/entities"
+	dp "gogym-api/internal/domain/entities/program"
+	dt "gogym-api/internal/domain/entities/template"
+	dw "gogym-api/internal/domain/entities/workout"
+	reflect "reflect"
+	"time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateProgram mocks base method.
+func (m *MockRepository) CreateProgram(ctx context.Context, program dp.Program) (dom.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProgram", ctx, program)
+	ret0, _ := ret[0].(dom.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProgram indicates an expected call of CreateProgram.
+func (mr *MockRepositoryMockRecorder) CreateProgram(ctx, program interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProgram", reflect.TypeOf((*MockRepository)(nil).CreateProgram), ctx, program)
+}
+
+// DeleteProgram mocks base method.
+func (m *MockRepository) DeleteProgram(ctx context.Context, userID string, programID dom.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProgram", ctx, userID, programID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProgram indicates an expected call of DeleteProgram.
+func (mr *MockRepositoryMockRecorder) DeleteProgram(ctx, userID, programID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProgram", reflect.TypeOf((*MockRepository)(nil).DeleteProgram), ctx, userID, programID)
+}
+
+// EndEnrollment mocks base method.
+func (m *MockRepository) EndEnrollment(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndEnrollment", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EndEnrollment indicates an expected call of EndEnrollment.
+func (mr *MockRepositoryMockRecorder) EndEnrollment(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndEnrollment", reflect.TypeOf((*MockRepository)(nil).EndEnrollment), ctx, userID)
+}
+
+// Enroll mocks base method.
+func (m *MockRepository) Enroll(ctx context.Context, enrollment dp.Enrollment) (dom.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enroll", ctx, enrollment)
+	ret0, _ := ret[0].(dom.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Enroll indicates an expected call of Enroll.
+func (mr *MockRepositoryMockRecorder) Enroll(ctx, enrollment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enroll", reflect.TypeOf((*MockRepository)(nil).Enroll), ctx, enrollment)
+}
+
+// FindActiveEnrollment mocks base method.
+func (m *MockRepository) FindActiveEnrollment(ctx context.Context, userID string) (dp.Enrollment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindActiveEnrollment", ctx, userID)
+	ret0, _ := ret[0].(dp.Enrollment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindActiveEnrollment indicates an expected call of FindActiveEnrollment.
+func (mr *MockRepositoryMockRecorder) FindActiveEnrollment(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActiveEnrollment", reflect.TypeOf((*MockRepository)(nil).FindActiveEnrollment), ctx, userID)
+}
+
+// FindProgram mocks base method.
+func (m *MockRepository) FindProgram(ctx context.Context, userID string, programID dom.ID) (dp.Program, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindProgram", ctx, userID, programID)
+	ret0, _ := ret[0].(dp.Program)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindProgram indicates an expected call of FindProgram.
+func (mr *MockRepositoryMockRecorder) FindProgram(ctx, userID, programID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindProgram", reflect.TypeOf((*MockRepository)(nil).FindProgram), ctx, userID, programID)
+}
+
+// ListPrograms mocks base method.
+func (m *MockRepository) ListPrograms(ctx context.Context, userID string) ([]dp.Program, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPrograms", ctx, userID)
+	ret0, _ := ret[0].([]dp.Program)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPrograms indicates an expected call of ListPrograms.
+func (mr *MockRepositoryMockRecorder) ListPrograms(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPrograms", reflect.TypeOf((*MockRepository)(nil).ListPrograms), ctx, userID)
+}
+
+// UpdateProgram mocks base method.
+func (m *MockRepository) UpdateProgram(ctx context.Context, program dp.Program) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProgram", ctx, program)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateProgram indicates an expected call of UpdateProgram.
+func (mr *MockRepositoryMockRecorder) UpdateProgram(ctx, program interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProgram", reflect.TypeOf((*MockRepository)(nil).UpdateProgram), ctx, program)
+}
+
+// MockTemplateRepository is a mock of TemplateRepository interface.
+type MockTemplateRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTemplateRepositoryMockRecorder
+}
+
+// MockTemplateRepositoryMockRecorder is the mock recorder for MockTemplateRepository.
+type MockTemplateRepositoryMockRecorder struct {
+	mock *MockTemplateRepository
+}
+
+// NewMockTemplateRepository creates a new mock instance.
+func NewMockTemplateRepository(ctrl *gomock.Controller) *MockTemplateRepository {
+	mock := &MockTemplateRepository{ctrl: ctrl}
+	mock.recorder = &MockTemplateRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTemplateRepository) EXPECT() *MockTemplateRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindTemplate mocks base method.
+func (m *MockTemplateRepository) FindTemplate(ctx context.Context, userID string, templateID dom.ID) (dt.Template, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTemplate", ctx, userID, templateID)
+	ret0, _ := ret[0].(dt.Template)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTemplate indicates an expected call of FindTemplate.
+func (mr *MockTemplateRepositoryMockRecorder) FindTemplate(ctx, userID, templateID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTemplate", reflect.TypeOf((*MockTemplateRepository)(nil).FindTemplate), ctx, userID, templateID)
+}
+
+// MockWorkoutRepository is a mock of WorkoutRepository interface.
+type MockWorkoutRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWorkoutRepositoryMockRecorder
+}
+
+// MockWorkoutRepositoryMockRecorder is the mock recorder for MockWorkoutRepository.
+type MockWorkoutRepositoryMockRecorder struct {
+	mock *MockWorkoutRepository
+}
+
+// NewMockWorkoutRepository creates a new mock instance.
+func NewMockWorkoutRepository(ctrl *gomock.Controller) *MockWorkoutRepository {
+	mock := &MockWorkoutRepository{ctrl: ctrl}
+	mock.recorder = &MockWorkoutRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWorkoutRepository) EXPECT() *MockWorkoutRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetRecordsInRange mocks base method.
+func (m *MockWorkoutRepository) GetRecordsInRange(ctx context.Context, userID string, from, to time.Time) ([]dw.WorkoutRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecordsInRange", ctx, userID, from, to)
+	ret0, _ := ret[0].([]dw.WorkoutRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecordsInRange indicates an expected call of GetRecordsInRange.
+func (mr *MockWorkoutRepositoryMockRecorder) GetRecordsInRange(ctx, userID, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecordsInRange", reflect.TypeOf((*MockWorkoutRepository)(nil).GetRecordsInRange), ctx, userID, from, to)
+}
+
+// GetTrainingMaxes mocks base method.
+func (m *MockWorkoutRepository) GetTrainingMaxes(ctx context.Context, userID string) (map[dw.ID]float64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrainingMaxes", ctx, userID)
+	ret0, _ := ret[0].(map[dw.ID]float64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrainingMaxes indicates an expected call of GetTrainingMaxes.
+func (mr *MockWorkoutRepositoryMockRecorder) GetTrainingMaxes(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrainingMaxes", reflect.TypeOf((*MockWorkoutRepository)(nil).GetTrainingMaxes), ctx, userID)
+}
@@ -0,0 +1,26 @@
+package program
+
+import (
+	"context"
+	"time"
+
+	dto "gogym-api/internal/adapter/dto"
+	dom "gogym-api/internal/domain/entities"
+)
+
+// handler → usecase
+type ProgramUseCase interface {
+	ListPrograms(ctx context.Context, userID string, locale string) ([]dto.ProgramDTO, error)
+	GetProgram(ctx context.Context, userID string, programID int64, locale string) (dto.ProgramDTO, error)
+	CreateProgram(ctx context.Context, userID string, req dto.SaveProgramRequest, locale string) (dto.ProgramDTO, error)
+	UpdateProgram(ctx context.Context, userID string, programID int64, req dto.SaveProgramRequest, locale string) (dto.ProgramDTO, error)
+	DeleteProgram(ctx context.Context, userID string, programID int64) error
+	// Enroll はプログラムを startDate から開始する（進行中のプログラムは終了する）
+	Enroll(ctx context.Context, userID string, programID int64, startDate time.Time) (dto.EnrollmentDTO, error)
+	GetEnrollment(ctx context.Context, userID string) (dto.EnrollmentDTO, error)
+	EndEnrollment(ctx context.Context, userID string) error
+	// GetToday は進行中のプログラムの date の予定を、トレーニングマックスから求めた重量つきで返す
+	GetToday(ctx context.Context, userID string, date time.Time, locale string, units dom.WeightUnits) (dto.ProgramTodayDTO, error)
+	// GetAdherence は進行中のプログラムの asOf 時点の実施状況を返す
+	GetAdherence(ctx context.Context, userID string, asOf time.Time) (dto.AdherenceDTO, error)
+}
@@ -0,0 +1,233 @@
+package program
+
+import (
+	"context"
+	"time"
+
+	dto "gogym-api/internal/adapter/dto"
+	dom "gogym-api/internal/domain/entities"
+	dp "gogym-api/internal/domain/entities/program"
+	dt "gogym-api/internal/domain/entities/template"
+	"gogym-api/internal/util"
+
+	"go.opentelemetry.io/otel"
+)
+
+var tracer = otel.Tracer("gogym-api/internal/application/program")
+
+type programInteractor struct {
+	repo         Repository
+	templateRepo TemplateRepository
+	workoutRepo  WorkoutRepository
+}
+
+func NewProgramInteractor(repo Repository, templateRepo TemplateRepository, workoutRepo WorkoutRepository) ProgramUseCase {
+	return &programInteractor{
+		repo:         repo,
+		templateRepo: templateRepo,
+		workoutRepo:  workoutRepo,
+	}
+}
+
+// ListPrograms はユーザーのプログラムを一覧で返す（種目名は locale で解決する）
+func (i *programInteractor) ListPrograms(ctx context.Context, userID string, locale string) (_ []dto.ProgramDTO, err error) {
+	ctx, span := tracer.Start(ctx, "ProgramUseCase.ListPrograms")
+	defer func() { util.EndSpan(span, err) }()
+
+	programs, err := i.repo.ListPrograms(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return dto.ProgramsToDTO(programs, locale), nil
+}
+
+// GetProgram はユーザーのプログラムを1件返す
+func (i *programInteractor) GetProgram(ctx context.Context, userID string, programID int64, locale string) (_ dto.ProgramDTO, err error) {
+	ctx, span := tracer.Start(ctx, "ProgramUseCase.GetProgram")
+	defer func() { util.EndSpan(span, err) }()
+
+	p, err := i.repo.FindProgram(ctx, userID, dom.ID(programID))
+	if err != nil {
+		return dto.ProgramDTO{}, err
+	}
+	return dto.ProgramToDTO(&p, locale), nil
+}
+
+// CreateProgram はプログラムを作成し、保存後の内容を返す
+func (i *programInteractor) CreateProgram(ctx context.Context, userID string, req dto.SaveProgramRequest, locale string) (_ dto.ProgramDTO, err error) {
+	ctx, span := tracer.Start(ctx, "ProgramUseCase.CreateProgram")
+	defer func() { util.EndSpan(span, err) }()
+
+	p, err := i.buildProgram(ctx, userID, req)
+	if err != nil {
+		return dto.ProgramDTO{}, err
+	}
+
+	id, err := i.repo.CreateProgram(ctx, *p)
+	if err != nil {
+		return dto.ProgramDTO{}, err
+	}
+
+	return i.GetProgram(ctx, userID, int64(id), locale)
+}
+
+// UpdateProgram はプログラムの名前・期間を更新し、日程を置き換える
+func (i *programInteractor) UpdateProgram(ctx context.Context, userID string, programID int64, req dto.SaveProgramRequest, locale string) (_ dto.ProgramDTO, err error) {
+	ctx, span := tracer.Start(ctx, "ProgramUseCase.UpdateProgram")
+	defer func() { util.EndSpan(span, err) }()
+
+	p, err := i.buildProgram(ctx, userID, req)
+	if err != nil {
+		return dto.ProgramDTO{}, err
+	}
+	p.ID = dom.ID(programID)
+
+	if err := i.repo.UpdateProgram(ctx, *p); err != nil {
+		return dto.ProgramDTO{}, err
+	}
+
+	return i.GetProgram(ctx, userID, programID, locale)
+}
+
+// DeleteProgram はプログラムを削除する（進行中なら終了する）
+func (i *programInteractor) DeleteProgram(ctx context.Context, userID string, programID int64) (err error) {
+	ctx, span := tracer.Start(ctx, "ProgramUseCase.DeleteProgram")
+	defer func() { util.EndSpan(span, err) }()
+
+	return i.repo.DeleteProgram(ctx, userID, dom.ID(programID))
+}
+
+// buildProgram はリクエストからプログラムを作り、日程のテンプレートがユーザーのものであること、
+// 処方の種目がそのテンプレートに含まれることを確認する
+func (i *programInteractor) buildProgram(ctx context.Context, userID string, req dto.SaveProgramRequest) (*dp.Program, error) {
+	p, err := dto.SaveProgramRequestToDomain(dom.ULID(userID), req)
+	if err != nil {
+		return nil, err
+	}
+
+	templates := make(map[dom.ID]dt.Template)
+	for _, id := range p.TemplateIDs() {
+		t, err := i.templateRepo.FindTemplate(ctx, userID, id)
+		if err != nil {
+			return nil, err
+		}
+		templates[id] = t
+	}
+	if err := p.CheckTemplates(templates); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Enroll はプログラムを startDate から開始する
+func (i *programInteractor) Enroll(ctx context.Context, userID string, programID int64, startDate time.Time) (_ dto.EnrollmentDTO, err error) {
+	ctx, span := tracer.Start(ctx, "ProgramUseCase.Enroll")
+	defer func() { util.EndSpan(span, err) }()
+
+	p, err := i.repo.FindProgram(ctx, userID, dom.ID(programID))
+	if err != nil {
+		return dto.EnrollmentDTO{}, err
+	}
+
+	e, err := dp.NewEnrollment(dom.ULID(userID), p, startDate)
+	if err != nil {
+		return dto.EnrollmentDTO{}, err
+	}
+
+	if _, err := i.repo.Enroll(ctx, *e); err != nil {
+		return dto.EnrollmentDTO{}, err
+	}
+
+	return i.GetEnrollment(ctx, userID)
+}
+
+// GetEnrollment は進行中のプログラムを返す
+func (i *programInteractor) GetEnrollment(ctx context.Context, userID string) (_ dto.EnrollmentDTO, err error) {
+	ctx, span := tracer.Start(ctx, "ProgramUseCase.GetEnrollment")
+	defer func() { util.EndSpan(span, err) }()
+
+	e, err := i.repo.FindActiveEnrollment(ctx, userID)
+	if err != nil {
+		return dto.EnrollmentDTO{}, err
+	}
+	return dto.EnrollmentToDTO(&e), nil
+}
+
+// EndEnrollment は進行中のプログラムを終了する
+func (i *programInteractor) EndEnrollment(ctx context.Context, userID string) (err error) {
+	ctx, span := tracer.Start(ctx, "ProgramUseCase.EndEnrollment")
+	defer func() { util.EndSpan(span, err) }()
+
+	return i.repo.EndEnrollment(ctx, userID)
+}
+
+// GetToday は date の予定を返す。トレーニング日ならテンプレートと処方の重量、実施済みのセッションを含める
+func (i *programInteractor) GetToday(ctx context.Context, userID string, date time.Time, locale string, units dom.WeightUnits) (_ dto.ProgramTodayDTO, err error) {
+	ctx, span := tracer.Start(ctx, "ProgramUseCase.GetToday")
+	defer func() { util.EndSpan(span, err) }()
+
+	e, err := i.repo.FindActiveEnrollment(ctx, userID)
+	if err != nil {
+		return dto.ProgramTodayDTO{}, err
+	}
+
+	plan := e.PlanOn(date)
+	out := dto.ProgramTodayToDTO(&e, plan, units)
+	if plan.Training == nil {
+		return out, nil
+	}
+
+	t, err := i.templateRepo.FindTemplate(ctx, userID, plan.Training.TemplateID)
+	if err != nil {
+		return dto.ProgramTodayDTO{}, err
+	}
+	trainingMaxes, err := i.workoutRepo.GetTrainingMaxes(ctx, userID)
+	if err != nil {
+		return dto.ProgramTodayDTO{}, err
+	}
+	adherence, err := i.adherence(ctx, &e, date)
+	if err != nil {
+		return dto.ProgramTodayDTO{}, err
+	}
+
+	workout := &dto.PlannedWorkoutDTO{
+		Template:      dto.TemplateToDTO(&t, locale, units),
+		Prescriptions: dto.PrescribedExercisesToDTO(plan.Training.Prescriptions, trainingMaxes, locale, units),
+	}
+	for _, d := range adherence.Days {
+		if d.Day.Week == plan.Week && d.Day.Day == plan.Day && d.RecordID != nil {
+			id := int64(*d.RecordID)
+			workout.RecordID = &id
+		}
+	}
+	out.Workout = workout
+
+	return out, nil
+}
+
+// GetAdherence は開始日から asOf までのセッションを予定と突き合わせる
+func (i *programInteractor) GetAdherence(ctx context.Context, userID string, asOf time.Time) (_ dto.AdherenceDTO, err error) {
+	ctx, span := tracer.Start(ctx, "ProgramUseCase.GetAdherence")
+	defer func() { util.EndSpan(span, err) }()
+
+	e, err := i.repo.FindActiveEnrollment(ctx, userID)
+	if err != nil {
+		return dto.AdherenceDTO{}, err
+	}
+
+	adherence, err := i.adherence(ctx, &e, asOf)
+	if err != nil {
+		return dto.AdherenceDTO{}, err
+	}
+
+	return dto.AdherenceToDTO(asOf, &e, adherence), nil
+}
+
+func (i *programInteractor) adherence(ctx context.Context, e *dp.Enrollment, asOf time.Time) (dp.Adherence, error) {
+	// 開始日・基準日とも暦日なので performed_date（DATE）とそのまま比較できる
+	records, err := i.workoutRepo.GetRecordsInRange(ctx, string(e.UserID), e.StartDate, asOf)
+	if err != nil {
+		return dp.Adherence{}, err
+	}
+	return e.Adherence(records, asOf), nil
+}
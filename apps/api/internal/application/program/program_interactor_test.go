@@ -0,0 +1,315 @@
+package program
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"gogym-api/internal/adapter/dto"
+	dom "gogym-api/internal/domain/entities"
+	dp "gogym-api/internal/domain/entities/program"
+	dt "gogym-api/internal/domain/entities/template"
+	dw "gogym-api/internal/domain/entities/workout"
+	"gogym-api/internal/util"
+)
+
+const testUserID = "01FGZ9K6TV3J5ZZZQX6Z9X6K7W" // ULID
+
+var (
+	squat = dw.WorkoutExerciseRef{ID: 10, Name: "スクワット"}
+	bench = dw.WorkoutExerciseRef{ID: 11, Name: "ベンチプレス"}
+
+	lowerDay = dt.Template{ID: 1, UserID: testUserID, Name: "Lower", Exercises: []dt.TemplateExercise{
+		{Exercise: squat, Position: 1, TargetSets: 3, RepsMin: 5, RepsMax: 5},
+	}}
+	upperDay = dt.Template{ID: 2, UserID: testUserID, Name: "Upper", Exercises: []dt.TemplateExercise{
+		{Exercise: bench, Position: 1, TargetSets: 3, RepsMin: 5, RepsMax: 5},
+	}}
+)
+
+// newEnrollment は 2025-11-03（月）開始、2週間・週2日（1日目 Lower、3日目 Upper）のプログラムを返す
+func newEnrollment() dp.Enrollment {
+	lower := func(week int) dp.ProgramDay {
+		return dp.ProgramDay{Week: week, Day: 1, TemplateID: 1, TemplateName: "Lower", Prescriptions: []dp.Prescription{
+			{Exercise: squat, SetNumber: 1, PercentOfMax: 65, Reps: 5},
+			{Exercise: squat, SetNumber: 2, PercentOfMax: 75, Reps: 5},
+			{Exercise: squat, SetNumber: 3, PercentOfMax: 85, Reps: 5, AMRAP: true},
+		}}
+	}
+	upper := func(week int) dp.ProgramDay {
+		return dp.ProgramDay{Week: week, Day: 3, TemplateID: 2, TemplateName: "Upper", Prescriptions: []dp.Prescription{
+			{Exercise: bench, SetNumber: 1, PercentOfMax: 70, Reps: 5},
+		}}
+	}
+	return dp.Enrollment{
+		ID:     5,
+		UserID: testUserID,
+		Program: dp.Program{
+			ID: 3, UserID: testUserID, Name: "5/3/1", Weeks: 2,
+			Days: []dp.ProgramDay{lower(1), upper(1), lower(2), upper(2)},
+		},
+		StartDate: time.Date(2025, 11, 3, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func performed(id dw.ID, templateID dom.ID, date string) dw.WorkoutRecord {
+	d, _ := time.Parse(util.DateLayout, date)
+	return dw.WorkoutRecord{ID: &id, UserID: testUserID, TemplateID: &templateID, PerformedDate: d}
+}
+
+func TestProgramInteractor_CreateProgram(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	rx := func(exerciseID int64, percent float64) dto.PrescriptionDTO {
+		return dto.PrescriptionDTO{ExerciseID: exerciseID, PercentOfMax: percent, Reps: 5}
+	}
+
+	t.Run("正常系: 日程を週・日の順に並べ、処方のセット番号を種目ごとに振って保存する", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		repo := NewMockRepository(ctrl)
+		templateRepo := NewMockTemplateRepository(ctrl)
+		uc := NewProgramInteractor(repo, templateRepo, NewMockWorkoutRepository(ctrl))
+
+		templateRepo.EXPECT().FindTemplate(gomock.Any(), testUserID, dom.ID(2)).Return(upperDay, nil)
+		templateRepo.EXPECT().FindTemplate(gomock.Any(), testUserID, dom.ID(1)).Return(lowerDay, nil)
+		repo.EXPECT().
+			CreateProgram(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, p dp.Program) (dom.ID, error) {
+				require.Len(t, p.Days, 2)
+				require.Equal(t, [2]int{1, 1}, [2]int{p.Days[0].Week, p.Days[0].Day})
+				require.Equal(t, "Lower", p.Days[0].TemplateName)
+				require.Equal(t, 1, p.Days[0].Prescriptions[0].SetNumber)
+				require.Equal(t, 2, p.Days[0].Prescriptions[1].SetNumber)
+				return 3, nil
+			})
+		repo.EXPECT().FindProgram(gomock.Any(), testUserID, dom.ID(3)).Return(newEnrollment().Program, nil)
+
+		got, err := uc.CreateProgram(ctx, testUserID, dto.SaveProgramRequest{
+			Name:  "5/3/1",
+			Weeks: 2,
+			Days: []dto.ProgramDayDTO{
+				{Week: 1, Day: 3, TemplateID: 2, Prescriptions: []dto.PrescriptionDTO{rx(11, 70)}},
+				{Week: 1, Day: 1, TemplateID: 1, Prescriptions: []dto.PrescriptionDTO{rx(10, 65), rx(10, 75)}},
+			},
+		}, "ja")
+		require.NoError(t, err)
+		require.Equal(t, int64(3), got.ID)
+		require.Equal(t, "スクワット", got.Days[0].Prescriptions[0].Name)
+	})
+
+	t.Run("異常系: テンプレートにない種目の処方はErrInvalidProgramを返し保存しない", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		templateRepo := NewMockTemplateRepository(ctrl)
+		uc := NewProgramInteractor(NewMockRepository(ctrl), templateRepo, NewMockWorkoutRepository(ctrl))
+
+		templateRepo.EXPECT().FindTemplate(gomock.Any(), testUserID, dom.ID(1)).Return(lowerDay, nil)
+
+		_, err := uc.CreateProgram(ctx, testUserID, dto.SaveProgramRequest{
+			Name:  "5/3/1",
+			Weeks: 1,
+			Days:  []dto.ProgramDayDTO{{Week: 1, Day: 1, TemplateID: 1, Prescriptions: []dto.PrescriptionDTO{rx(11, 70)}}},
+		}, "ja")
+		require.ErrorIs(t, err, dp.ErrInvalidProgram)
+	})
+
+	t.Run("異常系: 不正な日程はテンプレートを読まずにErrInvalidProgramを返す", func(t *testing.T) {
+		t.Parallel()
+
+		cases := map[string]dto.SaveProgramRequest{
+			"週数0":      {Name: "P", Weeks: 0, Days: []dto.ProgramDayDTO{{Week: 1, Day: 1, TemplateID: 1}}},
+			"日程なし":     {Name: "P", Weeks: 1},
+			"期間外の週":    {Name: "P", Weeks: 1, Days: []dto.ProgramDayDTO{{Week: 2, Day: 1, TemplateID: 1}}},
+			"8日目":      {Name: "P", Weeks: 1, Days: []dto.ProgramDayDTO{{Week: 1, Day: 8, TemplateID: 1}}},
+			"同じ日が2回":   {Name: "P", Weeks: 1, Days: []dto.ProgramDayDTO{{Week: 1, Day: 1, TemplateID: 1}, {Week: 1, Day: 1, TemplateID: 2}}},
+			"割合が範囲外":   {Name: "P", Weeks: 1, Days: []dto.ProgramDayDTO{{Week: 1, Day: 1, TemplateID: 1, Prescriptions: []dto.PrescriptionDTO{rx(10, 130)}}}},
+			"テンプレートなし": {Name: "P", Weeks: 1, Days: []dto.ProgramDayDTO{{Week: 1, Day: 1}}},
+		}
+		for name, req := range cases {
+			ctrl := gomock.NewController(t)
+			uc := NewProgramInteractor(NewMockRepository(ctrl), NewMockTemplateRepository(ctrl), NewMockWorkoutRepository(ctrl))
+
+			_, err := uc.CreateProgram(ctx, testUserID, req, "ja")
+			require.ErrorIs(t, err, dp.ErrInvalidProgram, name)
+		}
+	})
+}
+
+func TestProgramInteractor_GetToday(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("正常系: トレーニング日はトレーニングマックスから重量を求め、実施済みのセッションを返す", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		repo := NewMockRepository(ctrl)
+		templateRepo := NewMockTemplateRepository(ctrl)
+		workoutRepo := NewMockWorkoutRepository(ctrl)
+		uc := NewProgramInteractor(repo, templateRepo, workoutRepo)
+
+		repo.EXPECT().FindActiveEnrollment(gomock.Any(), testUserID).Return(newEnrollment(), nil)
+		templateRepo.EXPECT().FindTemplate(gomock.Any(), testUserID, dom.ID(1)).Return(lowerDay, nil)
+		workoutRepo.EXPECT().GetTrainingMaxes(gomock.Any(), testUserID).Return(map[dw.ID]float64{10: 102.5}, nil)
+		workoutRepo.EXPECT().
+			GetRecordsInRange(gomock.Any(), testUserID, gomock.Any(), gomock.Any()).
+			Return([]dw.WorkoutRecord{performed(100, 1, "2025-11-10")}, nil)
+
+		date, err := util.TimeZone{}.ParseDate("2025-11-10")
+		require.NoError(t, err)
+
+		got, err := uc.GetToday(ctx, testUserID, date, "ja", dom.WeightUnits{})
+		require.NoError(t, err)
+		require.Equal(t, "training", got.Status)
+		require.Equal(t, 2, *got.Week)
+		require.Equal(t, 1, *got.Day)
+		require.Equal(t, "2025-11-16", got.Enrollment.EndDate)
+		require.Equal(t, "Lower", got.Workout.Template.Name)
+		require.Equal(t, int64(100), *got.Workout.RecordID)
+
+		sets := got.Workout.Prescriptions[0].Sets
+		require.Len(t, sets, 3)
+		// 102.5kg の 65% = 66.625kg → 2.5kg 単位に丸めて 67.5kg
+		require.Equal(t, 67.5, *sets[0].WeightKg)
+		require.Equal(t, 77.5, *sets[1].WeightKg)
+		require.Equal(t, 87.5, *sets[2].WeightKg)
+		require.True(t, sets[2].AMRAP)
+	})
+
+	t.Run("正常系: lbのユーザーにはポンドのプレートの幅に丸めた重量をlbでも返す", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		repo := NewMockRepository(ctrl)
+		templateRepo := NewMockTemplateRepository(ctrl)
+		workoutRepo := NewMockWorkoutRepository(ctrl)
+		uc := NewProgramInteractor(repo, templateRepo, workoutRepo)
+
+		repo.EXPECT().FindActiveEnrollment(gomock.Any(), testUserID).Return(newEnrollment(), nil)
+		templateRepo.EXPECT().FindTemplate(gomock.Any(), testUserID, dom.ID(1)).Return(lowerDay, nil)
+		// 225 lb = 102.06 kg
+		workoutRepo.EXPECT().GetTrainingMaxes(gomock.Any(), testUserID).Return(map[dw.ID]float64{10: 102.06}, nil)
+		workoutRepo.EXPECT().GetRecordsInRange(gomock.Any(), testUserID, gomock.Any(), gomock.Any()).Return(nil, nil)
+
+		date, err := util.TimeZone{}.ParseDate("2025-11-10")
+		require.NoError(t, err)
+
+		got, err := uc.GetToday(ctx, testUserID, date, "ja", dom.WeightUnits{Unit: dom.WeightUnitLb})
+		require.NoError(t, err)
+		require.Equal(t, "lb", got.Units.Unit)
+		require.Equal(t, 225.0, *got.Workout.Prescriptions[0].TrainingMax)
+
+		sets := got.Workout.Prescriptions[0].Sets
+		// 225 lb の 65% = 146.25 lb → 5 lb 単位に丸めて 145 lb
+		require.Equal(t, 145.0, *sets[0].Weight)
+		require.Equal(t, 170.0, *sets[1].Weight)
+		require.Equal(t, 190.0, *sets[2].Weight)
+	})
+
+	t.Run("正常系: トレーニングマックスが未設定の種目は重量なしで返す", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		repo := NewMockRepository(ctrl)
+		templateRepo := NewMockTemplateRepository(ctrl)
+		workoutRepo := NewMockWorkoutRepository(ctrl)
+		uc := NewProgramInteractor(repo, templateRepo, workoutRepo)
+
+		repo.EXPECT().FindActiveEnrollment(gomock.Any(), testUserID).Return(newEnrollment(), nil)
+		templateRepo.EXPECT().FindTemplate(gomock.Any(), testUserID, dom.ID(2)).Return(upperDay, nil)
+		workoutRepo.EXPECT().GetTrainingMaxes(gomock.Any(), testUserID).Return(map[dw.ID]float64{}, nil)
+		workoutRepo.EXPECT().GetRecordsInRange(gomock.Any(), testUserID, gomock.Any(), gomock.Any()).Return(nil, nil)
+
+		got, err := uc.GetToday(ctx, testUserID, time.Date(2025, 11, 5, 0, 0, 0, 0, time.UTC), "ja", dom.WeightUnits{})
+		require.NoError(t, err)
+		require.Nil(t, got.Workout.RecordID)
+		require.Nil(t, got.Workout.Prescriptions[0].TrainingMaxKg)
+		require.Nil(t, got.Workout.Prescriptions[0].Sets[0].WeightKg)
+	})
+
+	t.Run("正常系: 休養日・期間外の日は予定のワークアウトを返さない", func(t *testing.T) {
+		t.Parallel()
+
+		cases := map[string]string{
+			"2025-11-04": "rest",
+			"2025-11-02": "not_started",
+			"2025-11-17": "finished",
+		}
+		for date, status := range cases {
+			ctrl := gomock.NewController(t)
+			repo := NewMockRepository(ctrl)
+			uc := NewProgramInteractor(repo, NewMockTemplateRepository(ctrl), NewMockWorkoutRepository(ctrl))
+
+			repo.EXPECT().FindActiveEnrollment(gomock.Any(), testUserID).Return(newEnrollment(), nil)
+
+			d, err := util.TimeZone{}.ParseDate(date)
+			require.NoError(t, err)
+
+			got, err := uc.GetToday(ctx, testUserID, d, "ja", dom.WeightUnits{})
+			require.NoError(t, err, date)
+			require.Equal(t, status, got.Status, date)
+			require.Nil(t, got.Workout, date)
+		}
+	})
+
+	t.Run("異常系: 進行中のプログラムがない場合はErrEnrollmentNotFoundを返す", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		repo := NewMockRepository(ctrl)
+		uc := NewProgramInteractor(repo, NewMockTemplateRepository(ctrl), NewMockWorkoutRepository(ctrl))
+
+		repo.EXPECT().FindActiveEnrollment(gomock.Any(), testUserID).Return(dp.Enrollment{}, dp.ErrEnrollmentNotFound)
+
+		_, err := uc.GetToday(ctx, testUserID, time.Now(), "ja", dom.WeightUnits{})
+		require.ErrorIs(t, err, dp.ErrEnrollmentNotFound)
+	})
+}
+
+func TestProgramInteractor_GetAdherence(t *testing.T) {
+	t.Parallel()
+
+	t.Run("正常系: 同じ週に同じテンプレートのセッションがあれば実施済み、予定日を過ぎて行っていないものは未実施とする", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		repo := NewMockRepository(ctrl)
+		workoutRepo := NewMockWorkoutRepository(ctrl)
+		uc := NewProgramInteractor(repo, NewMockTemplateRepository(ctrl), workoutRepo)
+
+		repo.EXPECT().FindActiveEnrollment(gomock.Any(), testUserID).Return(newEnrollment(), nil)
+		workoutRepo.EXPECT().
+			GetRecordsInRange(gomock.Any(), testUserID, gomock.Any(), gomock.Any()).
+			Return([]dw.WorkoutRecord{
+				performed(100, 1, "2025-11-03"), // 1週目1日目（予定日どおり）
+				performed(101, 2, "2025-11-06"), // 1週目3日目の予定を4日目に実施
+				performed(102, 2, "2025-11-10"), // 2週目3日目の予定を1日目に前倒し（2週目1日目は未実施）
+			}, nil)
+
+		asOf, err := util.TimeZone{}.ParseDate("2025-11-12")
+		require.NoError(t, err)
+
+		got, err := uc.GetAdherence(context.Background(), testUserID, asOf)
+		require.NoError(t, err)
+		require.Equal(t, "2025-11-12", got.AsOf)
+		require.Equal(t, 4, got.Planned)
+		require.Equal(t, 3, got.Performed)
+		require.InDelta(t, 0.75, got.Rate, 1e-9)
+
+		statuses := make([]string, 0, len(got.Days))
+		for _, d := range got.Days {
+			statuses = append(statuses, d.Status)
+		}
+		require.Equal(t, []string{"done", "done", "missed", "done"}, statuses)
+		require.Equal(t, int64(101), *got.Days[1].RecordID)
+		require.Equal(t, "2025-11-05", got.Days[1].Date)
+	})
+}
@@ -0,0 +1,33 @@
+package program
+
+import (
+	"context"
+	"time"
+
+	dom "gogym-api/internal/domain/entities"
+	dp "gogym-api/internal/domain/entities/program"
+	dt "gogym-api/internal/domain/entities/template"
+	dw "gogym-api/internal/domain/entities/workout"
+)
+
+type Repository interface {
+	ListPrograms(ctx context.Context, userID string) ([]dp.Program, error)
+	FindProgram(ctx context.Context, userID string, programID dom.ID) (dp.Program, error)
+	CreateProgram(ctx context.Context, program dp.Program) (dom.ID, error)
+	UpdateProgram(ctx context.Context, program dp.Program) error
+	DeleteProgram(ctx context.Context, userID string, programID dom.ID) error
+	FindActiveEnrollment(ctx context.Context, userID string) (dp.Enrollment, error)
+	Enroll(ctx context.Context, enrollment dp.Enrollment) (dom.ID, error)
+	EndEnrollment(ctx context.Context, userID string) error
+}
+
+// TemplateRepository はプログラムの日程が参照するテンプレートの取得
+type TemplateRepository interface {
+	FindTemplate(ctx context.Context, userID string, templateID dom.ID) (dt.Template, error)
+}
+
+// WorkoutRepository は予定と実績の突き合わせ・重量の計算に使うワークアウト記録の操作
+type WorkoutRepository interface {
+	GetRecordsInRange(ctx context.Context, userID string, from, to time.Time) ([]dw.WorkoutRecord, error)
+	GetTrainingMaxes(ctx context.Context, userID string) (map[dw.ID]float64, error)
+}
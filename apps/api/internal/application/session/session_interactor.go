@@ -7,59 +7,75 @@ package session
 import (
 	"context"
 	"errors"
+	"fmt"
 	"gogym-api/internal/adapter/dto"
+	"gogym-api/internal/util"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/oklog/ulid/v2"
+	"go.opentelemetry.io/otel"
 )
 
+var tracer = otel.Tracer("gogym-api/internal/application/session")
+
 type sessionInteractor struct {
 	// 外部依存関係
 	ur        UserRepository
 	ph        PasswordHasher
 	jwtSecret string
+	metrics   Metrics
 }
 
 func NewSessionInteractor(
 	ur UserRepository,
 	ph PasswordHasher,
 	jwtSecret string,
+	metrics Metrics,
 ) SessionUseCase {
 	return &sessionInteractor{
 		ur:        ur,
 		ph:        ph,
 		jwtSecret: jwtSecret,
+		metrics:   metrics,
 	}
 }
 
-func (i *sessionInteractor) Login(ctx context.Context, req dto.LoginRequest) error {
+func (i *sessionInteractor) Login(ctx context.Context, req dto.LoginRequest) (err error) {
+	ctx, span := tracer.Start(ctx, "SessionUseCase.Login")
+	defer func() { util.EndSpan(span, err) }()
+
 	// ユーザー検索
 	user, err := i.ur.FindByEmail(ctx, req.Email)
 	if err != nil {
-		return errors.New("email_not_found")
+		return fmt.Errorf("failed to find user by email: %w", err)
 	}
 	if user == nil {
-		return errors.New("user_not_found")
+		i.metrics.LoginFailed()
+		return ErrInvalidCredentials
 	}
 
 	// パスワード照合
 	if err := i.ph.VerifyPassword(req.Password, user.PasswordHash); err != nil {
-		return errors.New("invalid_password")
+		i.metrics.LoginFailed()
+		return ErrInvalidCredentials
 	}
 	return nil
 }
 
-func (i *sessionInteractor) CreateSession(ctx context.Context, email string) (dto.TokenResponse, error) {
+func (i *sessionInteractor) CreateSession(ctx context.Context, email string) (_ dto.TokenResponse, err error) {
+	ctx, span := tracer.Start(ctx, "SessionUseCase.CreateSession")
+	defer func() { util.EndSpan(span, err) }()
+
 	user, err := i.ur.FindByEmail(ctx, email)
 	if err != nil {
-		return dto.TokenResponse{}, errors.New("email_not_found")
+		return dto.TokenResponse{}, fmt.Errorf("failed to find user by email: %w", err)
 	}
 	if user == nil {
-		return dto.TokenResponse{}, errors.New("user_not_found")
+		return dto.TokenResponse{}, ErrInvalidCredentials
 	}
 
-	now := time.Now()
+	now := time.Now().UTC()
 	accessTTL := 15 * time.Minute    // アクセストークンは短めに設定
 	refreshTTL := 7 * 24 * time.Hour // リフレッシュトークンは7日間
 
@@ -103,7 +119,10 @@ func (i *sessionInteractor) CreateSession(ctx context.Context, email string) (dt
 	}, nil
 }
 
-func (i *sessionInteractor) RefreshToken(ctx context.Context, refreshToken string) (dto.TokenResponse, error) {
+func (i *sessionInteractor) RefreshToken(ctx context.Context, refreshToken string) (_ dto.TokenResponse, err error) {
+	ctx, span := tracer.Start(ctx, "SessionUseCase.RefreshToken")
+	defer func() { util.EndSpan(span, err) }()
+
 	secret := []byte(i.jwtSecret)
 
 	// リフレッシュトークンを検証
@@ -115,39 +134,42 @@ func (i *sessionInteractor) RefreshToken(ctx context.Context, refreshToken strin
 	})
 
 	if err != nil || !token.Valid {
-		return dto.TokenResponse{}, errors.New("invalid_refresh_token")
+		return dto.TokenResponse{}, ErrInvalidRefreshToken
 	}
 
 	claims, ok := token.Claims.(jwt.MapClaims)
 	if !ok {
-		return dto.TokenResponse{}, errors.New("invalid_token_claims")
+		return dto.TokenResponse{}, ErrInvalidRefreshToken
 	}
 
 	// トークンタイプを確認
 	if typ, ok := claims["typ"].(string); !ok || typ != "refresh" {
-		return dto.TokenResponse{}, errors.New("invalid_token_type")
+		return dto.TokenResponse{}, ErrInvalidRefreshToken
 	}
 
 	// ユーザーIDを取得
 	userIDStr, ok := claims["sub"].(string)
 	if !ok {
-		return dto.TokenResponse{}, errors.New("user_id_not_found")
+		return dto.TokenResponse{}, ErrInvalidRefreshToken
 	}
 
 	// ULIDに変換
 	userID, err := ulid.Parse(userIDStr)
 	if err != nil {
-		return dto.TokenResponse{}, errors.New("invalid_user_id")
+		return dto.TokenResponse{}, ErrInvalidRefreshToken
 	}
 
 	// ユーザー情報を取得
 	user, err := i.ur.FindByID(ctx, userID)
-	if err != nil || user == nil {
-		return dto.TokenResponse{}, errors.New("user_not_found")
+	if err != nil {
+		return dto.TokenResponse{}, fmt.Errorf("failed to find user: %w", err)
+	}
+	if user == nil {
+		return dto.TokenResponse{}, ErrInvalidRefreshToken
 	}
 
 	// 新しいアクセストークンとリフレッシュトークンを生成
-	now := time.Now()
+	now := time.Now().UTC()
 	accessTTL := 15 * time.Minute
 	refreshTTL := 7 * 24 * time.Hour
 
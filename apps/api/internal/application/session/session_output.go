@@ -7,11 +7,19 @@ package session
 import (
 	"context"
 
+	domain "gogym-api/internal/domain/entities"
 	dom "gogym-api/internal/domain/entities/user"
 
 	"github.com/oklog/ulid/v2"
 )
 
+var (
+	// ErrInvalidCredentials はメールアドレスかパスワードが一致しない場合のエラー（どちらが違うかは返さない）
+	ErrInvalidCredentials = domain.NewError(domain.KindUnauthorized, "invalid_credentials", "invalid credentials")
+	// ErrInvalidRefreshToken はリフレッシュトークンが不正・期限切れ・ユーザーが存在しない場合のエラー
+	ErrInvalidRefreshToken = domain.NewError(domain.KindUnauthorized, "invalid_refresh_token", "invalid or expired refresh token")
+)
+
 type UserRepository interface {
 	FindByEmail(ctx context.Context, email string) (*dom.User, error)
 	FindByID(ctx context.Context, id ulid.ULID) (*dom.User, error)
@@ -21,3 +29,9 @@ type PasswordHasher interface {
 	HashPassword(password string) (string, error)
 	VerifyPassword(password, hash string) error
 }
+
+// Metrics は業務メトリクスの記録先
+type Metrics interface {
+	// LoginFailed は認証情報の誤りによるログインの失敗を記録する
+	LoginFailed()
+}
@@ -0,0 +1,179 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: template_output.go
+
+// Package template is a generated GoMock package.
+package template
+
+import (
+	context "context"
+	dom "gogym-api/internal/domain/entities"
+	dt "gogym-api/internal/domain/entities/template"
+	dw "gogym-api/internal/domain/entities/workout"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateTemplate mocks base method.
+func (m *MockRepository) CreateTemplate(ctx context.Context, template dt.Template) (dom.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTemplate", ctx, template)
+	ret0, _ := ret[0].(dom.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTemplate indicates an expected call of CreateTemplate.
+func (mr *MockRepositoryMockRecorder) CreateTemplate(ctx, template interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTemplate", reflect.TypeOf((*MockRepository)(nil).CreateTemplate), ctx, template)
+}
+
+// DeleteTemplate mocks base method.
+func (m *MockRepository) DeleteTemplate(ctx context.Context, userID string, templateID dom.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTemplate", ctx, userID, templateID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTemplate indicates an expected call of DeleteTemplate.
+func (mr *MockRepositoryMockRecorder) DeleteTemplate(ctx, userID, templateID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTemplate", reflect.TypeOf((*MockRepository)(nil).DeleteTemplate), ctx, userID, templateID)
+}
+
+// FindTemplate mocks base method.
+func (m *MockRepository) FindTemplate(ctx context.Context, userID string, templateID dom.ID) (dt.Template, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTemplate", ctx, userID, templateID)
+	ret0, _ := ret[0].(dt.Template)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTemplate indicates an expected call of FindTemplate.
+func (mr *MockRepositoryMockRecorder) FindTemplate(ctx, userID, templateID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTemplate", reflect.TypeOf((*MockRepository)(nil).FindTemplate), ctx, userID, templateID)
+}
+
+// ListTemplates mocks base method.
+func (m *MockRepository) ListTemplates(ctx context.Context, userID string) ([]dt.Template, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTemplates", ctx, userID)
+	ret0, _ := ret[0].([]dt.Template)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTemplates indicates an expected call of ListTemplates.
+func (mr *MockRepositoryMockRecorder) ListTemplates(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTemplates", reflect.TypeOf((*MockRepository)(nil).ListTemplates), ctx, userID)
+}
+
+// UpdateTemplate mocks base method.
+func (m *MockRepository) UpdateTemplate(ctx context.Context, template dt.Template) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTemplate", ctx, template)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTemplate indicates an expected call of UpdateTemplate.
+func (mr *MockRepositoryMockRecorder) UpdateTemplate(ctx, template interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTemplate", reflect.TypeOf((*MockRepository)(nil).UpdateTemplate), ctx, template)
+}
+
+// MockWorkoutRepository is a mock of WorkoutRepository interface.
+type MockWorkoutRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWorkoutRepositoryMockRecorder
+}
+
+// MockWorkoutRepositoryMockRecorder is the mock recorder for MockWorkoutRepository.
+type MockWorkoutRepositoryMockRecorder struct {
+	mock *MockWorkoutRepository
+}
+
+// NewMockWorkoutRepository creates a new mock instance.
+func NewMockWorkoutRepository(ctrl *gomock.Controller) *MockWorkoutRepository {
+	mock := &MockWorkoutRepository{ctrl: ctrl}
+	mock.recorder = &MockWorkoutRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWorkoutRepository) EXPECT() *MockWorkoutRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateWorkoutRecord mocks base method.
+func (m *MockWorkoutRepository) CreateWorkoutRecord(ctx context.Context, workout dw.WorkoutRecord) (dw.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWorkoutRecord", ctx, workout)
+	ret0, _ := ret[0].(dw.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWorkoutRecord indicates an expected call of CreateWorkoutRecord.
+func (mr *MockWorkoutRepositoryMockRecorder) CreateWorkoutRecord(ctx, workout interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWorkoutRecord", reflect.TypeOf((*MockWorkoutRepository)(nil).CreateWorkoutRecord), ctx, workout)
+}
+
+// GetLastWorkoutRecord mocks base method.
+func (m *MockWorkoutRepository) GetLastWorkoutRecord(ctx context.Context, userID string, exerciseID int64) (dw.WorkoutRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastWorkoutRecord", ctx, userID, exerciseID)
+	ret0, _ := ret[0].(dw.WorkoutRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastWorkoutRecord indicates an expected call of GetLastWorkoutRecord.
+func (mr *MockWorkoutRepositoryMockRecorder) GetLastWorkoutRecord(ctx, userID, exerciseID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastWorkoutRecord", reflect.TypeOf((*MockWorkoutRepository)(nil).GetLastWorkoutRecord), ctx, userID, exerciseID)
+}
+
+// GetRecordByID mocks base method.
+func (m *MockWorkoutRepository) GetRecordByID(ctx context.Context, userID string, recordID dw.ID) (dw.WorkoutRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecordByID", ctx, userID, recordID)
+	ret0, _ := ret[0].(dw.WorkoutRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecordByID indicates an expected call of GetRecordByID.
+func (mr *MockWorkoutRepositoryMockRecorder) GetRecordByID(ctx, userID, recordID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecordByID", reflect.TypeOf((*MockWorkoutRepository)(nil).GetRecordByID), ctx, userID, recordID)
+}
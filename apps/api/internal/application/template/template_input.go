@@ -0,0 +1,21 @@
+package template
+
+import (
+	"context"
+
+	dto "gogym-api/internal/adapter/dto"
+	dom "gogym-api/internal/domain/entities"
+	dw "gogym-api/internal/domain/entities/workout"
+	"gogym-api/internal/util"
+)
+
+// handler → usecase
+type TemplateUseCase interface {
+	ListTemplates(ctx context.Context, userID string, locale string, units dom.WeightUnits) ([]dto.TemplateDTO, error)
+	GetTemplate(ctx context.Context, userID string, templateID int64, locale string, units dom.WeightUnits) (dto.TemplateDTO, error)
+	CreateTemplate(ctx context.Context, userID string, req dto.SaveTemplateRequest, locale string, units dom.WeightUnits) (dto.TemplateDTO, error)
+	UpdateTemplate(ctx context.Context, userID string, templateID int64, req dto.SaveTemplateRequest, locale string, units dom.WeightUnits) (dto.TemplateDTO, error)
+	DeleteTemplate(ctx context.Context, userID string, templateID int64) error
+	// StartWorkout は base（日付・開始時刻・ユーザー）にテンプレートのセットを詰めたセッションを作成する
+	StartWorkout(ctx context.Context, templateID int64, base dw.WorkoutRecord, locale string, zone util.TimeZone, units dom.WeightUnits) (dto.WorkoutRecordDTO, error)
+}
@@ -0,0 +1,136 @@
+package template
+
+import (
+	"context"
+	"fmt"
+
+	dto "gogym-api/internal/adapter/dto"
+	dom "gogym-api/internal/domain/entities"
+	dw "gogym-api/internal/domain/entities/workout"
+	"gogym-api/internal/util"
+
+	"go.opentelemetry.io/otel"
+)
+
+var tracer = otel.Tracer("gogym-api/internal/application/template")
+
+type templateInteractor struct {
+	repo        Repository
+	workoutRepo WorkoutRepository
+}
+
+func NewTemplateInteractor(repo Repository, workoutRepo WorkoutRepository) TemplateUseCase {
+	return &templateInteractor{
+		repo:        repo,
+		workoutRepo: workoutRepo,
+	}
+}
+
+// ListTemplates はユーザーのテンプレートを一覧で返す（種目名は locale で解決する）
+func (i *templateInteractor) ListTemplates(ctx context.Context, userID string, locale string, units dom.WeightUnits) (_ []dto.TemplateDTO, err error) {
+	ctx, span := tracer.Start(ctx, "TemplateUseCase.ListTemplates")
+	defer func() { util.EndSpan(span, err) }()
+
+	templates, err := i.repo.ListTemplates(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return dto.TemplatesToDTO(templates, locale, units), nil
+}
+
+// GetTemplate はユーザーのテンプレートを1件返す
+func (i *templateInteractor) GetTemplate(ctx context.Context, userID string, templateID int64, locale string, units dom.WeightUnits) (_ dto.TemplateDTO, err error) {
+	ctx, span := tracer.Start(ctx, "TemplateUseCase.GetTemplate")
+	defer func() { util.EndSpan(span, err) }()
+
+	t, err := i.repo.FindTemplate(ctx, userID, dom.ID(templateID))
+	if err != nil {
+		return dto.TemplateDTO{}, err
+	}
+	return dto.TemplateToDTO(&t, locale, units), nil
+}
+
+// CreateTemplate はテンプレートを作成し、保存後の内容を返す
+func (i *templateInteractor) CreateTemplate(ctx context.Context, userID string, req dto.SaveTemplateRequest, locale string, units dom.WeightUnits) (_ dto.TemplateDTO, err error) {
+	ctx, span := tracer.Start(ctx, "TemplateUseCase.CreateTemplate")
+	defer func() { util.EndSpan(span, err) }()
+
+	t, err := dto.SaveTemplateRequestToDomain(dom.ULID(userID), req, units)
+	if err != nil {
+		return dto.TemplateDTO{}, err
+	}
+
+	id, err := i.repo.CreateTemplate(ctx, *t)
+	if err != nil {
+		return dto.TemplateDTO{}, err
+	}
+
+	return i.GetTemplate(ctx, userID, int64(id), locale, units)
+}
+
+// UpdateTemplate はテンプレートの名前・メモを更新し、種目を置き換える
+func (i *templateInteractor) UpdateTemplate(ctx context.Context, userID string, templateID int64, req dto.SaveTemplateRequest, locale string, units dom.WeightUnits) (_ dto.TemplateDTO, err error) {
+	ctx, span := tracer.Start(ctx, "TemplateUseCase.UpdateTemplate")
+	defer func() { util.EndSpan(span, err) }()
+
+	t, err := dto.SaveTemplateRequestToDomain(dom.ULID(userID), req, units)
+	if err != nil {
+		return dto.TemplateDTO{}, err
+	}
+	t.ID = dom.ID(templateID)
+
+	if err := i.repo.UpdateTemplate(ctx, *t); err != nil {
+		return dto.TemplateDTO{}, err
+	}
+
+	return i.GetTemplate(ctx, userID, templateID, locale, units)
+}
+
+// DeleteTemplate はテンプレートを削除する（開始済みのセッションは残る）
+func (i *templateInteractor) DeleteTemplate(ctx context.Context, userID string, templateID int64) (err error) {
+	ctx, span := tracer.Start(ctx, "TemplateUseCase.DeleteTemplate")
+	defer func() { util.EndSpan(span, err) }()
+
+	return i.repo.DeleteTemplate(ctx, userID, dom.ID(templateID))
+}
+
+// StartWorkout はテンプレートの種目と目標からセットを作り、新しいセッションとして保存する
+// 重量は種目ごとの前回の記録（GetLastWorkoutRecord と同じセッション）から引き継ぐ
+func (i *templateInteractor) StartWorkout(ctx context.Context, templateID int64, base dw.WorkoutRecord, locale string, zone util.TimeZone, units dom.WeightUnits) (_ dto.WorkoutRecordDTO, err error) {
+	ctx, span := tracer.Start(ctx, "TemplateUseCase.StartWorkout")
+	defer func() { util.EndSpan(span, err) }()
+
+	userID := string(base.UserID)
+	t, err := i.repo.FindTemplate(ctx, userID, dom.ID(templateID))
+	if err != nil {
+		return dto.WorkoutRecordDTO{}, err
+	}
+
+	record := base
+	record.ID = nil
+	record.TemplateID = &t.ID
+	record.Sets = []dw.WorkoutSet{}
+	for _, ex := range t.Exercises {
+		last, err := i.workoutRepo.GetLastWorkoutRecord(ctx, userID, int64(ex.Exercise.ID))
+		if err != nil {
+			return dto.WorkoutRecordDTO{}, err
+		}
+		for _, set := range ex.PlanSets(last.SetsOf(ex.Exercise.ID)) {
+			if err := record.AddSet(set); err != nil {
+				return dto.WorkoutRecordDTO{}, fmt.Errorf("failed to plan set: %w", err)
+			}
+		}
+	}
+
+	id, err := i.workoutRepo.CreateWorkoutRecord(ctx, record)
+	if err != nil {
+		return dto.WorkoutRecordDTO{}, err
+	}
+
+	created, err := i.workoutRepo.GetRecordByID(ctx, userID, id)
+	if err != nil {
+		return dto.WorkoutRecordDTO{}, err
+	}
+
+	return *dto.WorkoutDomainToDTO(&created, locale, zone, units), nil
+}
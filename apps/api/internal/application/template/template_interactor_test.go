@@ -0,0 +1,168 @@
+package template
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"gogym-api/internal/adapter/dto"
+	dom "gogym-api/internal/domain/entities"
+	dt "gogym-api/internal/domain/entities/template"
+	dw "gogym-api/internal/domain/entities/workout"
+	"gogym-api/internal/util"
+)
+
+func TestTemplateInteractor_CreateTemplate(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	userID := "01FGZ9K6TV3J5ZZZQX6Z9X6K7W" // ULID
+
+	t.Run("正常系: 種目の並び順を振り直して保存し、保存後の内容を返す", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		repo := NewMockRepository(ctrl)
+		uc := NewTemplateInteractor(repo, nil)
+
+		repo.EXPECT().
+			CreateTemplate(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, tmpl dt.Template) (dom.ID, error) {
+				require.Equal(t, "Push", tmpl.Name)
+				require.Len(t, tmpl.Exercises, 2)
+				require.Equal(t, 1, tmpl.Exercises[0].Position)
+				require.Equal(t, 2, tmpl.Exercises[1].Position)
+				return 7, nil
+			})
+		repo.EXPECT().
+			FindTemplate(gomock.Any(), userID, dom.ID(7)).
+			Return(dt.Template{ID: 7, Name: "Push", Exercises: []dt.TemplateExercise{
+				{Exercise: dw.WorkoutExerciseRef{ID: 10, Name: "ベンチプレス"}, Position: 1, TargetSets: 3, RepsMin: 8, RepsMax: 12},
+			}}, nil)
+
+		got, err := uc.CreateTemplate(ctx, userID, dto.SaveTemplateRequest{
+			Name: " Push ",
+			Exercises: []dto.TemplateExerciseDTO{
+				{ExerciseID: 10, TargetSets: 3, RepsMin: 8, RepsMax: 12},
+				{ExerciseID: 11, TargetSets: 3, RepsMin: 10, RepsMax: 15},
+			},
+		}, "ja", dom.WeightUnits{})
+		require.NoError(t, err)
+		require.Equal(t, int64(7), got.ID)
+		require.Equal(t, "ベンチプレス", got.Exercises[0].Name)
+	})
+
+	t.Run("異常系: 不正な目標の場合はErrInvalidTemplateを返し保存しない", func(t *testing.T) {
+		t.Parallel()
+
+		cases := map[string]dto.SaveTemplateRequest{
+			"名前なし":    {Name: " ", Exercises: []dto.TemplateExerciseDTO{{ExerciseID: 10, TargetSets: 3, RepsMin: 8, RepsMax: 12}}},
+			"種目なし":    {Name: "Push"},
+			"回数の範囲が逆": {Name: "Push", Exercises: []dto.TemplateExerciseDTO{{ExerciseID: 10, TargetSets: 3, RepsMin: 12, RepsMax: 8}}},
+			"セット数0":   {Name: "Push", Exercises: []dto.TemplateExerciseDTO{{ExerciseID: 10, RepsMin: 8, RepsMax: 12}}},
+			"種目の重複": {Name: "Push", Exercises: []dto.TemplateExerciseDTO{
+				{ExerciseID: 10, TargetSets: 3, RepsMin: 8, RepsMax: 12},
+				{ExerciseID: 10, TargetSets: 2, RepsMin: 8, RepsMax: 12},
+			}},
+		}
+		for name, req := range cases {
+			ctrl := gomock.NewController(t)
+			uc := NewTemplateInteractor(NewMockRepository(ctrl), nil)
+
+			_, err := uc.CreateTemplate(ctx, userID, req, "ja", dom.WeightUnits{})
+			require.ErrorIs(t, err, dt.ErrInvalidTemplate, name)
+		}
+	})
+}
+
+func TestTemplateInteractor_StartWorkout(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	userID := "01FGZ9K6TV3J5ZZZQX6Z9X6K7W" // ULID
+	date := time.Date(2025, 11, 25, 0, 0, 0, 0, time.UTC)
+
+	bench := dw.WorkoutExerciseRef{ID: 10, Name: "ベンチプレス"}
+	fly := dw.WorkoutExerciseRef{ID: 11, Name: "ダンベルフライ"}
+	targetWeight := 12.5
+	tmpl := dt.Template{
+		ID:   7,
+		Name: "Push",
+		Exercises: []dt.TemplateExercise{
+			{Exercise: bench, Position: 1, TargetSets: 3, RepsMin: 5, RepsMax: 8},
+			{Exercise: fly, Position: 2, TargetSets: 2, RepsMin: 10, RepsMax: 15, TargetWeightKg: &targetWeight},
+		},
+	}
+
+	t.Run("正常系: 前回の重量を引き継ぎ、記録がない種目は目標重量でセッションを作る", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		repo := NewMockRepository(ctrl)
+		workoutRepo := NewMockWorkoutRepository(ctrl)
+		uc := NewTemplateInteractor(repo, workoutRepo)
+
+		lastID := dom.ID(3)
+		repo.EXPECT().FindTemplate(gomock.Any(), userID, dom.ID(7)).Return(tmpl, nil)
+		workoutRepo.EXPECT().
+			GetLastWorkoutRecord(gomock.Any(), userID, int64(10)).
+			Return(dw.WorkoutRecord{ID: &lastID, Sets: []dw.WorkoutSet{
+				{Exercise: bench, SetNumber: 1, Type: dw.SetTypeWarmup, Weight: 40, Reps: 10},
+				{Exercise: bench, SetNumber: 2, Weight: 80, Reps: 8},
+				{Exercise: bench, SetNumber: 3, Weight: 82.5, Reps: 6},
+			}}, nil)
+		workoutRepo.EXPECT().
+			GetLastWorkoutRecord(gomock.Any(), userID, int64(11)).
+			Return(dw.WorkoutRecord{}, nil)
+
+		var saved dw.WorkoutRecord
+		workoutRepo.EXPECT().
+			CreateWorkoutRecord(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, r dw.WorkoutRecord) (dw.ID, error) {
+				saved = r
+				return 42, nil
+			})
+		workoutRepo.EXPECT().
+			GetRecordByID(gomock.Any(), userID, dw.ID(42)).
+			DoAndReturn(func(context.Context, string, dw.ID) (dw.WorkoutRecord, error) {
+				id := dw.ID(42)
+				saved.ID = &id
+				return saved, nil
+			})
+
+		got, err := uc.StartWorkout(ctx, 7, dw.WorkoutRecord{UserID: dom.ULID(userID), PerformedDate: date}, "ja", util.TimeZone{}, dom.WeightUnits{})
+		require.NoError(t, err)
+		require.Equal(t, int64(42), *got.ID)
+		require.Equal(t, int64(7), *got.TemplateID)
+
+		require.Equal(t, dw.ID(7), *saved.TemplateID)
+		benchSets := saved.SetsOf(10)
+		require.Len(t, benchSets, 3)
+		// ウォームアップを除いた前回のセットの重量をセット番号ごとに使い、足りない分は最後のセットの重量
+		require.Equal(t, dw.WeightKg(80), benchSets[0].Weight)
+		require.Equal(t, dw.WeightKg(82.5), benchSets[1].Weight)
+		require.Equal(t, dw.WeightKg(82.5), benchSets[2].Weight)
+		require.Equal(t, dw.Reps(5), benchSets[0].Reps)
+
+		flySets := saved.SetsOf(11)
+		require.Len(t, flySets, 2)
+		require.Equal(t, dw.WeightKg(12.5), flySets[1].Weight)
+		require.Equal(t, dw.Reps(10), flySets[1].Reps)
+	})
+
+	t.Run("異常系: テンプレートが存在しない場合はセッションを作らない", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		repo := NewMockRepository(ctrl)
+		uc := NewTemplateInteractor(repo, NewMockWorkoutRepository(ctrl))
+
+		repo.EXPECT().FindTemplate(gomock.Any(), userID, dom.ID(99)).Return(dt.Template{}, dt.ErrTemplateNotFound)
+
+		_, err := uc.StartWorkout(ctx, 99, dw.WorkoutRecord{UserID: dom.ULID(userID), PerformedDate: date}, "ja", util.TimeZone{}, dom.WeightUnits{})
+		require.ErrorIs(t, err, dt.ErrTemplateNotFound)
+	})
+}
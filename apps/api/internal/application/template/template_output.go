@@ -0,0 +1,24 @@
+package template
+
+import (
+	"context"
+
+	dom "gogym-api/internal/domain/entities"
+	dt "gogym-api/internal/domain/entities/template"
+	dw "gogym-api/internal/domain/entities/workout"
+)
+
+type Repository interface {
+	ListTemplates(ctx context.Context, userID string) ([]dt.Template, error)
+	FindTemplate(ctx context.Context, userID string, templateID dom.ID) (dt.Template, error)
+	CreateTemplate(ctx context.Context, template dt.Template) (dom.ID, error)
+	UpdateTemplate(ctx context.Context, template dt.Template) error
+	DeleteTemplate(ctx context.Context, userID string, templateID dom.ID) error
+}
+
+// WorkoutRepository はテンプレートからセッションを作るのに使うワークアウト記録の操作
+type WorkoutRepository interface {
+	GetLastWorkoutRecord(ctx context.Context, userID string, exerciseID int64) (dw.WorkoutRecord, error)
+	CreateWorkoutRecord(ctx context.Context, workout dw.WorkoutRecord) (dw.ID, error)
+	GetRecordByID(ctx context.Context, userID string, recordID dw.ID) (dw.WorkoutRecord, error)
+}
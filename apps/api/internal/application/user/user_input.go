@@ -3,8 +3,12 @@ package user
 import (
 	"context"
 	"gogym-api/internal/adapter/dto"
+	domain "gogym-api/internal/domain/entities"
 )
 
 type UserUseCase interface {
 	SignUp(ctx context.Context, req dto.SignUpRequest) error
+	GetPreferences(ctx context.Context, userID string) (dto.UserPreferencesResponse, error)
+	UpdatePreferences(ctx context.Context, userID string, req dto.UpdateUserPreferencesRequest) (dto.UserPreferencesResponse, error)
+	Preferences(ctx context.Context, userID string) (domain.UserPreferences, error)
 }
@@ -7,32 +7,49 @@ import (
 	"time"
 
 	"gogym-api/internal/adapter/dto"
+	"gogym-api/internal/application/notify"
+	"gogym-api/internal/application/outbox"
+	domain "gogym-api/internal/domain/entities"
+	dn "gogym-api/internal/domain/entities/notification"
 	dom "gogym-api/internal/domain/entities/user"
+	"gogym-api/internal/util"
 
 	"github.com/oklog/ulid/v2"
+	"go.opentelemetry.io/otel"
 )
 
+var tracer = otel.Tracer("gogym-api/internal/application/user")
+
 type userInteractor struct {
-	repo   Repository
-	hasher PasswordHasher
+	repo      Repository
+	hasher    PasswordHasher
+	tx        Transactor
+	publisher outbox.Publisher
+	metrics   Metrics
 }
 
-func NewUserInteractor(repo Repository, hasher PasswordHasher) UserUseCase {
+func NewUserInteractor(repo Repository, hasher PasswordHasher, tx Transactor, publisher outbox.Publisher, metrics Metrics) UserUseCase {
 	return &userInteractor{
-		repo:   repo,
-		hasher: hasher,
+		repo:      repo,
+		hasher:    hasher,
+		tx:        tx,
+		publisher: publisher,
+		metrics:   metrics,
 	}
 }
 
 // SignUp handles user registration
-func (i *userInteractor) SignUp(ctx context.Context, req dto.SignUpRequest) error {
+func (i *userInteractor) SignUp(ctx context.Context, req dto.SignUpRequest) (err error) {
+	ctx, span := tracer.Start(ctx, "UserUseCase.SignUp")
+	defer func() { util.EndSpan(span, err) }()
+
 	// メールアドレスの重複チェック
 	exists, err := i.repo.ExistsByEmail(ctx, req.Email)
 	if err != nil {
 		return err
 	}
 	if exists {
-		return errors.New("email_already_exists")
+		return ErrEmailAlreadyExists
 	}
 
 	// パスワードハッシュ化
@@ -46,7 +63,7 @@ func (i *userInteractor) SignUp(ctx context.Context, req dto.SignUpRequest) erro
 	entropy := ulid.Monotonic(rand.Reader, 0)
 	id := ulid.MustNew(ulid.Timestamp(t), entropy)
 
-	now := time.Now()
+	now := time.Now().UTC()
 
 	// ユーザーエンティティの生成
 	user := dom.NewUser(id, req.Name, req.Email, hashedPassword, now)
@@ -54,11 +71,110 @@ func (i *userInteractor) SignUp(ctx context.Context, req dto.SignUpRequest) erro
 		return errors.New("failed to create user entity")
 	}
 
-	// データベースに保存
-	err = i.repo.Create(ctx, user)
+	// データベースに保存し、新規登録通知を同一トランザクションで予約
+	err = i.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := i.repo.Create(ctx, user); err != nil {
+			return err
+		}
+		return i.publisher.Publish(ctx, notify.TopicNotification, dn.Notification{
+			Channel: dn.ChannelSignups,
+			Title:   "🎉 New user signed up",
+			Fields: []dn.Field{
+				{Label: "Name", Value: user.Name},
+				{Label: "User ID", Value: user.ID.String()},
+			},
+			Context: []dn.Field{
+				{Label: "Email", Value: user.Email},
+			},
+		})
+	})
 	if err != nil {
 		return err
 	}
-
+	i.metrics.SignedUp()
 	return nil
 }
+
+// GetPreferences はユーザーの表示設定を返す
+func (i *userInteractor) GetPreferences(ctx context.Context, userID string) (_ dto.UserPreferencesResponse, err error) {
+	ctx, span := tracer.Start(ctx, "UserUseCase.GetPreferences")
+	defer func() { util.EndSpan(span, err) }()
+
+	user, err := i.findUser(ctx, userID)
+	if err != nil {
+		return dto.UserPreferencesResponse{}, err
+	}
+	return dto.UserToPreferencesResponse(user), nil
+}
+
+// UpdatePreferences はユーザーの表示設定を更新する（指定された項目のみ）
+func (i *userInteractor) UpdatePreferences(ctx context.Context, userID string, req dto.UpdateUserPreferencesRequest) (_ dto.UserPreferencesResponse, err error) {
+	ctx, span := tracer.Start(ctx, "UserUseCase.UpdatePreferences")
+	defer func() { util.EndSpan(span, err) }()
+
+	user, err := i.findUser(ctx, userID)
+	if err != nil {
+		return dto.UserPreferencesResponse{}, err
+	}
+
+	if req.Locale != nil {
+		if err := user.SetLocale(*req.Locale); err != nil {
+			return dto.UserPreferencesResponse{}, err
+		}
+	}
+	if req.TimeZone != nil {
+		if err := user.SetTimeZone(*req.TimeZone); err != nil {
+			return dto.UserPreferencesResponse{}, err
+		}
+	}
+	if req.WeightUnit != nil || req.WeightIncrement != nil {
+		units := user.WeightUnits
+		if req.WeightUnit != nil && domain.WeightUnit(*req.WeightUnit) != units.Unit {
+			// 前の単位の丸め幅は引き継がない
+			units = domain.WeightUnits{Unit: domain.WeightUnit(*req.WeightUnit)}
+		}
+		if req.WeightIncrement != nil {
+			units.Increment = *req.WeightIncrement
+		}
+		if err := user.SetWeightUnits(units); err != nil {
+			return dto.UserPreferencesResponse{}, err
+		}
+	}
+
+	if err := i.repo.UpdatePreferences(ctx, user); err != nil {
+		return dto.UserPreferencesResponse{}, err
+	}
+	return dto.UserToPreferencesResponse(user), nil
+}
+
+// Preferences はユーザーが設定した表示設定を返す（未設定の項目はゼロ値）
+func (i *userInteractor) Preferences(ctx context.Context, userID string) (_ domain.UserPreferences, err error) {
+	ctx, span := tracer.Start(ctx, "UserUseCase.Preferences")
+	defer func() { util.EndSpan(span, err) }()
+
+	user, err := i.findUser(ctx, userID)
+	if err != nil {
+		return domain.UserPreferences{}, err
+	}
+	return domain.UserPreferences{
+		Locale:      user.Locale,
+		TimeZone:    user.TimeZone,
+		WeightUnits: user.WeightUnits,
+	}, nil
+}
+
+func (i *userInteractor) findUser(ctx context.Context, userID string) (*dom.User, error) {
+	id, err := ulid.Parse(userID)
+	if err != nil {
+		return nil, dom.ErrUserNotFound
+	}
+
+	user, err := i.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, dom.ErrUserNotFound
+	}
+	return user, nil
+}
@@ -2,13 +2,21 @@ package user
 
 import (
 	"context"
+	domain "gogym-api/internal/domain/entities"
 	dom "gogym-api/internal/domain/entities/user"
+
+	"github.com/oklog/ulid/v2"
 )
 
+// ErrEmailAlreadyExists は登録済みのメールアドレスで新規登録しようとした場合のエラー
+var ErrEmailAlreadyExists = domain.NewError(domain.KindConflict, "email_already_exists", "email already exists")
+
 // Repository はユーザーデータの永続化を担当
 type Repository interface {
 	Create(ctx context.Context, u *dom.User) error
 	ExistsByEmail(ctx context.Context, email string) (bool, error)
+	FindByID(ctx context.Context, id ulid.ULID) (*dom.User, error)
+	UpdatePreferences(ctx context.Context, u *dom.User) error
 }
 
 // PasswordHasher はパスワードのハッシュ化を担当
@@ -16,3 +24,14 @@ type PasswordHasher interface {
 	HashPassword(password string) (string, error)
 	VerifyPassword(password, hash string) error
 }
+
+// Transactor は複数リポジトリへの書き込みを1トランザクションにまとめる
+type Transactor interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// Metrics は業務メトリクスの記録先
+type Metrics interface {
+	// SignedUp はユーザー登録を記録する
+	SignedUp()
+}
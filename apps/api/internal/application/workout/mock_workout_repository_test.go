@@ -36,41 +36,13 @@ func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
 	return m.recorder
 }
 
-// CountUserWorkoutParts mocks base method.
-func (m *MockRepository) CountUserWorkoutParts(ctx context.Context, userID string) (int64, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CountUserWorkoutParts", ctx, userID)
-	ret0, _ := ret[0].(int64)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// CountUserWorkoutParts indicates an expected call of CountUserWorkoutParts.
-func (mr *MockRepositoryMockRecorder) CountUserWorkoutParts(ctx, userID interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUserWorkoutParts", reflect.TypeOf((*MockRepository)(nil).CountUserWorkoutParts), ctx, userID)
-}
-
-// CreateWorkoutParts mocks base method.
-func (m *MockRepository) CreateWorkoutParts(ctx context.Context, userID string, parts []dw.WorkoutPart) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateWorkoutParts", ctx, userID, parts)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// CreateWorkoutParts indicates an expected call of CreateWorkoutParts.
-func (mr *MockRepositoryMockRecorder) CreateWorkoutParts(ctx, userID, parts interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWorkoutParts", reflect.TypeOf((*MockRepository)(nil).CreateWorkoutParts), ctx, userID, parts)
-}
-
 // CreateWorkoutRecord mocks base method.
-func (m *MockRepository) CreateWorkoutRecord(ctx context.Context, workout dw.WorkoutRecord) error {
+func (m *MockRepository) CreateWorkoutRecord(ctx context.Context, workout dw.WorkoutRecord) (dw.ID, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateWorkoutRecord", ctx, workout)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(dw.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // CreateWorkoutRecord indicates an expected call of CreateWorkoutRecord.
@@ -93,6 +65,21 @@ func (mr *MockRepositoryMockRecorder) DeleteWorkoutExercise(ctx, userID, exercis
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkoutExercise", reflect.TypeOf((*MockRepository)(nil).DeleteWorkoutExercise), ctx, userID, exerciseID)
 }
 
+// GetDefaultRests mocks base method.
+func (m *MockRepository) GetDefaultRests(ctx context.Context, userID string) (map[dw.ID]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDefaultRests", ctx, userID)
+	ret0, _ := ret[0].(map[dw.ID]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDefaultRests indicates an expected call of GetDefaultRests.
+func (mr *MockRepositoryMockRecorder) GetDefaultRests(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDefaultRests", reflect.TypeOf((*MockRepository)(nil).GetDefaultRests), ctx, userID)
+}
+
 // GetLastWorkoutRecord mocks base method.
 func (m *MockRepository) GetLastWorkoutRecord(ctx context.Context, userID string, exerciseID int64) (dw.WorkoutRecord, error) {
 	m.ctrl.T.Helper()
@@ -108,11 +95,41 @@ func (mr *MockRepositoryMockRecorder) GetLastWorkoutRecord(ctx, userID, exercise
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastWorkoutRecord", reflect.TypeOf((*MockRepository)(nil).GetLastWorkoutRecord), ctx, userID, exerciseID)
 }
 
+// GetRecentWorkoutRecords mocks base method.
+func (m *MockRepository) GetRecentWorkoutRecords(ctx context.Context, userID string, exerciseID int64, limit int) ([]dw.WorkoutRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecentWorkoutRecords", ctx, userID, exerciseID, limit)
+	ret0, _ := ret[0].([]dw.WorkoutRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecentWorkoutRecords indicates an expected call of GetRecentWorkoutRecords.
+func (mr *MockRepositoryMockRecorder) GetRecentWorkoutRecords(ctx, userID, exerciseID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecentWorkoutRecords", reflect.TypeOf((*MockRepository)(nil).GetRecentWorkoutRecords), ctx, userID, exerciseID, limit)
+}
+
+// GetRecordByID mocks base method.
+func (m *MockRepository) GetRecordByID(ctx context.Context, userID string, recordID dw.ID) (dw.WorkoutRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecordByID", ctx, userID, recordID)
+	ret0, _ := ret[0].(dw.WorkoutRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecordByID indicates an expected call of GetRecordByID.
+func (mr *MockRepositoryMockRecorder) GetRecordByID(ctx, userID, recordID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecordByID", reflect.TypeOf((*MockRepository)(nil).GetRecordByID), ctx, userID, recordID)
+}
+
 // GetRecordsByDate mocks base method.
-func (m *MockRepository) GetRecordsByDate(ctx context.Context, userID string, date time.Time) (dw.WorkoutRecord, error) {
+func (m *MockRepository) GetRecordsByDate(ctx context.Context, userID string, date time.Time) ([]dw.WorkoutRecord, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetRecordsByDate", ctx, userID, date)
-	ret0, _ := ret[0].(dw.WorkoutRecord)
+	ret0, _ := ret[0].([]dw.WorkoutRecord)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -123,6 +140,36 @@ func (mr *MockRepositoryMockRecorder) GetRecordsByDate(ctx, userID, date interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecordsByDate", reflect.TypeOf((*MockRepository)(nil).GetRecordsByDate), ctx, userID, date)
 }
 
+// GetRecordsInRange mocks base method.
+func (m *MockRepository) GetRecordsInRange(ctx context.Context, userID string, from, to time.Time) ([]dw.WorkoutRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecordsInRange", ctx, userID, from, to)
+	ret0, _ := ret[0].([]dw.WorkoutRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecordsInRange indicates an expected call of GetRecordsInRange.
+func (mr *MockRepositoryMockRecorder) GetRecordsInRange(ctx, userID, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecordsInRange", reflect.TypeOf((*MockRepository)(nil).GetRecordsInRange), ctx, userID, from, to)
+}
+
+// GetTrainingMaxes mocks base method.
+func (m *MockRepository) GetTrainingMaxes(ctx context.Context, userID string) (map[dw.ID]float64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrainingMaxes", ctx, userID)
+	ret0, _ := ret[0].(map[dw.ID]float64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrainingMaxes indicates an expected call of GetTrainingMaxes.
+func (mr *MockRepositoryMockRecorder) GetTrainingMaxes(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrainingMaxes", reflect.TypeOf((*MockRepository)(nil).GetTrainingMaxes), ctx, userID)
+}
+
 // GetWorkoutParts mocks base method.
 func (m *MockRepository) GetWorkoutParts(ctx context.Context, userID string) ([]dw.WorkoutPart, error) {
 	m.ctrl.T.Helper()
@@ -138,6 +185,90 @@ func (mr *MockRepositoryMockRecorder) GetWorkoutParts(ctx, userID interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkoutParts", reflect.TypeOf((*MockRepository)(nil).GetWorkoutParts), ctx, userID)
 }
 
+// HideExercise mocks base method.
+func (m *MockRepository) HideExercise(ctx context.Context, userID string, exerciseID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HideExercise", ctx, userID, exerciseID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HideExercise indicates an expected call of HideExercise.
+func (mr *MockRepositoryMockRecorder) HideExercise(ctx, userID, exerciseID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HideExercise", reflect.TypeOf((*MockRepository)(nil).HideExercise), ctx, userID, exerciseID)
+}
+
+// SetDefaultRest mocks base method.
+func (m *MockRepository) SetDefaultRest(ctx context.Context, userID string, exerciseID int64, restSec *int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDefaultRest", ctx, userID, exerciseID, restSec)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetDefaultRest indicates an expected call of SetDefaultRest.
+func (mr *MockRepositoryMockRecorder) SetDefaultRest(ctx, userID, exerciseID, restSec interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDefaultRest", reflect.TypeOf((*MockRepository)(nil).SetDefaultRest), ctx, userID, exerciseID, restSec)
+}
+
+// SetTrainingMax mocks base method.
+func (m *MockRepository) SetTrainingMax(ctx context.Context, userID string, exerciseID int64, weightKg *float64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTrainingMax", ctx, userID, exerciseID, weightKg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetTrainingMax indicates an expected call of SetTrainingMax.
+func (mr *MockRepositoryMockRecorder) SetTrainingMax(ctx, userID, exerciseID, weightKg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrainingMax", reflect.TypeOf((*MockRepository)(nil).SetTrainingMax), ctx, userID, exerciseID, weightKg)
+}
+
+// SyncPresets mocks base method.
+func (m *MockRepository) SyncPresets(ctx context.Context, parts []dw.WorkoutPart) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncPresets", ctx, parts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SyncPresets indicates an expected call of SyncPresets.
+func (mr *MockRepositoryMockRecorder) SyncPresets(ctx, parts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncPresets", reflect.TypeOf((*MockRepository)(nil).SyncPresets), ctx, parts)
+}
+
+// UnhideExercise mocks base method.
+func (m *MockRepository) UnhideExercise(ctx context.Context, userID string, exerciseID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnhideExercise", ctx, userID, exerciseID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnhideExercise indicates an expected call of UnhideExercise.
+func (mr *MockRepositoryMockRecorder) UnhideExercise(ctx, userID, exerciseID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnhideExercise", reflect.TypeOf((*MockRepository)(nil).UnhideExercise), ctx, userID, exerciseID)
+}
+
+// UpdateWorkoutRecord mocks base method.
+func (m *MockRepository) UpdateWorkoutRecord(ctx context.Context, workout dw.WorkoutRecord) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkoutRecord", ctx, workout)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWorkoutRecord indicates an expected call of UpdateWorkoutRecord.
+func (mr *MockRepositoryMockRecorder) UpdateWorkoutRecord(ctx, workout interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkoutRecord", reflect.TypeOf((*MockRepository)(nil).UpdateWorkoutRecord), ctx, workout)
+}
+
 // UpsertWorkoutExercises mocks base method.
 func (m *MockRepository) UpsertWorkoutExercises(ctx context.Context, userID string, exercises []dw.WorkoutExerciseRef) error {
 	m.ctrl.T.Helper()
@@ -152,16 +283,75 @@ func (mr *MockRepositoryMockRecorder) UpsertWorkoutExercises(ctx, userID, exerci
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertWorkoutExercises", reflect.TypeOf((*MockRepository)(nil).UpsertWorkoutExercises), ctx, userID, exercises)
 }
 
-// UpsertWorkoutRecord mocks base method.
-func (m *MockRepository) UpsertWorkoutRecord(ctx context.Context, workout dw.WorkoutRecord) error {
+// MockPresetCatalog is a mock of PresetCatalog interface.
+type MockPresetCatalog struct {
+	ctrl     *gomock.Controller
+	recorder *MockPresetCatalogMockRecorder
+}
+
+// MockPresetCatalogMockRecorder is the mock recorder for MockPresetCatalog.
+type MockPresetCatalogMockRecorder struct {
+	mock *MockPresetCatalog
+}
+
+// NewMockPresetCatalog creates a new mock instance.
+func NewMockPresetCatalog(ctrl *gomock.Controller) *MockPresetCatalog {
+	mock := &MockPresetCatalog{ctrl: ctrl}
+	mock.recorder = &MockPresetCatalogMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPresetCatalog) EXPECT() *MockPresetCatalogMockRecorder {
+	return m.recorder
+}
+
+// Presets mocks base method.
+func (m *MockPresetCatalog) Presets() ([]dw.WorkoutPart, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpsertWorkoutRecord", ctx, workout)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "Presets")
+	ret0, _ := ret[0].([]dw.WorkoutPart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Presets indicates an expected call of Presets.
+func (mr *MockPresetCatalogMockRecorder) Presets() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Presets", reflect.TypeOf((*MockPresetCatalog)(nil).Presets))
+}
+
+// MockMetrics is a mock of Metrics interface.
+type MockMetrics struct {
+	ctrl     *gomock.Controller
+	recorder *MockMetricsMockRecorder
+}
+
+// MockMetricsMockRecorder is the mock recorder for MockMetrics.
+type MockMetricsMockRecorder struct {
+	mock *MockMetrics
+}
+
+// NewMockMetrics creates a new mock instance.
+func NewMockMetrics(ctrl *gomock.Controller) *MockMetrics {
+	mock := &MockMetrics{ctrl: ctrl}
+	mock.recorder = &MockMetricsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMetrics) EXPECT() *MockMetricsMockRecorder {
+	return m.recorder
+}
+
+// WorkoutSaved mocks base method.
+func (m *MockMetrics) WorkoutSaved() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "WorkoutSaved")
 }
 
-// UpsertWorkoutRecord indicates an expected call of UpsertWorkoutRecord.
-func (mr *MockRepositoryMockRecorder) UpsertWorkoutRecord(ctx, workout interface{}) *gomock.Call {
+// WorkoutSaved indicates an expected call of WorkoutSaved.
+func (mr *MockMetricsMockRecorder) WorkoutSaved() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertWorkoutRecord", reflect.TypeOf((*MockRepository)(nil).UpsertWorkoutRecord), ctx, workout)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WorkoutSaved", reflect.TypeOf((*MockMetrics)(nil).WorkoutSaved))
 }
@@ -2,22 +2,65 @@ package workout
 
 import (
 	"context"
+	"slices"
 	"time"
 
 	dto "gogym-api/internal/adapter/dto"
 	dom "gogym-api/internal/domain/entities"
 	dw "gogym-api/internal/domain/entities/workout"
+	"gogym-api/internal/util"
 )
 
 type WorkoutUseCase interface {
-	GetWorkoutRecords(ctx context.Context, userID string, date time.Time) (dto.WorkoutRecordDTO, error)
-	CreateWorkoutRecord(ctx context.Context, workout dw.WorkoutRecord) error
-	UpsertWorkoutRecord(ctx context.Context, workout dw.WorkoutRecord) error
-	GetWorkoutParts(ctx context.Context, userID string) ([]dto.WorkoutPartListItemDTO, error)
+	GetWorkoutRecords(ctx context.Context, userID string, date time.Time, locale string, zone util.TimeZone, units dom.WeightUnits) (dto.WorkoutRecordsByDateDTO, error)
+	GetWorkoutRecord(ctx context.Context, userID string, recordID int64, locale string, zone util.TimeZone, units dom.WeightUnits) (dto.WorkoutRecordDTO, error)
+	CreateWorkoutRecord(ctx context.Context, workout dw.WorkoutRecord) (int64, error)
+	UpdateWorkoutRecord(ctx context.Context, workout dw.WorkoutRecord) error
+	GetWorkoutParts(ctx context.Context, userID string, filter WorkoutPartsFilter, locale string, units dom.WeightUnits) ([]dto.WorkoutPartListItemDTO, error)
 	SeedWorkoutParts(ctx context.Context, userID string) error
+	SyncPresetCatalog(ctx context.Context) error
+	HideExercise(ctx context.Context, userID string, exerciseID int64) error
+	UnhideExercise(ctx context.Context, userID string, exerciseID int64) error
+	SetExerciseDefaultRest(ctx context.Context, userID string, exerciseID int64, restSec *int) error
+	SetExerciseTrainingMax(ctx context.Context, userID string, exerciseID int64, weightKg *float64) error
+	GetRestSummary(ctx context.Context, userID string, from, to time.Time, locale string) (dto.RestSummaryDTO, error)
 	CreateWorkoutExercise(ctx context.Context, userID string, exercises []dto.CreateWorkoutExerciseItem) error
 	DeleteWorkoutExercise(ctx context.Context, userID string, exerciseID int64) error
-	GetLastWorkoutRecord(ctx context.Context, userID string, exerciseID int64) (*dto.ExerciseDTO, error)
+	GetLastWorkoutRecord(ctx context.Context, userID string, exerciseID int64, locale string, zone util.TimeZone, units dom.WeightUnits) (*dto.ExerciseDTO, error)
+	// SuggestProgression は直近のセッションから次の重量・回数を cfg のルールで提案する
+	SuggestProgression(ctx context.Context, userID string, exerciseID int64, cfg dw.ProgressionConfig, locale string, units dom.WeightUnits) (dto.ProgressionSuggestionDTO, error)
 
 	ResolveGymIDFromName(ctx context.Context, userID string, gymName string) (dom.ID, error)
 }
+
+// WorkoutPartsFilter は部位・種目一覧の絞り込み条件
+// 各条件は未指定（空・nil）なら絞り込まない。複数値はいずれかに一致すればよい
+type WorkoutPartsFilter struct {
+	IncludeHidden    bool                 // true の場合、非表示にしたプリセット種目も返す
+	Equipment        []dw.Equipment       // 器具
+	MovementPatterns []dw.MovementPattern // 動作パターン
+	Muscles          []dw.Muscle          // 主・補助のいずれかで使う筋肉
+	Unilateral       *bool                // 片側種目かどうか
+}
+
+// Match は種目が絞り込み条件に一致するかを返す
+func (f WorkoutPartsFilter) Match(ex dw.WorkoutExerciseRef) bool {
+	if ex.Hidden && !f.IncludeHidden {
+		return false
+	}
+	if len(f.Equipment) > 0 && !slices.Contains(f.Equipment, ex.Equipment) {
+		return false
+	}
+	if len(f.MovementPatterns) > 0 && !slices.Contains(f.MovementPatterns, ex.MovementPattern) {
+		return false
+	}
+	if len(f.Muscles) > 0 && !slices.ContainsFunc(f.Muscles, func(m dw.Muscle) bool {
+		return slices.Contains(ex.PrimaryMuscles, m) || slices.Contains(ex.SecondaryMuscles, m)
+	}) {
+		return false
+	}
+	if f.Unilateral != nil && *f.Unilateral != ex.Unilateral {
+		return false
+	}
+	return true
+}
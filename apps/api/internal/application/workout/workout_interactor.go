@@ -3,44 +3,63 @@ package workout
 import (
 	"context"
 	"errors"
+	"fmt"
 	"gogym-api/internal/util"
+	"strings"
 	"time"
 
 	dto "gogym-api/internal/adapter/dto"
 	gymUsecase "gogym-api/internal/application/gym"
+	dom "gogym-api/internal/domain/entities"
 	dg "gogym-api/internal/domain/entities/gym"
 	dw "gogym-api/internal/domain/entities/workout"
+
+	"go.opentelemetry.io/otel"
 )
 
+var tracer = otel.Tracer("gogym-api/internal/application/workout")
+
 type workoutInteractor struct {
 	repo    Repository
 	gymRepo gymUsecase.Repository
+	catalog PresetCatalog
+	metrics Metrics
 }
 
-func NewWorkoutInteractor(repo Repository, gymRepo gymUsecase.Repository) WorkoutUseCase {
+func NewWorkoutInteractor(repo Repository, gymRepo gymUsecase.Repository, catalog PresetCatalog, metrics Metrics) WorkoutUseCase {
 	return &workoutInteractor{
 		repo:    repo,
 		gymRepo: gymRepo,
+		catalog: catalog,
+		metrics: metrics,
 	}
 }
 
-func (i *workoutInteractor) GetWorkoutRecords(ctx context.Context, userID string, date time.Time) (dto.WorkoutRecordDTO, error) {
-	// repositoryからドメインエンティティを取得
-	domainRecord, err := i.repo.GetRecordsByDate(ctx, userID, date)
+// GetWorkoutRecords は指定日のセッションを開始時刻順に返す（記録がない日は空の一覧）
+// 種目名は locale で、時刻は zone で返す
+func (i *workoutInteractor) GetWorkoutRecords(ctx context.Context, userID string, date time.Time, locale string, zone util.TimeZone, units dom.WeightUnits) (_ dto.WorkoutRecordsByDateDTO, err error) {
+	ctx, span := tracer.Start(ctx, "WorkoutUseCase.GetWorkoutRecords")
+	defer func() { util.EndSpan(span, err) }()
+
+	records, err := i.repo.GetRecordsByDate(ctx, userID, date)
 	if err != nil {
-		return dto.WorkoutRecordDTO{}, err
+		return dto.WorkoutRecordsByDateDTO{}, err
 	}
 
-	// レコードが空（IDがnil）の場合は、日付だけ設定したDTOを返す
-	if domainRecord.ID == nil {
-		return dto.WorkoutRecordDTO{
-			PerformedDate: util.FormatJSTDate(date),
-			Parts:         []dto.WorkoutPartGroupDTO{},
-		}, nil
+	return dto.WorkoutRecordsToByDateDTO(util.FormatDate(date), records, locale, zone, units), nil
+}
+
+// GetWorkoutRecord はIDで指定したセッションを返す
+func (i *workoutInteractor) GetWorkoutRecord(ctx context.Context, userID string, recordID int64, locale string, zone util.TimeZone, units dom.WeightUnits) (_ dto.WorkoutRecordDTO, err error) {
+	ctx, span := tracer.Start(ctx, "WorkoutUseCase.GetWorkoutRecord")
+	defer func() { util.EndSpan(span, err) }()
+
+	record, err := i.repo.GetRecordByID(ctx, userID, dw.ID(recordID))
+	if err != nil {
+		return dto.WorkoutRecordDTO{}, err
 	}
 
-	// ドメインエンティティをDTOに変換
-	response := dto.WorkoutDomainToDTO(&domainRecord)
+	response := dto.WorkoutDomainToDTO(&record, locale, zone, units)
 	if response == nil {
 		return dto.WorkoutRecordDTO{}, errors.New("failed to convert domain record to DTO")
 	}
@@ -48,103 +67,144 @@ func (i *workoutInteractor) GetWorkoutRecords(ctx context.Context, userID string
 	return *response, nil
 }
 
-func (i *workoutInteractor) CreateWorkoutRecord(ctx context.Context, workout dw.WorkoutRecord) error {
-	// 同日同部位ならupsert、それ以外は新規作成
-	err := i.repo.UpsertWorkoutRecord(ctx, workout)
+// CreateWorkoutRecord は新しいセッションを作成する（同日に既存の記録があっても統合しない）
+func (i *workoutInteractor) CreateWorkoutRecord(ctx context.Context, workout dw.WorkoutRecord) (_ int64, err error) {
+	ctx, span := tracer.Start(ctx, "WorkoutUseCase.CreateWorkoutRecord")
+	defer func() { util.EndSpan(span, err) }()
+
+	workout.ID = nil
+	id, err := i.repo.CreateWorkoutRecord(ctx, workout)
 	if err != nil {
-		return err
+		return 0, err
 	}
-	return nil
+	i.metrics.WorkoutSaved()
+	return int64(id), nil
 }
 
-func (i *workoutInteractor) UpsertWorkoutRecord(ctx context.Context, workout dw.WorkoutRecord) error {
-	// 同日同部位ならupsert、それ以外は新規作成
-	err := i.repo.UpsertWorkoutRecord(ctx, workout)
-	if err != nil {
+// UpdateWorkoutRecord はIDで指定したセッションを更新する
+func (i *workoutInteractor) UpdateWorkoutRecord(ctx context.Context, workout dw.WorkoutRecord) (err error) {
+	ctx, span := tracer.Start(ctx, "WorkoutUseCase.UpdateWorkoutRecord")
+	defer func() { util.EndSpan(span, err) }()
+
+	if workout.ID == nil {
+		return dw.ErrRecordNotFound
+	}
+	if err := i.repo.UpdateWorkoutRecord(ctx, workout); err != nil {
 		return err
 	}
+	i.metrics.WorkoutSaved()
 	return nil
 }
 
-func (i *workoutInteractor) GetWorkoutParts(ctx context.Context, userID string) ([]dto.WorkoutPartListItemDTO, error) {
+// GetWorkoutParts はプリセットにユーザーの部位・種目をまとめた一覧を返す
+// 種目は filter で絞り込む（非表示にしたプリセット種目は filter.IncludeHidden が true の場合のみ含める）
+func (i *workoutInteractor) GetWorkoutParts(ctx context.Context, userID string, filter WorkoutPartsFilter, locale string, units dom.WeightUnits) (_ []dto.WorkoutPartListItemDTO, err error) {
+	ctx, span := tracer.Start(ctx, "WorkoutUseCase.GetWorkoutParts")
+	defer func() { util.EndSpan(span, err) }()
+
 	parts, err := i.repo.GetWorkoutParts(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
-	return dto.WorkoutPartsToDTO(parts), nil
+
+	merged := dw.MergeWorkoutParts(parts)
+	for idx := range merged {
+		matched := make([]dw.WorkoutExerciseRef, 0, len(merged[idx].Exercises))
+		for _, ex := range merged[idx].Exercises {
+			if filter.Match(ex) {
+				matched = append(matched, ex)
+			}
+		}
+		merged[idx].Exercises = matched
+	}
+
+	return dto.WorkoutPartsToDTO(merged, locale, units), nil
 }
 
+// SeedWorkoutParts は互換性のために残している
+// 部位はプリセットカタログとして全ユーザーで共有するため、ユーザーごとの作成は不要
 func (i *workoutInteractor) SeedWorkoutParts(ctx context.Context, userID string) error {
-	// すでにユーザーの部位が存在するかチェック
-	count, err := i.repo.CountUserWorkoutParts(ctx, userID)
+	return nil
+}
+
+// SyncPresetCatalog は埋め込みカタログのプリセット部位・種目をDBに反映する（冪等）
+func (i *workoutInteractor) SyncPresetCatalog(ctx context.Context) (err error) {
+	ctx, span := tracer.Start(ctx, "WorkoutUseCase.SyncPresetCatalog")
+	defer func() { util.EndSpan(span, err) }()
+
+	parts, err := i.catalog.Presets()
 	if err != nil {
 		return err
 	}
+	return i.repo.SyncPresets(ctx, parts)
+}
+
+// HideExercise はプリセット種目をユーザーの一覧から非表示にする
+func (i *workoutInteractor) HideExercise(ctx context.Context, userID string, exerciseID int64) (err error) {
+	ctx, span := tracer.Start(ctx, "WorkoutUseCase.HideExercise")
+	defer func() { util.EndSpan(span, err) }()
+
+	return i.repo.HideExercise(ctx, userID, exerciseID)
+}
 
-	// すでに存在する場合は何もしない（idempotent）
-	if count > 0 {
-		return nil
+// UnhideExercise はプリセット種目の非表示を解除する
+func (i *workoutInteractor) UnhideExercise(ctx context.Context, userID string, exerciseID int64) (err error) {
+	ctx, span := tracer.Start(ctx, "WorkoutUseCase.UnhideExercise")
+	defer func() { util.EndSpan(span, err) }()
+
+	return i.repo.UnhideExercise(ctx, userID, exerciseID)
+}
+
+// SetExerciseDefaultRest は種目のセット間の休憩（秒）を設定する（nil で解除）
+func (i *workoutInteractor) SetExerciseDefaultRest(ctx context.Context, userID string, exerciseID int64, restSec *int) (err error) {
+	ctx, span := tracer.Start(ctx, "WorkoutUseCase.SetExerciseDefaultRest")
+	defer func() { util.EndSpan(span, err) }()
+
+	if err := dw.ValidateDefaultRest(restSec); err != nil {
+		return err
 	}
+	return i.repo.SetDefaultRest(ctx, userID, exerciseID, restSec)
+}
 
-	// ULID型に変換
-	ownerULID := dw.ULID(userID)
+// SetExerciseTrainingMax は種目のトレーニングマックス（kg）を設定する（nil で解除）
+func (i *workoutInteractor) SetExerciseTrainingMax(ctx context.Context, userID string, exerciseID int64, weightKg *float64) (err error) {
+	ctx, span := tracer.Start(ctx, "WorkoutUseCase.SetExerciseTrainingMax")
+	defer func() { util.EndSpan(span, err) }()
+
+	if err := dw.ValidateTrainingMax(weightKg); err != nil {
+		return err
+	}
+	return i.repo.SetTrainingMax(ctx, userID, exerciseID, weightKg)
+}
+
+// GetRestSummary は期間内のセット間の休憩を種目ごとに平均し、設定した休憩と比べて返す
+func (i *workoutInteractor) GetRestSummary(ctx context.Context, userID string, from, to time.Time, locale string) (_ dto.RestSummaryDTO, err error) {
+	ctx, span := tracer.Start(ctx, "WorkoutUseCase.GetRestSummary")
+	defer func() { util.EndSpan(span, err) }()
+
+	records, err := i.repo.GetRecordsInRange(ctx, userID, from, to)
+	if err != nil {
+		return dto.RestSummaryDTO{}, err
+	}
+	restDefaults, err := i.repo.GetDefaultRests(ctx, userID)
+	if err != nil {
+		return dto.RestSummaryDTO{}, err
+	}
 
-	// 6部位のシードデータを作成（多言語対応）
-	defaultParts := []dw.WorkoutPart{
-		{
-			Key:   "chest",
-			Owner: &ownerULID,
-			Translations: []dw.WorkoutPartTranslation{
-				{Locale: "ja", Name: "胸"},
-				{Locale: "en", Name: "Chest"},
-			},
-		},
-		{
-			Key:   "shoulders",
-			Owner: &ownerULID,
-			Translations: []dw.WorkoutPartTranslation{
-				{Locale: "ja", Name: "肩"},
-				{Locale: "en", Name: "Shoulders"},
-			},
-		},
-		{
-			Key:   "back",
-			Owner: &ownerULID,
-			Translations: []dw.WorkoutPartTranslation{
-				{Locale: "ja", Name: "背中"},
-				{Locale: "en", Name: "Back"},
-			},
-		},
-		{
-			Key:   "arms",
-			Owner: &ownerULID,
-			Translations: []dw.WorkoutPartTranslation{
-				{Locale: "ja", Name: "腕"},
-				{Locale: "en", Name: "Arms"},
-			},
-		},
-		{
-			Key:   "legs",
-			Owner: &ownerULID,
-			Translations: []dw.WorkoutPartTranslation{
-				{Locale: "ja", Name: "脚"},
-				{Locale: "en", Name: "Legs"},
-			},
-		},
-		{
-			Key:   "others",
-			Owner: &ownerULID,
-			Translations: []dw.WorkoutPartTranslation{
-				{Locale: "ja", Name: "その他"},
-				{Locale: "en", Name: "Others"},
-			},
-		},
+	summaries := dw.SummarizeRest(records)
+	for idx := range summaries {
+		if sec, ok := restDefaults[summaries[idx].Exercise.ID]; ok {
+			summaries[idx].Exercise.DefaultRestSec = &sec
+		}
 	}
 
-	return i.repo.CreateWorkoutParts(ctx, userID, defaultParts)
+	return dto.RestSummariesToDTO(util.FormatDate(from), util.FormatDate(to), summaries, locale), nil
 }
 
-func (i *workoutInteractor) CreateWorkoutExercise(ctx context.Context, userID string, exercises []dto.CreateWorkoutExerciseItem) error {
+func (i *workoutInteractor) CreateWorkoutExercise(ctx context.Context, userID string, exercises []dto.CreateWorkoutExerciseItem) (err error) {
+	ctx, span := tracer.Start(ctx, "WorkoutUseCase.CreateWorkoutExercise")
+	defer func() { util.EndSpan(span, err) }()
+
 	ownerULID := dw.ULID(userID)
 
 	// DTOをドメインモデルに変換
@@ -152,9 +212,18 @@ func (i *workoutInteractor) CreateWorkoutExercise(ctx context.Context, userID st
 	for _, ex := range exercises {
 		partID := dw.ID(ex.WorkoutPartID)
 		exerciseRef := dw.WorkoutExerciseRef{
-			Name:   ex.Name,
-			PartID: &partID,
-			Owner:  &ownerULID, // ユーザー作成の種目なのでOwnerを設定
+			Name:             strings.TrimSpace(ex.Name),
+			PartID:           &partID,
+			Owner:            &ownerULID, // ユーザー作成の種目なのでOwnerを設定
+			PrimaryMuscles:   toMuscles(ex.PrimaryMuscles),
+			SecondaryMuscles: toMuscles(ex.SecondaryMuscles),
+			SecondaryParts:   ex.SecondaryParts,
+			Equipment:        dw.Equipment(ex.Equipment),
+			MovementPattern:  dw.MovementPattern(ex.MovementPattern),
+			Unilateral:       ex.Unilateral,
+		}
+		if err := exerciseRef.Validate(); err != nil {
+			return err
 		}
 
 		// IDがある場合は設定（update対象）
@@ -168,11 +237,17 @@ func (i *workoutInteractor) CreateWorkoutExercise(ctx context.Context, userID st
 	return i.repo.UpsertWorkoutExercises(ctx, userID, domainExercises)
 }
 
-func (i *workoutInteractor) DeleteWorkoutExercise(ctx context.Context, userID string, exerciseID int64) error {
+func (i *workoutInteractor) DeleteWorkoutExercise(ctx context.Context, userID string, exerciseID int64) (err error) {
+	ctx, span := tracer.Start(ctx, "WorkoutUseCase.DeleteWorkoutExercise")
+	defer func() { util.EndSpan(span, err) }()
+
 	return i.repo.DeleteWorkoutExercise(ctx, userID, exerciseID)
 }
 
-func (i *workoutInteractor) GetLastWorkoutRecord(ctx context.Context, userID string, exerciseID int64) (*dto.ExerciseDTO, error) {
+func (i *workoutInteractor) GetLastWorkoutRecord(ctx context.Context, userID string, exerciseID int64, locale string, zone util.TimeZone, units dom.WeightUnits) (_ *dto.ExerciseDTO, err error) {
+	ctx, span := tracer.Start(ctx, "WorkoutUseCase.GetLastWorkoutRecord")
+	defer func() { util.EndSpan(span, err) }()
+
 	// 最後のワークアウトレコードを取得
 	record, err := i.repo.GetLastWorkoutRecord(ctx, userID, exerciseID)
 	if err != nil {
@@ -185,65 +260,56 @@ func (i *workoutInteractor) GetLastWorkoutRecord(ctx context.Context, userID str
 	}
 
 	// 該当するエクササイズIDのセットだけをフィルタリング
-	var exerciseSets []dw.WorkoutSet
-	var exerciseName string
-	var workoutPartID *int64
-
-	for _, set := range record.Sets {
-		if int64(set.Exercise.ID) == exerciseID {
-			exerciseSets = append(exerciseSets, set)
-			if exerciseName == "" {
-				exerciseName = set.Exercise.Name
-				if set.Exercise.PartID != nil {
-					partID := int64(*set.Exercise.PartID)
-					workoutPartID = &partID
-				}
-			}
-		}
-	}
+	exerciseSets := record.SetsOf(dw.ID(exerciseID))
 
 	// 該当するセットが見つからない場合
 	if len(exerciseSets) == 0 {
 		return nil, nil
 	}
 
+	exercise := exerciseSets[0].Exercise
+	var workoutPartID *int64
+	if exercise.PartID != nil {
+		partID := int64(*exercise.PartID)
+		workoutPartID = &partID
+	}
+
 	// ExerciseDTOに変換して返す
+	unitsDTO := dto.UnitsToDTO(units)
 	exerciseDTO := dto.ExerciseDTO{
 		ID:            &exerciseID,
-		Name:          exerciseName,
+		Name:          exercise.LocalizedName(locale),
+		Translations:  dto.ExerciseTranslationsToDTO(exercise.Translations),
 		WorkoutPartID: workoutPartID,
 		Sets:          []dto.SetDTO{},
+		Units:         &unitsDTO,
 	}
 
 	// セット情報を追加
 	for _, set := range exerciseSets {
-		var setID *int64
-		if set.ID != nil {
-			id := int64(*set.ID)
-			setID = &id
-		}
-
-		weight := float64(set.Weight)
-		reps := int(set.Reps)
-
-		exerciseDTO.Sets = append(exerciseDTO.Sets, dto.SetDTO{
-			ID:        setID,
-			SetNumber: set.SetNumber,
-			WeightKg:  &weight,
-			Reps:      &reps,
-			Note:      set.Note,
-		})
+		exerciseDTO.Sets = append(exerciseDTO.Sets, dto.SetToDTO(set, zone, units))
 	}
 
 	return &exerciseDTO, nil
 }
 
+func toMuscles(values []string) []dw.Muscle {
+	muscles := make([]dw.Muscle, 0, len(values))
+	for _, v := range values {
+		muscles = append(muscles, dw.Muscle(v))
+	}
+	return muscles
+}
+
 // ResolveGymIDFromName resolves gym_name to gym_id (finds or creates)
-func (i *workoutInteractor) ResolveGymIDFromName(ctx context.Context, userID string, gymName string) (dw.ID, error) {
+func (i *workoutInteractor) ResolveGymIDFromName(ctx context.Context, userID string, gymName string) (_ dw.ID, err error) {
+	ctx, span := tracer.Start(ctx, "WorkoutUseCase.ResolveGymIDFromName")
+	defer func() { util.EndSpan(span, err) }()
+
 	// Normalize gym name
 	normalizedName := dg.NormalizeName(gymName)
 	if normalizedName == "" {
-		return 0, errors.New("gym name cannot be empty")
+		return 0, fmt.Errorf("%w: gym name cannot be empty", dg.ErrInvalidGymName)
 	}
 
 	// Try to find existing gym
@@ -263,3 +329,28 @@ func (i *workoutInteractor) ResolveGymIDFromName(ctx context.Context, userID str
 
 	return 0, err
 }
+
+// SuggestProgression は直近 cfg.Sessions 件のセッションを分析し、次の重量・回数を提案する
+// 幅の指定がなければ、ユーザーのプレートの丸め幅（設定している場合と lb の場合）を器具ごとの既定値より優先する
+func (i *workoutInteractor) SuggestProgression(ctx context.Context, userID string, exerciseID int64, cfg dw.ProgressionConfig, locale string, units dom.WeightUnits) (_ dto.ProgressionSuggestionDTO, err error) {
+	ctx, span := tracer.Start(ctx, "WorkoutUseCase.SuggestProgression")
+	defer func() { util.EndSpan(span, err) }()
+
+	if cfg.Increment == nil {
+		if inc, ok := units.PlateIncrementKg(); ok {
+			step := dw.WeightKg(inc)
+			cfg.Increment = &step
+		}
+	}
+	if err := cfg.Validate(); err != nil {
+		return dto.ProgressionSuggestionDTO{}, err
+	}
+
+	records, err := i.repo.GetRecentWorkoutRecords(ctx, userID, exerciseID, cfg.Sessions)
+	if err != nil {
+		return dto.ProgressionSuggestionDTO{}, err
+	}
+
+	suggestion := dw.SuggestProgression(dw.ID(exerciseID), records, cfg)
+	return dto.ProgressionSuggestionToDTO(exerciseID, suggestion, locale, units), nil
+}
@@ -2,65 +2,257 @@ package workout
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
+	"gogym-api/internal/adapter/dto"
 	dom "gogym-api/internal/domain/entities"
+	dg "gogym-api/internal/domain/entities/gym"
 	dw "gogym-api/internal/domain/entities/workout"
+	"gogym-api/internal/util"
 )
 
-func TestWorkoutInteractor_SeedWorkoutParts(t *testing.T) {
+func TestWorkoutInteractor_GetWorkoutParts(t *testing.T) {
 	t.Parallel()
 
-	ctrl := gomock.NewController(t)
-	t.Cleanup(ctrl.Finish)
+	ctx := context.Background()
+	userID := "01FGZ9K6TV3J5ZZZQX6Z9X6K7W" // ULID
+	owner := dw.ULID(userID)
+	chestID := dom.ID(1)
+
+	// プリセットの胸、同じキーのユーザー部位、プリセットにないユーザー部位
+	parts := []dw.WorkoutPart{
+		{
+			ID:  chestID,
+			Key: "chest",
+			Translations: []dw.WorkoutPartTranslation{
+				{Locale: "ja", Name: "胸"},
+				{Locale: "en", Name: "Chest"},
+			},
+			Exercises: []dw.WorkoutExerciseRef{
+				{
+					ID: 10, Key: "bench_press", Name: "ベンチプレス", PartID: &chestID,
+					Translations: []dw.WorkoutExerciseTranslation{
+						{Locale: "ja", Name: "ベンチプレス"},
+						{Locale: "en", Name: "Bench Press"},
+					},
+				},
+				{ID: 11, Key: "pec_deck", Name: "ペックデック", PartID: &chestID, Hidden: true},
+			},
+		},
+		{
+			ID:        100,
+			Key:       "chest",
+			Owner:     &owner,
+			Exercises: []dw.WorkoutExerciseRef{{ID: 20, Name: "マイベンチ", Owner: &owner}},
+		},
+		{
+			ID:        101,
+			Key:       "cardio",
+			Owner:     &owner,
+			Exercises: []dw.WorkoutExerciseRef{{ID: 21, Name: "ランニング", Owner: &owner}},
+		},
+	}
+
+	t.Run("正常系: ユーザーの種目を同じキーのプリセット部位にまとめ、非表示の種目を除く・名前はロケールで解決する", func(t *testing.T) {
+		t.Parallel()
 
-	repo := NewMockRepository(ctrl)
+		ctrl := gomock.NewController(t)
+		repo := NewMockRepository(ctrl)
+		uc := NewWorkoutInteractor(repo, nil, nil, nil)
+
+		repo.EXPECT().GetWorkoutParts(gomock.Any(), userID).Return(parts, nil)
+
+		got, err := uc.GetWorkoutParts(ctx, userID, WorkoutPartsFilter{}, "en", dom.WeightUnits{})
+		require.NoError(t, err)
+		require.Len(t, got, 2)
+
+		require.Equal(t, "chest", got[0].Key)
+		require.Equal(t, "Chest", got[0].Name)
+		require.Equal(t, "preset", got[0].Source)
+		require.Len(t, got[0].Exercises, 2)
+		require.Equal(t, "bench_press", got[0].Exercises[0].Key)
+		require.Equal(t, "Bench Press", got[0].Exercises[0].Name)
+		require.Len(t, got[0].Exercises[0].Translations, 2)
+		require.Equal(t, "preset", got[0].Exercises[0].Source)
+		// 翻訳のないユーザー作成の種目は入力された名前のまま
+		require.Equal(t, "マイベンチ", got[0].Exercises[1].Name)
+		require.Equal(t, "custom", got[0].Exercises[1].Source)
+
+		require.Equal(t, "cardio", got[1].Key)
+		require.Equal(t, "cardio", got[1].Name)
+		require.Equal(t, "custom", got[1].Source)
+	})
+
+	t.Run("正常系: include_hidden の場合は非表示の種目も返す", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		repo := NewMockRepository(ctrl)
+		uc := NewWorkoutInteractor(repo, nil, nil, nil)
+
+		repo.EXPECT().GetWorkoutParts(gomock.Any(), userID).Return(parts, nil)
+
+		got, err := uc.GetWorkoutParts(ctx, userID, WorkoutPartsFilter{IncludeHidden: true}, "ja", dom.WeightUnits{})
+		require.NoError(t, err)
+		require.Len(t, got[0].Exercises, 3)
+		require.Equal(t, "胸", got[0].Name)
+		require.Equal(t, "ベンチプレス", got[0].Exercises[0].Name)
+		require.True(t, got[0].Exercises[1].Hidden)
+	})
 
-	uc := NewWorkoutInteractor(repo, nil)
+	t.Run("正常系: 器具・動作パターン・筋肉・片側で絞り込み、種目がなくなった部位も残す", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		repo := NewMockRepository(ctrl)
+		uc := NewWorkoutInteractor(repo, nil, nil, nil)
+
+		legsID := dom.ID(2)
+		filtered := []dw.WorkoutPart{
+			{
+				ID:  chestID,
+				Key: "chest",
+				Exercises: []dw.WorkoutExerciseRef{
+					{
+						ID: 10, Key: "bench_press", Name: "ベンチプレス", PartID: &chestID,
+						Equipment: dw.EquipmentBarbell, MovementPattern: dw.MovementHorizontalPush,
+						PrimaryMuscles: []dw.Muscle{dw.MuscleChest}, SecondaryMuscles: []dw.Muscle{dw.MuscleTriceps},
+					},
+					{
+						ID: 12, Key: "dumbbell_press", Name: "ダンベルプレス", PartID: &chestID,
+						Equipment: dw.EquipmentDumbbell, MovementPattern: dw.MovementHorizontalPush,
+						PrimaryMuscles: []dw.Muscle{dw.MuscleChest},
+					},
+				},
+			},
+			{
+				ID:  legsID,
+				Key: "legs",
+				Exercises: []dw.WorkoutExerciseRef{
+					{
+						ID: 30, Key: "bulgarian_split_squat", Name: "ブルガリアンスクワット", PartID: &legsID,
+						Equipment: dw.EquipmentDumbbell, MovementPattern: dw.MovementLunge, Unilateral: true,
+						PrimaryMuscles: []dw.Muscle{dw.MuscleQuads},
+					},
+				},
+			},
+		}
+		repo.EXPECT().GetWorkoutParts(gomock.Any(), userID).Return(filtered, nil).Times(2)
+
+		unilateral := true
+		got, err := uc.GetWorkoutParts(ctx, userID, WorkoutPartsFilter{
+			Equipment:  []dw.Equipment{dw.EquipmentDumbbell},
+			Unilateral: &unilateral,
+		}, "ja", dom.WeightUnits{})
+		require.NoError(t, err)
+		require.Len(t, got, 2)
+		require.Empty(t, got[0].Exercises)
+		require.Len(t, got[1].Exercises, 1)
+		require.Equal(t, "bulgarian_split_squat", got[1].Exercises[0].Key)
+		require.True(t, got[1].Exercises[0].Unilateral)
+
+		// 補助筋として使う種目も筋肉の絞り込みに一致する
+		got, err = uc.GetWorkoutParts(ctx, userID, WorkoutPartsFilter{
+			MovementPatterns: []dw.MovementPattern{dw.MovementHorizontalPush},
+			Muscles:          []dw.Muscle{dw.MuscleTriceps},
+		}, "ja", dom.WeightUnits{})
+		require.NoError(t, err)
+		require.Len(t, got[0].Exercises, 1)
+		require.Equal(t, "bench_press", got[0].Exercises[0].Key)
+		require.Equal(t, "horizontal_push", got[0].Exercises[0].MovementPattern)
+		require.Empty(t, got[1].Exercises)
+	})
+}
+
+func TestWorkoutInteractor_CreateWorkoutExercise(t *testing.T) {
+	t.Parallel()
 
 	ctx := context.Background()
 	userID := "01FGZ9K6TV3J5ZZZQX6Z9X6K7W" // ULID
 
-	t.Run("正常系: 部位が未登録の場合、シードデータを登録する", func(t *testing.T) {
+	t.Run("正常系: メタデータ付きの種目を保存する", func(t *testing.T) {
 		t.Parallel()
 
-		repo.EXPECT().
-			CountUserWorkoutParts(gomock.Any(), userID).
-			Return(int64(0), nil)
+		ctrl := gomock.NewController(t)
+		repo := NewMockRepository(ctrl)
+		uc := NewWorkoutInteractor(repo, nil, nil, nil)
 
 		repo.EXPECT().
-			CreateWorkoutParts(gomock.Any(), userID, gomock.Any()).
-			DoAndReturn(func(ctx context.Context, _ string, parts []dw.WorkoutPart) error {
-				require.Len(t, parts, 6)
-
-				keys := []string{parts[0].Key, parts[1].Key, parts[2].Key, parts[3].Key, parts[4].Key, parts[5].Key}
-				require.Equal(t, []string{"chest", "shoulders", "back", "arms", "legs", "others"}, keys)
-
-				for _, p := range parts {
-					require.NotNil(t, p.Owner)
-					require.Len(t, p.Translations, 2)
-				}
+			UpsertWorkoutExercises(gomock.Any(), userID, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, exercises []dw.WorkoutExerciseRef) error {
+				require.Len(t, exercises, 1)
+				require.Equal(t, "片手ロウ", exercises[0].Name)
+				require.Equal(t, dw.EquipmentDumbbell, exercises[0].Equipment)
+				require.Equal(t, dw.MovementHorizontalPull, exercises[0].MovementPattern)
+				require.Equal(t, []string{"arms"}, exercises[0].SecondaryParts)
+				require.True(t, exercises[0].Unilateral)
 				return nil
 			})
 
-		err := uc.SeedWorkoutParts(ctx, userID)
+		err := uc.CreateWorkoutExercise(ctx, userID, []dto.CreateWorkoutExerciseItem{{
+			Name:            " 片手ロウ ",
+			WorkoutPartID:   2,
+			Equipment:       "dumbbell",
+			MovementPattern: "horizontal_pull",
+			PrimaryMuscles:  []string{"lats"},
+			SecondaryParts:  []string{"arms"},
+			Unilateral:      true,
+		}})
 		require.NoError(t, err)
 	})
 
-	t.Run("正常系: 部位が既に登録されている場合、何もしない", func(t *testing.T) {
+	t.Run("異常系: 不正なメタデータの場合はErrInvalidExerciseを返し保存しない", func(t *testing.T) {
 		t.Parallel()
 
-		repo.EXPECT().
-			CountUserWorkoutParts(gomock.Any(), userID).
-			Return(int64(6), nil)
+		ctrl := gomock.NewController(t)
+		repo := NewMockRepository(ctrl)
+		uc := NewWorkoutInteractor(repo, nil, nil, nil)
 
-		// CreateWorkoutPartsは呼ばれないはず（モックなし）
+		err := uc.CreateWorkoutExercise(ctx, userID, []dto.CreateWorkoutExerciseItem{{
+			Name:            "謎の種目",
+			WorkoutPartID:   2,
+			MovementPattern: "teleport",
+		}})
+		require.ErrorIs(t, err, dw.ErrInvalidExercise)
+	})
+}
 
-		err := uc.SeedWorkoutParts(ctx, userID)
-		require.NoError(t, err)
+func TestWorkoutInteractor_SyncPresetCatalog(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("正常系: カタログのプリセットをリポジトリに同期する", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		repo := NewMockRepository(ctrl)
+		catalog := NewMockPresetCatalog(ctrl)
+		uc := NewWorkoutInteractor(repo, nil, catalog, nil)
+
+		presets := []dw.WorkoutPart{{Key: "chest"}, {Key: "back"}}
+		catalog.EXPECT().Presets().Return(presets, nil)
+		repo.EXPECT().SyncPresets(gomock.Any(), presets).Return(nil)
+
+		require.NoError(t, uc.SyncPresetCatalog(ctx))
+	})
+
+	t.Run("異常系: カタログの読み込みに失敗した場合は同期しない", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		repo := NewMockRepository(ctrl)
+		catalog := NewMockPresetCatalog(ctrl)
+		uc := NewWorkoutInteractor(repo, nil, catalog, nil)
+
+		catalog.EXPECT().Presets().Return(nil, errors.New("broken catalog"))
+
+		require.Error(t, uc.SyncPresetCatalog(ctx))
 	})
 }
 
@@ -72,7 +264,7 @@ func TestWorkoutInteractor_GetLastWorkoutRecord(t *testing.T) {
 
 	repo := NewMockRepository(ctrl)
 
-	uc := NewWorkoutInteractor(repo, nil)
+	uc := NewWorkoutInteractor(repo, nil, nil, nil)
 
 	ctx := context.Background()
 	userID := "01FGZ9K6TV3J5ZZZQX6Z9X6K7W" // ULID
@@ -85,7 +277,7 @@ func TestWorkoutInteractor_GetLastWorkoutRecord(t *testing.T) {
 			GetLastWorkoutRecord(gomock.Any(), userID, exerciseID).
 			Return(dw.WorkoutRecord{}, nil)
 
-		record, err := uc.GetLastWorkoutRecord(ctx, userID, exerciseID)
+		record, err := uc.GetLastWorkoutRecord(ctx, userID, exerciseID, "ja", util.TimeZone{}, dom.WeightUnits{})
 		require.NoError(t, err)
 		require.Nil(t, record)
 	})
@@ -101,7 +293,7 @@ func TestWorkoutInteractor_GetLastWorkoutRecord(t *testing.T) {
 				Sets: nil,
 			}, nil)
 
-		record, err := uc.GetLastWorkoutRecord(ctx, userID, exerciseID)
+		record, err := uc.GetLastWorkoutRecord(ctx, userID, exerciseID, "ja", util.TimeZone{}, dom.WeightUnits{})
 		require.NoError(t, err)
 		require.Nil(t, record)
 	})
@@ -145,7 +337,7 @@ func TestWorkoutInteractor_GetLastWorkoutRecord(t *testing.T) {
 			GetLastWorkoutRecord(gomock.Any(), userID, exerciseID).
 			Return(domainRecord, nil)
 
-		result, err := uc.GetLastWorkoutRecord(ctx, userID, exerciseID)
+		result, err := uc.GetLastWorkoutRecord(ctx, userID, exerciseID, "ja", util.TimeZone{}, dom.WeightUnits{})
 		require.NoError(t, err)
 		require.NotNil(t, result)
 
@@ -163,7 +355,409 @@ func TestWorkoutInteractor_GetLastWorkoutRecord(t *testing.T) {
 	})
 }
 
+func TestWorkoutInteractor_GetWorkoutRecords(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := NewMockRepository(ctrl)
+
+	uc := NewWorkoutInteractor(repo, nil, nil, nil)
+
+	ctx := context.Background()
+	userID := "01FGZ9K6TV3J5ZZZQX6Z9X6K7W" // ULID
+	date := time.Date(2025, 11, 25, 0, 0, 0, 0, time.UTC)
+
+	t.Run("正常系: 同日の複数セッションを一覧で返す", func(t *testing.T) {
+		t.Parallel()
+
+		morning := time.Date(2025, 11, 25, 7, 0, 0, 0, time.UTC)
+		evening := time.Date(2025, 11, 25, 19, 0, 0, 0, time.UTC)
+		repo.EXPECT().
+			GetRecordsByDate(gomock.Any(), "multi", date).
+			Return([]dw.WorkoutRecord{
+				{ID: ptrID(1), PerformedDate: date, StartedAt: &morning, GymID: ptrID(10)},
+				{ID: ptrID(2), PerformedDate: date, StartedAt: &evening, GymID: ptrID(20), Condition: dw.Cond4},
+			}, nil)
+
+		result, err := uc.GetWorkoutRecords(ctx, "multi", date, "ja", util.TimeZone{}, dom.WeightUnits{})
+		require.NoError(t, err)
+		require.Equal(t, "2025-11-25", result.PerformedDate)
+		require.Len(t, result.Records, 2)
+		require.Equal(t, int64(1), *result.Records[0].ID)
+		require.Equal(t, int64(10), *result.Records[0].GymID)
+		require.Equal(t, int64(2), *result.Records[1].ID)
+		require.Equal(t, int64(20), *result.Records[1].GymID)
+		require.Equal(t, 4, *result.Records[1].ConditionLevel)
+	})
+
+	t.Run("正常系: 記録がない日は空の一覧を返す", func(t *testing.T) {
+		t.Parallel()
+
+		repo.EXPECT().
+			GetRecordsByDate(gomock.Any(), userID, date).
+			Return(nil, nil)
+
+		result, err := uc.GetWorkoutRecords(ctx, userID, date, "ja", util.TimeZone{}, dom.WeightUnits{})
+		require.NoError(t, err)
+		require.Equal(t, "2025-11-25", result.PerformedDate)
+		require.NotNil(t, result.Records)
+		require.Empty(t, result.Records)
+	})
+}
+
+func TestWorkoutInteractor_UpdateWorkoutRecord(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := NewMockRepository(ctrl)
+	metrics := NewMockMetrics(ctrl)
+
+	uc := NewWorkoutInteractor(repo, nil, nil, metrics)
+
+	ctx := context.Background()
+
+	t.Run("異常系: IDが指定されていない場合、ErrRecordNotFoundを返す", func(t *testing.T) {
+		t.Parallel()
+
+		err := uc.UpdateWorkoutRecord(ctx, dw.WorkoutRecord{UserID: "01FGZ9K6TV3J5ZZZQX6Z9X6K7W"})
+		require.ErrorIs(t, err, dw.ErrRecordNotFound)
+	})
+
+	t.Run("正常系: IDで指定したセッションを更新し、保存を記録する", func(t *testing.T) {
+		t.Parallel()
+
+		record := dw.WorkoutRecord{ID: ptrID(5), UserID: "01FGZ9K6TV3J5ZZZQX6Z9X6K7W"}
+		repo.EXPECT().
+			UpdateWorkoutRecord(gomock.Any(), record).
+			Return(nil)
+		metrics.EXPECT().WorkoutSaved()
+
+		require.NoError(t, uc.UpdateWorkoutRecord(ctx, record))
+	})
+}
+
+func TestWorkoutInteractor_ResolveGymIDFromName(t *testing.T) {
+	t.Parallel()
+
+	uc := NewWorkoutInteractor(nil, nil, nil, nil)
+
+	t.Run("異常系: 空白だけのジム名は入力エラー（ErrInvalidGymName）を返す", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := uc.ResolveGymIDFromName(context.Background(), "01FGZ9K6TV3J5ZZZQX6Z9X6K7W", "   ")
+		require.ErrorIs(t, err, dg.ErrInvalidGymName)
+
+		var derr *dom.Error
+		require.ErrorAs(t, err, &derr)
+		require.Equal(t, dom.KindValidation, derr.Kind)
+	})
+}
+
 func ptrID(v int64) *dom.ID {
 	id := dom.ID(v)
 	return &id
 }
+
+func TestWorkoutInteractor_GetRestSummary(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	userID := "01FGZ9K6TV3J5ZZZQX6Z9X6K7W" // ULID
+	from := time.Date(2025, 11, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 11, 30, 0, 0, 0, 0, time.UTC)
+
+	t.Run("正常系: 完了時刻の差から休憩を求め、種目ごとの平均を設定した休憩と比べる", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		repo := NewMockRepository(ctrl)
+		uc := NewWorkoutInteractor(repo, nil, nil, nil)
+
+		bench := dw.WorkoutExerciseRef{ID: 10, Name: "ベンチプレス"}
+		squat := dw.WorkoutExerciseRef{ID: 20, Name: "スクワット"}
+		at := func(min, sec int) *time.Time {
+			t := time.Date(2025, 11, 25, 10, min, sec, 0, time.UTC)
+			return &t
+		}
+		record := dw.WorkoutRecord{
+			ID: ptrID(1),
+			Sets: []dw.WorkoutSet{
+				// セット番号順に並んでいなくても同じ種目の直前のセットと比べる
+				{Exercise: bench, SetNumber: 2, CompletedAt: at(3, 0)},
+				{Exercise: bench, SetNumber: 1, CompletedAt: at(0, 0)},
+				{Exercise: bench, SetNumber: 3, CompletedAt: at(7, 0)},
+				{Exercise: squat, SetNumber: 1, CompletedAt: at(10, 0)},
+				{Exercise: squat, SetNumber: 2},                         // 完了時刻なし
+				{Exercise: squat, SetNumber: 3, CompletedAt: at(14, 0)}, // 直前のセットに時刻がないので休憩なし
+			},
+		}
+		record.DeriveRest()
+
+		repo.EXPECT().GetRecordsInRange(gomock.Any(), userID, from, to).Return([]dw.WorkoutRecord{record}, nil)
+		repo.EXPECT().GetDefaultRests(gomock.Any(), userID).Return(map[dw.ID]int{10: 180}, nil)
+
+		got, err := uc.GetRestSummary(ctx, userID, from, to, "ja")
+		require.NoError(t, err)
+		require.Len(t, got.Exercises, 1)
+		require.Equal(t, int64(10), got.Exercises[0].ExerciseID)
+		require.Equal(t, 210, got.Exercises[0].AverageRestSec) // (180 + 240) / 2
+		require.Equal(t, 2, got.Exercises[0].SampleCount)
+		require.Equal(t, 180, *got.Exercises[0].DefaultRestSec)
+		require.True(t, got.Exercises[0].OverDefault)
+	})
+}
+
+func TestWorkoutInteractor_SetExerciseDefaultRest(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	userID := "01FGZ9K6TV3J5ZZZQX6Z9X6K7W" // ULID
+
+	t.Run("正常系: 休憩の設定と解除をリポジトリに渡す", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		repo := NewMockRepository(ctrl)
+		uc := NewWorkoutInteractor(repo, nil, nil, nil)
+
+		rest := 90
+		repo.EXPECT().SetDefaultRest(gomock.Any(), userID, int64(10), &rest).Return(nil)
+		repo.EXPECT().SetDefaultRest(gomock.Any(), userID, int64(10), nil).Return(nil)
+
+		require.NoError(t, uc.SetExerciseDefaultRest(ctx, userID, 10, &rest))
+		require.NoError(t, uc.SetExerciseDefaultRest(ctx, userID, 10, nil))
+	})
+
+	t.Run("異常系: 範囲外の休憩はErrInvalidExerciseを返す", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		repo := NewMockRepository(ctrl)
+		uc := NewWorkoutInteractor(repo, nil, nil, nil)
+
+		rest := -1
+		require.ErrorIs(t, uc.SetExerciseDefaultRest(ctx, userID, 10, &rest), dw.ErrInvalidExercise)
+	})
+}
+
+func TestWorkoutInteractor_SetExerciseTrainingMax(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	userID := "01FGZ9K6TV3J5ZZZQX6Z9X6K7W" // ULID
+
+	t.Run("正常系: トレーニングマックスの設定と解除をリポジトリに渡す", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		repo := NewMockRepository(ctrl)
+		uc := NewWorkoutInteractor(repo, nil, nil, nil)
+
+		tm := 102.5
+		repo.EXPECT().SetTrainingMax(gomock.Any(), userID, int64(10), &tm).Return(nil)
+		repo.EXPECT().SetTrainingMax(gomock.Any(), userID, int64(10), nil).Return(nil)
+
+		require.NoError(t, uc.SetExerciseTrainingMax(ctx, userID, 10, &tm))
+		require.NoError(t, uc.SetExerciseTrainingMax(ctx, userID, 10, nil))
+	})
+
+	t.Run("異常系: 0以下のトレーニングマックスはErrInvalidExerciseを返す", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		repo := NewMockRepository(ctrl)
+		uc := NewWorkoutInteractor(repo, nil, nil, nil)
+
+		tm := 0.0
+		require.ErrorIs(t, uc.SetExerciseTrainingMax(ctx, userID, 10, &tm), dw.ErrInvalidExercise)
+	})
+}
+
+func TestWorkoutInteractor_SuggestProgression(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	userID := "01FGZ9K6TV3J5ZZZQX6Z9X6K7W" // ULID
+	bench := dw.WorkoutExerciseRef{ID: 10, Name: "ベンチプレス", Equipment: dw.EquipmentBarbell}
+
+	// session は1種目分のセッションを作る（新しい順に並べて使う）
+	session := func(ex dw.WorkoutExerciseRef, date string, kind dw.SetKind, weight dw.WeightKg, reps ...dw.Reps) dw.WorkoutRecord {
+		d, _ := time.Parse("2006-01-02", date)
+		r := dw.WorkoutRecord{PerformedDate: d}
+		r.Sets = append(r.Sets, dw.WorkoutSet{Exercise: ex, SetNumber: 1, Type: dw.SetTypeWarmup, Kind: kind, Weight: weight / 2, Reps: 10})
+		for i, n := range reps {
+			r.Sets = append(r.Sets, dw.WorkoutSet{Exercise: ex, SetNumber: i + 2, Kind: kind, Weight: weight, Reps: n})
+		}
+		return r
+	}
+	suggestIn := func(t *testing.T, units dom.WeightUnits, cfg dw.ProgressionConfig, records ...dw.WorkoutRecord) dto.ProgressionSuggestionDTO {
+		ctrl := gomock.NewController(t)
+		repo := NewMockRepository(ctrl)
+		uc := NewWorkoutInteractor(repo, nil, nil, nil)
+
+		repo.EXPECT().GetRecentWorkoutRecords(gomock.Any(), userID, int64(10), cfg.Sessions).Return(records, nil)
+
+		got, err := uc.SuggestProgression(ctx, userID, 10, cfg, "ja", units)
+		require.NoError(t, err)
+		return got
+	}
+	suggest := func(t *testing.T, cfg dw.ProgressionConfig, records ...dw.WorkoutRecord) dto.ProgressionSuggestionDTO {
+		return suggestIn(t, dom.WeightUnits{}, cfg, records...)
+	}
+
+	t.Run("正常系: ダブルプログレッションで全セットが上限に届いたら器具の幅だけ重量を上げる", func(t *testing.T) {
+		t.Parallel()
+
+		got := suggest(t, dw.DefaultProgressionConfig(),
+			session(bench, "2025-11-25", dw.SetKindWeightReps, 60, 12, 12, 12),
+			session(bench, "2025-11-21", dw.SetKindWeightReps, 60, 11, 10, 10),
+		)
+		require.Equal(t, "increase_weight", got.Rule)
+		require.Equal(t, 62.5, *got.WeightKg)
+		require.Equal(t, 8, *got.Reps)
+		require.Equal(t, 3, *got.Sets)
+		require.Equal(t, 2.5, got.IncrementKg)
+		require.Contains(t, got.Explanation, "62.5 kg")
+		require.Len(t, got.History, 2)
+		require.Equal(t, []int{12, 12, 12}, got.History[0].Reps) // ウォームアップは含めない
+	})
+
+	t.Run("正常系: 前回の重量が幅の単位からずれていても上げた重量は幅の倍数に丸める", func(t *testing.T) {
+		t.Parallel()
+
+		// 81.2kg + 2.5kg = 83.7kg はプレートで組めないため 82.5kg にする
+		got := suggest(t, dw.DefaultProgressionConfig(),
+			session(bench, "2025-11-25", dw.SetKindWeightReps, 81.2, 12, 12, 12),
+		)
+		require.Equal(t, "increase_weight", got.Rule)
+		require.Equal(t, 82.5, *got.WeightKg)
+
+		got = suggest(t, dw.DefaultProgressionConfig(),
+			session(bench, "2025-11-25", dw.SetKindWeightReps, 81.3, 12, 12, 12),
+		)
+		require.Equal(t, 85.0, *got.WeightKg)
+	})
+
+	t.Run("正常系: 上限に届いていなければ同じ重量で最少回数+1回を目指す", func(t *testing.T) {
+		t.Parallel()
+
+		got := suggest(t, dw.DefaultProgressionConfig(),
+			session(bench, "2025-11-25", dw.SetKindWeightReps, 60, 10, 9, 9),
+		)
+		require.Equal(t, "add_reps", got.Rule)
+		require.Equal(t, 60.0, *got.WeightKg)
+		require.Equal(t, 10, *got.Reps)
+	})
+
+	t.Run("正常系: 下限に届かなかったら同じ重量を繰り返し、続けて届かなければディロードする", func(t *testing.T) {
+		t.Parallel()
+
+		got := suggest(t, dw.DefaultProgressionConfig(),
+			session(bench, "2025-11-25", dw.SetKindWeightReps, 60, 8, 7, 6),
+			session(bench, "2025-11-21", dw.SetKindWeightReps, 57.5, 12, 12, 12),
+		)
+		require.Equal(t, "repeat", got.Rule)
+		require.Equal(t, 60.0, *got.WeightKg)
+
+		got = suggest(t, dw.DefaultProgressionConfig(),
+			session(bench, "2025-11-25", dw.SetKindWeightReps, 60, 8, 7, 6),
+			session(bench, "2025-11-21", dw.SetKindWeightReps, 60, 7, 7, 6),
+		)
+		require.Equal(t, "deload", got.Rule)
+		// 60kg の 10% 減 = 54kg → 2.5kg 単位に切り下げて 52.5kg
+		require.Equal(t, 52.5, *got.WeightKg)
+		require.Equal(t, 8, *got.Reps)
+		require.Contains(t, got.Explanation, "2セッション")
+	})
+
+	t.Run("正常系: リニアは目標回数をこなせば毎回上げ、幅を指定すればその幅を使う", func(t *testing.T) {
+		t.Parallel()
+
+		dumbbell := bench
+		dumbbell.Equipment = dw.EquipmentDumbbell
+		cfg := dw.DefaultProgressionConfig()
+		cfg.Strategy = dw.ProgressionLinear
+		cfg.RepsMin, cfg.RepsMax = 5, 5
+
+		got := suggest(t, cfg, session(dumbbell, "2025-11-25", dw.SetKindWeightReps, 20, 5, 5, 5))
+		require.Equal(t, "increase_weight", got.Rule)
+		require.Equal(t, 22.0, *got.WeightKg)
+
+		inc := dw.WeightKg(1)
+		cfg.Increment = &inc
+		got = suggest(t, cfg, session(dumbbell, "2025-11-25", dw.SetKindWeightReps, 20, 5, 5, 5))
+		require.Equal(t, 21.0, *got.WeightKg)
+	})
+
+	t.Run("正常系: lbのユーザーにはポンドのプレートの幅で上げた重量をlbでも返す", func(t *testing.T) {
+		t.Parallel()
+
+		lb := dom.WeightUnits{Unit: dom.WeightUnitLb}
+		// 135 lb = 61.23 kg
+		got := suggestIn(t, lb, dw.DefaultProgressionConfig(),
+			session(bench, "2025-11-25", dw.SetKindWeightReps, 61.23, 12, 12, 12),
+		)
+		require.Equal(t, "increase_weight", got.Rule)
+		require.Equal(t, 140.0, *got.Weight)
+		require.Equal(t, 63.5, *got.WeightKg)
+		require.Equal(t, 5.0, got.Increment)
+		require.Equal(t, "lb", got.Units.Unit)
+		require.Contains(t, got.Explanation, "140 lb")
+		require.Equal(t, 135.0, got.History[0].Weight)
+	})
+
+	t.Run("正常系: 補助付きは補助を減らし、自重の種目は回数で伸ばす", func(t *testing.T) {
+		t.Parallel()
+
+		machine := bench
+		machine.Equipment = dw.EquipmentMachine
+		got := suggest(t, dw.DefaultProgressionConfig(), session(machine, "2025-11-25", dw.SetKindAssisted, 20, 12, 12))
+		require.Equal(t, "increase_weight", got.Rule)
+		require.Equal(t, 15.0, *got.WeightKg)
+		require.Equal(t, "assisted", got.Kind)
+
+		got = suggest(t, dw.DefaultProgressionConfig(), session(machine, "2025-11-25", dw.SetKindAssisted, 5, 12, 12))
+		require.Equal(t, 0.0, *got.WeightKg)
+		require.Equal(t, "bodyweight", got.Kind)
+
+		pullUp := dw.WorkoutExerciseRef{ID: 10, Name: "懸垂", Equipment: dw.EquipmentBodyweight}
+		got = suggest(t, dw.DefaultProgressionConfig(), session(pullUp, "2025-11-25", dw.SetKindBodyweight, 0, 12, 12))
+		require.Equal(t, "add_reps", got.Rule)
+		require.Equal(t, 13, *got.Reps)
+	})
+
+	t.Run("正常系: 記録がない場合は重量なしで説明だけを返す", func(t *testing.T) {
+		t.Parallel()
+
+		got := suggest(t, dw.DefaultProgressionConfig())
+		require.Equal(t, "no_history", got.Rule)
+		require.Nil(t, got.WeightKg)
+		require.NotEmpty(t, got.Explanation)
+		require.Empty(t, got.History)
+	})
+
+	t.Run("異常系: 不正なルールはErrInvalidProgressionを返し記録を読まない", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		uc := NewWorkoutInteractor(NewMockRepository(ctrl), nil, nil, nil)
+
+		cases := map[string]func(*dw.ProgressionConfig){
+			"未定義の方式":       func(c *dw.ProgressionConfig) { c.Strategy = "wave" },
+			"回数の範囲が逆":      func(c *dw.ProgressionConfig) { c.RepsMin, c.RepsMax = 12, 8 },
+			"セッション数0":      func(c *dw.ProgressionConfig) { c.Sessions = 0 },
+			"分析数を超えるディロード": func(c *dw.ProgressionConfig) { c.DeloadAfterMisses = 4 },
+			"ディロード率0":      func(c *dw.ProgressionConfig) { c.DeloadPercent = 0 },
+		}
+		for name, mutate := range cases {
+			cfg := dw.DefaultProgressionConfig()
+			mutate(&cfg)
+			_, err := uc.SuggestProgression(ctx, userID, 10, cfg, "ja", dom.WeightUnits{})
+			require.ErrorIs(t, err, dw.ErrInvalidProgression, name)
+		}
+	})
+}
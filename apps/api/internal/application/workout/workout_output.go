@@ -8,13 +8,32 @@ import (
 )
 
 type Repository interface {
-	GetRecordsByDate(ctx context.Context, userID string, date time.Time) (dw.WorkoutRecord, error)
-	CreateWorkoutRecord(ctx context.Context, workout dw.WorkoutRecord) error
-	UpsertWorkoutRecord(ctx context.Context, workout dw.WorkoutRecord) error
+	GetRecordsByDate(ctx context.Context, userID string, date time.Time) ([]dw.WorkoutRecord, error)
+	GetRecordByID(ctx context.Context, userID string, recordID dw.ID) (dw.WorkoutRecord, error)
+	GetRecordsInRange(ctx context.Context, userID string, from, to time.Time) ([]dw.WorkoutRecord, error)
+	CreateWorkoutRecord(ctx context.Context, workout dw.WorkoutRecord) (dw.ID, error)
+	UpdateWorkoutRecord(ctx context.Context, workout dw.WorkoutRecord) error
 	GetWorkoutParts(ctx context.Context, userID string) ([]dw.WorkoutPart, error)
-	CreateWorkoutParts(ctx context.Context, userID string, parts []dw.WorkoutPart) error
-	CountUserWorkoutParts(ctx context.Context, userID string) (int64, error)
+	SyncPresets(ctx context.Context, parts []dw.WorkoutPart) error
+	HideExercise(ctx context.Context, userID string, exerciseID int64) error
+	UnhideExercise(ctx context.Context, userID string, exerciseID int64) error
+	GetDefaultRests(ctx context.Context, userID string) (map[dw.ID]int, error)
+	SetDefaultRest(ctx context.Context, userID string, exerciseID int64, restSec *int) error
+	GetTrainingMaxes(ctx context.Context, userID string) (map[dw.ID]float64, error)
+	SetTrainingMax(ctx context.Context, userID string, exerciseID int64, weightKg *float64) error
 	UpsertWorkoutExercises(ctx context.Context, userID string, exercises []dw.WorkoutExerciseRef) error
 	DeleteWorkoutExercise(ctx context.Context, userID string, exerciseID int64) error
 	GetLastWorkoutRecord(ctx context.Context, userID string, exerciseID int64) (dw.WorkoutRecord, error)
+	GetRecentWorkoutRecords(ctx context.Context, userID string, exerciseID int64, limit int) ([]dw.WorkoutRecord, error)
+}
+
+// PresetCatalog は全ユーザー共通のプリセット部位・種目の提供元
+type PresetCatalog interface {
+	Presets() ([]dw.WorkoutPart, error)
+}
+
+// Metrics は業務メトリクスの記録先
+type Metrics interface {
+	// WorkoutSaved はセッションの保存（作成・更新）を記録する
+	WorkoutSaved()
 }
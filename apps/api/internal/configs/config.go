@@ -19,6 +19,8 @@ type DatabaseConfig struct {
 	Password string `env:"DB_PASSWORD,required"`       // データベースパスワード（必須）
 	Database string `env:"DB_NAME,required"`           // データベース名（必須）
 	Timezone string `env:"TZ" envDefault:"Asia/Tokyo"` // タイムゾーン設定（デフォルト: Asia/Tokyo）
+	// 起動時にスキーマが最新か確認し、古ければ起動しない（デフォルト: false）
+	CheckSchemaOnStartup bool `env:"DB_CHECK_SCHEMA_ON_STARTUP" envDefault:"false"`
 }
 
 type AuthConfig struct {
@@ -26,12 +28,13 @@ type AuthConfig struct {
 	AccessExpiresIn  time.Duration `env:"JWT_ACCESS_EXPIRES_IN" envDefault:"1h"`   // アクセストークン有効期限（デフォルト: 1時間）
 	RefreshExpiresIn time.Duration `env:"JWT_REFRESH_EXPIRES_IN" envDefault:"24h"` // リフレッシュトークン有効期限（デフォルト: 24時間）
 	Issuer           string        `env:"JWT_ISSUER" envDefault:"gogym-api"`       // JWTの発行者（デフォルト: gogym-api）
+	AdminUserIDs     []string      `env:"ADMIN_USER_IDS" envSeparator:","`         // 管理者ユーザーID（ULID、カンマ区切り）
 }
 
 type CORSConfig struct {
 	AllowOrigins []string `env:"CORS_ALLOW_ORIGINS"   envSeparator:","`
 	AllowMethods []string `env:"CORS_ALLOW_METHODS"   envSeparator:"," envDefault:"GET,POST,PUT,DELETE,OPTIONS"`
-	AllowHeaders []string `env:"CORS_ALLOW_HEADERS"   envSeparator:"," envDefault:"Content-Type,Authorization"`
+	AllowHeaders []string `env:"CORS_ALLOW_HEADERS"   envSeparator:"," envDefault:"Content-Type,Authorization,X-Time-Zone"`
 	AllowCreds   bool     `env:"CORS_ALLOW_CREDENTIALS" envDefault:"true"`
 }
 
@@ -51,6 +54,66 @@ type HTTPConfig struct {
 
 type SlackConfig struct {
 	ContactWebhookURL string `env:"SLACK_CONTACT_WEBHOOK_URL"` // 問い合わせ通知用WebhookURL
+	SignupsWebhookURL string `env:"SLACK_SIGNUPS_WEBHOOK_URL"` // 新規登録通知用WebhookURL
+	ErrorsWebhookURL  string `env:"SLACK_ERRORS_WEBHOOK_URL"`  // エラー通知用WebhookURL
+}
+
+type DiscordConfig struct {
+	ContactWebhookURL string `env:"DISCORD_CONTACT_WEBHOOK_URL"` // 問い合わせ通知用WebhookURL
+	SignupsWebhookURL string `env:"DISCORD_SIGNUPS_WEBHOOK_URL"` // 新規登録通知用WebhookURL
+	ErrorsWebhookURL  string `env:"DISCORD_ERRORS_WEBHOOK_URL"`  // エラー通知用WebhookURL
+}
+
+type WebhookConfig struct {
+	URL string `env:"NOTIFY_WEBHOOK_URL"` // 全チャンネルの通知を送る汎用WebhookURL
+}
+
+type OutboxConfig struct {
+	PollInterval   time.Duration `env:"OUTBOX_POLL_INTERVAL"    envDefault:"2s"`  // ポーリング間隔
+	BatchSize      int           `env:"OUTBOX_BATCH_SIZE"       envDefault:"20"`  // 1回に処理する件数
+	MaxAttempts    int           `env:"OUTBOX_MAX_ATTEMPTS"     envDefault:"8"`   // デッドレターまでの最大試行回数
+	BaseBackoff    time.Duration `env:"OUTBOX_BASE_BACKOFF"     envDefault:"5s"`  // 初回リトライ待機（以降2倍）
+	MaxBackoff     time.Duration `env:"OUTBOX_MAX_BACKOFF"      envDefault:"30m"` // リトライ待機の上限
+	Lease          time.Duration `env:"OUTBOX_LEASE"            envDefault:"1m"`  // 処理中ロックの有効期間
+	HandlerTimeout time.Duration `env:"OUTBOX_HANDLER_TIMEOUT"  envDefault:"10s"` // 1件あたりの処理タイムアウト
+	DrainTimeout   time.Duration `env:"OUTBOX_DRAIN_TIMEOUT"    envDefault:"10s"` // シャットダウン時の配信待ち上限
+}
+
+type ContactConfig struct {
+	FormSecret       string        `env:"CONTACT_FORM_SECRET,required"`                 // フォームトークンの署名鍵（必須、16文字以上・JWT_SECRETとは別の値）
+	RequireFormToken bool          `env:"CONTACT_REQUIRE_FORM_TOKEN" envDefault:"true"` // トークンなしの送信を拒否するか
+	MinFillTime      time.Duration `env:"CONTACT_MIN_FILL_TIME"      envDefault:"3s"`   // フォーム表示から送信までの最短時間
+	FormTokenTTL     time.Duration `env:"CONTACT_FORM_TOKEN_TTL"     envDefault:"2h"`   // フォームトークンの有効期限
+	QuotaWindow      time.Duration `env:"CONTACT_QUOTA_WINDOW"       envDefault:"1h"`   // 送信数を数える期間
+	MaxPerIP         int           `env:"CONTACT_MAX_PER_IP"         envDefault:"5"`    // 期間内のIPごとの送信上限
+	MaxPerEmail      int           `env:"CONTACT_MAX_PER_EMAIL"      envDefault:"3"`    // 期間内のメールアドレスごとの送信上限
+	MaxLinks         int           `env:"CONTACT_MAX_LINKS"          envDefault:"2"`    // 本文のリンク数上限（超えると隔離）
+	SpamKeywords     []string      `env:"CONTACT_SPAM_KEYWORDS"      envSeparator:","`  // 隔離対象のキーワード（カンマ区切り）
+}
+
+type CaptchaConfig struct {
+	VerifyURL string `env:"CAPTCHA_VERIFY_URL" envDefault:"https://challenges.cloudflare.com/turnstile/v0/siteverify"` // siteverify互換の検証URL
+	Secret    string `env:"CAPTCHA_SECRET"`                                                                            // 未設定の場合はCAPTCHA検証を行わない
+}
+
+// MetricsConfig は Prometheus の /metrics の公開設定
+type MetricsConfig struct {
+	Enabled bool   `env:"METRICS_ENABLED" envDefault:"false"` // /metrics を公開するか
+	Token   string `env:"METRICS_TOKEN"`                      // 取得に要求するBearerトークン（本番で公開する場合は必須）
+}
+
+// TracingConfig は OpenTelemetry のトレースの送信設定
+type TracingConfig struct {
+	Exporter     string  `env:"OTEL_TRACES_EXPORTER"        envDefault:"none"`      // none / stdout（ローカル確認用）/ otlp
+	OTLPEndpoint string  `env:"OTEL_EXPORTER_OTLP_ENDPOINT"`                        // OTLP/HTTPの送信先（例: http://localhost:4318、未設定ならSDKの既定値）
+	ServiceName  string  `env:"OTEL_SERVICE_NAME"           envDefault:"gogym-api"` // トレースのサービス名
+	SampleRatio  float64 `env:"OTEL_TRACES_SAMPLER_ARG"     envDefault:"1"`         // ルートスパンのサンプリング率（0〜1、親があれば親に従う）
+}
+
+// HealthConfig は liveness / readiness とシャットダウン時の切り離しの設定
+type HealthConfig struct {
+	CheckTimeout time.Duration `env:"HEALTH_CHECK_TIMEOUT" envDefault:"2s"` // readinessでのDB・スキーマ確認のタイムアウト
+	DrainDelay   time.Duration `env:"HEALTH_DRAIN_DELAY"   envDefault:"5s"` // シャットダウン開始（readiness失敗）からHTTP停止までの待機
 }
 
 type Config struct {
@@ -58,6 +121,14 @@ type Config struct {
 	Auth     AuthConfig     // JWT認証設定
 	HTTP     HTTPConfig     // HTTPサーバー設定
 	Slack    SlackConfig    // Slack通知設定
+	Discord  DiscordConfig  // Discord通知設定
+	Webhook  WebhookConfig  // 汎用Webhook通知設定
+	Outbox   OutboxConfig   // アウトボックス（非同期通知）設定
+	Contact  ContactConfig  // 問い合わせフォームのスパム対策設定
+	Captcha  CaptchaConfig  // CAPTCHA検証設定
+	Metrics  MetricsConfig  // Prometheusメトリクス設定
+	Tracing  TracingConfig  // OpenTelemetryトレース設定
+	Health   HealthConfig   // liveness / readiness 設定
 }
 
 // Load は環境変数から設定を読み込む
@@ -74,6 +145,10 @@ func Load() (*Config, error) {
 		cfg.HTTP.CORS.AllowOrigins[i] = strings.TrimSpace(o)
 	}
 
+	for i, id := range cfg.Auth.AdminUserIDs {
+		cfg.Auth.AdminUserIDs[i] = strings.TrimSpace(id)
+	}
+
 	// Render互換: PORT を優先（APP_PORTより上位）
 	if v := strings.TrimSpace(os.Getenv("PORT")); v != "" {
 		if p, err := strconv.Atoi(v); err == nil && p > 0 {
@@ -87,6 +162,17 @@ func Load() (*Config, error) {
 	return cfg, nil
 }
 
+// LoadDatabase はDB接続設定のみを読み込む（migrate サブコマンド用）
+func LoadDatabase() (*DatabaseConfig, error) {
+	_ = godotenv.Load()
+
+	cfg := &DatabaseConfig{}
+	if err := env.Parse(cfg); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
 // validate は読み込んだ設定を検証する
 func validate(c *Config) error {
 	// JWT 秘密鍵の強度
@@ -97,6 +183,30 @@ func validate(c *Config) error {
 	if c.Auth.AccessExpiresIn <= 0 || c.Auth.AccessExpiresIn > 24*time.Hour {
 		return errors.New("JWT_ACCESS_EXPIRES_IN out of range (0<ttl<=24h)")
 	}
+	// フォームトークンの署名鍵（JWT と共有すると片方の漏洩でもう片方も偽造できる）
+	if len(c.Contact.FormSecret) < 16 {
+		return errors.New("CONTACT_FORM_SECRET too short (>=16)")
+	}
+	if c.Contact.FormSecret == c.Auth.JWTSecret {
+		return errors.New("CONTACT_FORM_SECRET must differ from JWT_SECRET")
+	}
+	// 使用済みノンスの保持期間にもなるため無期限は不可
+	if c.Contact.FormTokenTTL <= 0 {
+		return errors.New("CONTACT_FORM_TOKEN_TTL must be positive")
+	}
+	// メトリクスにはリクエスト数などが含まれるため、本番ではトークンなしで公開しない
+	if c.Metrics.Enabled && c.Metrics.Token == "" && c.HTTP.Env == "production" {
+		return errors.New("METRICS_TOKEN is required when METRICS_ENABLED in production")
+	}
+	// トレースのエクスポーター
+	switch c.Tracing.Exporter {
+	case "none", "stdout", "otlp":
+	default:
+		return errors.New("OTEL_TRACES_EXPORTER must be one of none, stdout, otlp")
+	}
+	if c.Tracing.SampleRatio < 0 || c.Tracing.SampleRatio > 1 {
+		return errors.New("OTEL_TRACES_SAMPLER_ARG out of range (0<=ratio<=1)")
+	}
 	// 本番 × '*'（AllowCredsとの整合もブラウザ仕様的にNG）
 	for _, o := range c.HTTP.CORS.AllowOrigins {
 		if o == "*" && c.HTTP.Env == "production" {
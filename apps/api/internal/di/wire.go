@@ -3,30 +3,62 @@
 package di
 
 import (
+	"gogym-api/internal/configs"
+	"gogym-api/internal/infra/captcha"
+	"gogym-api/internal/infra/catalog"
+	"gogym-api/internal/infra/db"
+	"gogym-api/internal/infra/metrics"
+	"gogym-api/internal/infra/notify"
 	"gogym-api/internal/infra/security"
-	"gogym-api/internal/infra/slack"
 
 	"github.com/google/wire"
 	"gorm.io/gorm"
 
 	handler "gogym-api/internal/adapter/handler"
+	contactrepo "gogym-api/internal/adapter/repository/contact"
 	gymrepo "gogym-api/internal/adapter/repository/gym"
+	outboxrepo "gogym-api/internal/adapter/repository/outbox"
+	programrepo "gogym-api/internal/adapter/repository/program"
+	templaterepo "gogym-api/internal/adapter/repository/template"
 	userrepo "gogym-api/internal/adapter/repository/user"
 	workoutrepo "gogym-api/internal/adapter/repository/workout"
 
 	contactuc "gogym-api/internal/application/contact"
 	gymuc "gogym-api/internal/application/gym"
+	notifyuc "gogym-api/internal/application/notify"
+	outboxuc "gogym-api/internal/application/outbox"
+	programuc "gogym-api/internal/application/program"
 	sessionuc "gogym-api/internal/application/session"
+	templateuc "gogym-api/internal/application/template"
 	useruc "gogym-api/internal/application/user"
 	workoutuc "gogym-api/internal/application/workout"
 )
 
+// App はHTTPハンドラーとバックグラウンドワーカーをまとめたもの
+type App struct {
+	Handlers   *Handlers
+	Dispatcher *outboxuc.Dispatcher
+	Workout    workoutuc.WorkoutUseCase // 起動時のプリセットカタログ同期に使う
+	User       useruc.UserUseCase       // ロケール解決ミドルウェアがユーザー設定を読むのに使う
+}
+
+func NewApp(handlers *Handlers, dispatcher *outboxuc.Dispatcher, workout workoutuc.WorkoutUseCase, user useruc.UserUseCase) *App {
+	return &App{
+		Handlers:   handlers,
+		Dispatcher: dispatcher,
+		Workout:    workout,
+		User:       user,
+	}
+}
+
 type Handlers struct {
-	User    *handler.UserHandler
-	Session *handler.SessionHandler
-	Gym     *handler.GymHandler
-	Workout *handler.WorkoutHandler
-	Contact *handler.ContactHandler
+	User     *handler.UserHandler
+	Session  *handler.SessionHandler
+	Gym      *handler.GymHandler
+	Workout  *handler.WorkoutHandler
+	Template *handler.TemplateHandler
+	Program  *handler.ProgramHandler
+	Contact  *handler.ContactHandler
 }
 
 func NewHandlers(
@@ -34,14 +66,18 @@ func NewHandlers(
 	session *handler.SessionHandler,
 	gym *handler.GymHandler,
 	workout *handler.WorkoutHandler,
+	template *handler.TemplateHandler,
+	program *handler.ProgramHandler,
 	contact *handler.ContactHandler,
 ) *Handlers {
 	return &Handlers{
-		User:    user,
-		Session: session,
-		Gym:     gym,
-		Workout: workout,
-		Contact: contact,
+		User:     user,
+		Session:  session,
+		Gym:      gym,
+		Workout:  workout,
+		Template: template,
+		Program:  program,
+		Contact:  contact,
 	}
 }
 
@@ -49,6 +85,10 @@ var repositorySet = wire.NewSet(
 	userrepo.NewUserRepository,
 	gymrepo.NewGymRepository,
 	workoutrepo.NewWorkoutRepository,
+	templaterepo.NewTemplateRepository,
+	programrepo.NewProgramRepository,
+	contactrepo.NewContactRepository,
+	outboxrepo.NewOutboxRepository,
 	// Bind user repository to interfaces
 	wire.Bind(new(useruc.Repository), new(*userrepo.UserRepository)),
 	wire.Bind(new(sessionuc.UserRepository), new(*userrepo.UserRepository)),
@@ -65,7 +105,11 @@ var usecaseSet = wire.NewSet(
 	sessionuc.NewSessionInteractor,
 	gymuc.NewGymInteractor,
 	workoutuc.NewWorkoutInteractor,
+	templateuc.NewTemplateInteractor,
+	programuc.NewProgramInteractor,
 	contactuc.NewContactInteractor,
+	notifyuc.NewNotifyInteractor,
+	outboxuc.NewPublisher,
 )
 
 var handlerSet = wire.NewSet(
@@ -73,26 +117,139 @@ var handlerSet = wire.NewSet(
 	handler.NewSessionHandler,
 	handler.NewGymHandler,
 	handler.NewWorkoutHandler,
+	handler.NewTemplateHandler,
+	handler.NewProgramHandler,
 	handler.NewContactHandler,
 	NewHandlers,
 )
 
-// provideSlackGateway converts *slack.Client to contactuc.SlackGateway interface
-func provideSlackGateway(client *slack.Client) contactuc.SlackGateway {
-	return client
+// provideContactNotifier converts *notify.Multi to contactuc.Notifier interface
+func provideContactNotifier(n *notify.Multi) contactuc.Notifier {
+	return n
+}
+
+// provideNotifier converts *notify.Multi to notifyuc.Notifier interface
+func provideNotifier(n *notify.Multi) notifyuc.Notifier {
+	return n
+}
+
+// provideCaptchaVerifier converts *captcha.Client to contactuc.CaptchaVerifier interface
+func provideCaptchaVerifier(c *captcha.Client) contactuc.CaptchaVerifier {
+	return c
+}
+
+// provideSpamPolicy converts configs.ContactConfig to contactuc.SpamPolicy
+func provideSpamPolicy(cfg configs.ContactConfig) contactuc.SpamPolicy {
+	return contactuc.SpamPolicy{
+		FormSecret:       cfg.FormSecret,
+		RequireFormToken: cfg.RequireFormToken,
+		MinFillTime:      cfg.MinFillTime,
+		FormTokenTTL:     cfg.FormTokenTTL,
+		QuotaWindow:      cfg.QuotaWindow,
+		MaxPerIP:         cfg.MaxPerIP,
+		MaxPerEmail:      cfg.MaxPerEmail,
+		MaxLinks:         cfg.MaxLinks,
+		Keywords:         cfg.SpamKeywords,
+	}
+}
+
+// providePresetCatalog converts *catalog.Catalog to workoutuc.PresetCatalog interface
+func providePresetCatalog(c *catalog.Catalog) workoutuc.PresetCatalog {
+	return c
+}
+
+// provideTemplateWorkoutRepository converts workoutuc.Repository to templateuc.WorkoutRepository interface
+func provideTemplateWorkoutRepository(r workoutuc.Repository) templateuc.WorkoutRepository {
+	return r
+}
+
+// provideProgramTemplateRepository converts templateuc.Repository to programuc.TemplateRepository interface
+func provideProgramTemplateRepository(r templateuc.Repository) programuc.TemplateRepository {
+	return r
+}
+
+// provideProgramWorkoutRepository converts workoutuc.Repository to programuc.WorkoutRepository interface
+func provideProgramWorkoutRepository(r workoutuc.Repository) programuc.WorkoutRepository {
+	return r
 }
 
 var gatewaySet = wire.NewSet(
-	provideSlackGateway,
+	catalog.NewCatalog,
+	providePresetCatalog,
+	provideTemplateWorkoutRepository,
+	provideProgramTemplateRepository,
+	provideProgramWorkoutRepository,
+	provideContactNotifier,
+	provideNotifier,
+	provideCaptchaVerifier,
+	provideSpamPolicy,
+)
+
+var transactionSet = wire.NewSet(
+	db.NewTransactor,
+	wire.Bind(new(contactuc.Transactor), new(*db.Transactor)),
+	wire.Bind(new(useruc.Transactor), new(*db.Transactor)),
+)
+
+// provideOutboxOptions converts configs.OutboxConfig to outboxuc.Options
+func provideOutboxOptions(cfg configs.OutboxConfig) outboxuc.Options {
+	return outboxuc.Options{
+		PollInterval:   cfg.PollInterval,
+		BatchSize:      cfg.BatchSize,
+		MaxAttempts:    cfg.MaxAttempts,
+		BaseBackoff:    cfg.BaseBackoff,
+		MaxBackoff:     cfg.MaxBackoff,
+		Lease:          cfg.Lease,
+		HandlerTimeout: cfg.HandlerTimeout,
+	}
+}
+
+// provideDispatcher builds the outbox dispatcher and registers topic handlers
+func provideDispatcher(repo outboxuc.Repository, opts outboxuc.Options, contact contactuc.ContactUseCase, notify notifyuc.NotifyUseCase) *outboxuc.Dispatcher {
+	d := outboxuc.NewDispatcher(repo, opts)
+	d.Register(contactuc.TopicContactNotify, contact.DeliverContact)
+	d.Register(notifyuc.TopicNotification, notify.Deliver)
+	d.OnDeadLetter(notify.NotifyDeadLetter)
+	return d
+}
+
+// provideUserMetrics converts *metrics.Metrics to useruc.Metrics interface
+func provideUserMetrics(m *metrics.Metrics) useruc.Metrics {
+	return m
+}
+
+// provideSessionMetrics converts *metrics.Metrics to sessionuc.Metrics interface
+func provideSessionMetrics(m *metrics.Metrics) sessionuc.Metrics {
+	return m
+}
+
+// provideWorkoutMetrics converts *metrics.Metrics to workoutuc.Metrics interface
+func provideWorkoutMetrics(m *metrics.Metrics) workoutuc.Metrics {
+	return m
+}
+
+var metricsSet = wire.NewSet(
+	provideUserMetrics,
+	provideSessionMetrics,
+	provideWorkoutMetrics,
+)
+
+var workerSet = wire.NewSet(
+	provideOutboxOptions,
+	provideDispatcher,
+	NewApp,
 )
 
-func Initialize(db *gorm.DB, slackClient *slack.Client, jwtSecret string) *Handlers {
+func Initialize(db *gorm.DB, notifier *notify.Multi, captchaClient *captcha.Client, m *metrics.Metrics, jwtSecret string, outboxCfg configs.OutboxConfig, contactCfg configs.ContactConfig) *App {
 	wire.Build(
 		repositorySet,
 		securitySet,
 		gatewaySet,
+		transactionSet,
+		metricsSet,
 		usecaseSet,
 		handlerSet,
+		workerSet,
 	)
 	return nil
 }
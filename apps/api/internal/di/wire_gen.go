@@ -9,75 +9,236 @@ package di
 import (
 	"github.com/google/wire"
 	"gogym-api/internal/adapter/handler"
+	"gogym-api/internal/adapter/repository/contact"
 	"gogym-api/internal/adapter/repository/gym"
+	"gogym-api/internal/adapter/repository/outbox"
+	"gogym-api/internal/adapter/repository/program"
+	"gogym-api/internal/adapter/repository/template"
 	"gogym-api/internal/adapter/repository/user"
 	"gogym-api/internal/adapter/repository/workout"
-	"gogym-api/internal/application/contact"
+	contact2 "gogym-api/internal/application/contact"
 	gym2 "gogym-api/internal/application/gym"
+	notify2 "gogym-api/internal/application/notify"
+	outbox2 "gogym-api/internal/application/outbox"
+	program2 "gogym-api/internal/application/program"
 	"gogym-api/internal/application/session"
+	template2 "gogym-api/internal/application/template"
 	user2 "gogym-api/internal/application/user"
 	workout2 "gogym-api/internal/application/workout"
+	"gogym-api/internal/configs"
+	"gogym-api/internal/infra/captcha"
+	"gogym-api/internal/infra/catalog"
+	"gogym-api/internal/infra/db"
+	"gogym-api/internal/infra/metrics"
+	"gogym-api/internal/infra/notify"
 	"gogym-api/internal/infra/security"
-	"gogym-api/internal/infra/slack"
 	"gorm.io/gorm"
 )
 
 // Injectors from wire.go:
 
-func Initialize(db *gorm.DB, slackClient *slack.Client, jwtSecret string) *Handlers {
-	userRepository := user.NewUserRepository(db)
+func Initialize(db2 *gorm.DB, notifier *notify.Multi, captchaClient *captcha.Client, m *metrics.Metrics, jwtSecret string, outboxCfg configs.OutboxConfig, contactCfg configs.ContactConfig) *App {
+	userRepository := user.NewUserRepository(db2)
 	bcryptPasswordHasher := security.NewBcryptPasswordHasher()
-	userUseCase := user2.NewUserInteractor(userRepository, bcryptPasswordHasher)
+	transactor := db.NewTransactor(db2)
+	repository := outbox.NewOutboxRepository(db2)
+	options := provideOutboxOptions(outboxCfg)
+	publisher := outbox2.NewPublisher(repository, options)
+	userMetrics := provideUserMetrics(m)
+	userUseCase := user2.NewUserInteractor(userRepository, bcryptPasswordHasher, transactor, publisher, userMetrics)
 	userHandler := handler.NewUserHandler(userUseCase)
-	sessionUseCase := session.NewSessionInteractor(userRepository, bcryptPasswordHasher, jwtSecret)
+	sessionMetrics := provideSessionMetrics(m)
+	sessionUseCase := session.NewSessionInteractor(userRepository, bcryptPasswordHasher, jwtSecret, sessionMetrics)
 	sessionHandler := handler.NewSessionHandler(sessionUseCase)
-	repository := gym.NewGymRepository(db)
-	gymUseCase := gym2.NewGymInteractor(repository)
+	gymRepository := gym.NewGymRepository(db2)
+	gymUseCase := gym2.NewGymInteractor(gymRepository)
 	gymHandler := handler.NewGymHandler(gymUseCase)
-	workoutRepository := workout.NewWorkoutRepository(db)
-	workoutUseCase := workout2.NewWorkoutInteractor(workoutRepository, repository)
+	workoutRepository := workout.NewWorkoutRepository(db2)
+	catalogCatalog := catalog.NewCatalog()
+	presetCatalog := providePresetCatalog(catalogCatalog)
+	workoutMetrics := provideWorkoutMetrics(m)
+	workoutUseCase := workout2.NewWorkoutInteractor(workoutRepository, gymRepository, presetCatalog, workoutMetrics)
 	workoutHandler := handler.NewWorkoutHandler(workoutUseCase)
-	slackGateway := provideSlackGateway(slackClient)
-	contactUseCase := contact.NewContactInteractor(slackGateway)
+	templateRepository := template.NewTemplateRepository(db2)
+	templateWorkoutRepository := provideTemplateWorkoutRepository(workoutRepository)
+	templateUseCase := template2.NewTemplateInteractor(templateRepository, templateWorkoutRepository)
+	templateHandler := handler.NewTemplateHandler(templateUseCase)
+	programRepository := program.NewProgramRepository(db2)
+	programTemplateRepository := provideProgramTemplateRepository(templateRepository)
+	programWorkoutRepository := provideProgramWorkoutRepository(workoutRepository)
+	programUseCase := program2.NewProgramInteractor(programRepository, programTemplateRepository, programWorkoutRepository)
+	programHandler := handler.NewProgramHandler(programUseCase)
+	contactRepository := contact.NewContactRepository(db2)
+	contactNotifier := provideContactNotifier(notifier)
+	captchaVerifier := provideCaptchaVerifier(captchaClient)
+	spamPolicy := provideSpamPolicy(contactCfg)
+	contactUseCase := contact2.NewContactInteractor(contactRepository, transactor, publisher, contactNotifier, captchaVerifier, spamPolicy)
 	contactHandler := handler.NewContactHandler(contactUseCase)
-	handlers := NewHandlers(userHandler, sessionHandler, gymHandler, workoutHandler, contactHandler)
-	return handlers
+	handlers := NewHandlers(userHandler, sessionHandler, gymHandler, workoutHandler, templateHandler, programHandler, contactHandler)
+	notifyNotifier := provideNotifier(notifier)
+	notifyUseCase := notify2.NewNotifyInteractor(notifyNotifier)
+	dispatcher := provideDispatcher(repository, options, contactUseCase, notifyUseCase)
+	app := NewApp(handlers, dispatcher, workoutUseCase, userUseCase)
+	return app
 }
 
 // wire.go:
 
+// App はHTTPハンドラーとバックグラウンドワーカーをまとめたもの
+type App struct {
+	Handlers   *Handlers
+	Dispatcher *outbox2.Dispatcher
+	Workout    workout2.WorkoutUseCase // 起動時のプリセットカタログ同期に使う
+	User       user2.UserUseCase       // ロケール解決ミドルウェアがユーザー設定を読むのに使う
+}
+
+func NewApp(handlers *Handlers, dispatcher *outbox2.Dispatcher, workout3 workout2.WorkoutUseCase, user3 user2.UserUseCase) *App {
+	return &App{
+		Handlers:   handlers,
+		Dispatcher: dispatcher,
+		Workout:    workout3,
+		User:       user3,
+	}
+}
+
 type Handlers struct {
-	User    *handler.UserHandler
-	Session *handler.SessionHandler
-	Gym     *handler.GymHandler
-	Workout *handler.WorkoutHandler
-	Contact *handler.ContactHandler
+	User     *handler.UserHandler
+	Session  *handler.SessionHandler
+	Gym      *handler.GymHandler
+	Workout  *handler.WorkoutHandler
+	Template *handler.TemplateHandler
+	Program  *handler.ProgramHandler
+	Contact  *handler.ContactHandler
 }
 
-func NewHandlers(user3 *handler.UserHandler, session2 *handler.SessionHandler, gym3 *handler.GymHandler, workout3 *handler.WorkoutHandler, contact2 *handler.ContactHandler,
+func NewHandlers(user3 *handler.UserHandler, session2 *handler.SessionHandler, gym3 *handler.GymHandler, workout3 *handler.WorkoutHandler, template3 *handler.TemplateHandler, program3 *handler.ProgramHandler, contact3 *handler.ContactHandler,
 ) *Handlers {
 	return &Handlers{
-		User:    user3,
-		Session: session2,
-		Gym:     gym3,
-		Workout: workout3,
-		Contact: contact2,
+		User:     user3,
+		Session:  session2,
+		Gym:      gym3,
+		Workout:  workout3,
+		Template: template3,
+		Program:  program3,
+		Contact:  contact3,
 	}
 }
 
-var repositorySet = wire.NewSet(user.NewUserRepository, gym.NewGymRepository, workout.NewWorkoutRepository, wire.Bind(new(user2.Repository), new(*user.UserRepository)), wire.Bind(new(session.UserRepository), new(*user.UserRepository)))
+var repositorySet = wire.NewSet(user.NewUserRepository, gym.NewGymRepository, workout.NewWorkoutRepository, template.NewTemplateRepository, program.NewProgramRepository, contact.NewContactRepository, outbox.NewOutboxRepository, wire.Bind(new(user2.Repository), new(*user.UserRepository)), wire.Bind(new(session.UserRepository), new(*user.UserRepository)))
 
 var securitySet = wire.NewSet(security.NewBcryptPasswordHasher, wire.Bind(new(user2.PasswordHasher), new(*security.BcryptPasswordHasher)), wire.Bind(new(session.PasswordHasher), new(*security.BcryptPasswordHasher)))
 
-var usecaseSet = wire.NewSet(user2.NewUserInteractor, session.NewSessionInteractor, gym2.NewGymInteractor, workout2.NewWorkoutInteractor, contact.NewContactInteractor)
+var usecaseSet = wire.NewSet(user2.NewUserInteractor, session.NewSessionInteractor, gym2.NewGymInteractor, workout2.NewWorkoutInteractor, template2.NewTemplateInteractor, program2.NewProgramInteractor, contact2.NewContactInteractor, notify2.NewNotifyInteractor, outbox2.NewPublisher)
+
+var handlerSet = wire.NewSet(handler.NewUserHandler, handler.NewSessionHandler, handler.NewGymHandler, handler.NewWorkoutHandler, handler.NewTemplateHandler, handler.NewProgramHandler, handler.NewContactHandler, NewHandlers)
+
+// provideContactNotifier converts *notify.Multi to contactuc.Notifier interface
+func provideContactNotifier(n *notify.Multi) contact2.Notifier {
+	return n
+}
+
+// provideNotifier converts *notify.Multi to notifyuc.Notifier interface
+func provideNotifier(n *notify.Multi) notify2.Notifier {
+	return n
+}
+
+// provideCaptchaVerifier converts *captcha.Client to contactuc.CaptchaVerifier interface
+func provideCaptchaVerifier(c *captcha.Client) contact2.CaptchaVerifier {
+	return c
+}
+
+// provideSpamPolicy converts configs.ContactConfig to contactuc.SpamPolicy
+func provideSpamPolicy(cfg configs.ContactConfig) contact2.SpamPolicy {
+	return contact2.SpamPolicy{
+		FormSecret:       cfg.FormSecret,
+		RequireFormToken: cfg.RequireFormToken,
+		MinFillTime:      cfg.MinFillTime,
+		FormTokenTTL:     cfg.FormTokenTTL,
+		QuotaWindow:      cfg.QuotaWindow,
+		MaxPerIP:         cfg.MaxPerIP,
+		MaxPerEmail:      cfg.MaxPerEmail,
+		MaxLinks:         cfg.MaxLinks,
+		Keywords:         cfg.SpamKeywords,
+	}
+}
+
+// providePresetCatalog converts *catalog.Catalog to workoutuc.PresetCatalog interface
+func providePresetCatalog(c *catalog.Catalog) workout2.PresetCatalog {
+	return c
+}
+
+// provideTemplateWorkoutRepository converts workoutuc.Repository to templateuc.WorkoutRepository interface
+func provideTemplateWorkoutRepository(r workout2.Repository) template2.WorkoutRepository {
+	return r
+}
+
+// provideProgramTemplateRepository converts templateuc.Repository to programuc.TemplateRepository interface
+func provideProgramTemplateRepository(r template2.Repository) program2.TemplateRepository {
+	return r
+}
+
+// provideProgramWorkoutRepository converts workoutuc.Repository to programuc.WorkoutRepository interface
+func provideProgramWorkoutRepository(r workout2.Repository) program2.WorkoutRepository {
+	return r
+}
+
+var gatewaySet = wire.NewSet(catalog.NewCatalog, providePresetCatalog,
+	provideTemplateWorkoutRepository,
+	provideProgramTemplateRepository,
+	provideProgramWorkoutRepository,
+	provideContactNotifier,
+	provideNotifier,
+	provideCaptchaVerifier,
+	provideSpamPolicy,
+)
+
+var transactionSet = wire.NewSet(db.NewTransactor, wire.Bind(new(contact2.Transactor), new(*db.Transactor)), wire.Bind(new(user2.Transactor), new(*db.Transactor)))
+
+// provideOutboxOptions converts configs.OutboxConfig to outboxuc.Options
+func provideOutboxOptions(cfg configs.OutboxConfig) outbox2.Options {
+	return outbox2.Options{
+		PollInterval:   cfg.PollInterval,
+		BatchSize:      cfg.BatchSize,
+		MaxAttempts:    cfg.MaxAttempts,
+		BaseBackoff:    cfg.BaseBackoff,
+		MaxBackoff:     cfg.MaxBackoff,
+		Lease:          cfg.Lease,
+		HandlerTimeout: cfg.HandlerTimeout,
+	}
+}
 
-var handlerSet = wire.NewSet(handler.NewUserHandler, handler.NewSessionHandler, handler.NewGymHandler, handler.NewWorkoutHandler, handler.NewContactHandler, NewHandlers)
+// provideDispatcher builds the outbox dispatcher and registers topic handlers
+func provideDispatcher(repo outbox2.Repository, opts outbox2.Options, contact3 contact2.ContactUseCase, notify3 notify2.NotifyUseCase) *outbox2.Dispatcher {
+	d := outbox2.NewDispatcher(repo, opts)
+	d.Register(contact2.TopicContactNotify, contact3.DeliverContact)
+	d.Register(notify2.TopicNotification, notify3.Deliver)
+	d.OnDeadLetter(notify3.NotifyDeadLetter)
+	return d
+}
+
+// provideUserMetrics converts *metrics.Metrics to useruc.Metrics interface
+func provideUserMetrics(m *metrics.Metrics) user2.Metrics {
+	return m
+}
 
-// provideSlackGateway converts *slack.Client to contactuc.SlackGateway interface
-func provideSlackGateway(client *slack.Client) contact.SlackGateway {
-	return client
+// provideSessionMetrics converts *metrics.Metrics to sessionuc.Metrics interface
+func provideSessionMetrics(m *metrics.Metrics) session.Metrics {
+	return m
 }
 
-var gatewaySet = wire.NewSet(
-	provideSlackGateway,
+// provideWorkoutMetrics converts *metrics.Metrics to workoutuc.Metrics interface
+func provideWorkoutMetrics(m *metrics.Metrics) workout2.Metrics {
+	return m
+}
+
+var metricsSet = wire.NewSet(
+	provideUserMetrics,
+	provideSessionMetrics,
+	provideWorkoutMetrics,
+)
+
+var workerSet = wire.NewSet(
+	provideOutboxOptions,
+	provideDispatcher,
+	NewApp,
 )
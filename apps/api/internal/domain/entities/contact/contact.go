@@ -0,0 +1,151 @@
+package contact
+
+import (
+	"strings"
+	"time"
+
+	dom "gogym-api/internal/domain/entities"
+)
+
+// Status は問い合わせの対応状況
+type Status string
+
+const (
+	StatusOpen        Status = "open"        // 未対応
+	StatusResolved    Status = "resolved"    // 対応済み
+	StatusQuarantined Status = "quarantined" // スパム疑い（通知せず保留）
+)
+
+// Valid は定義済みのステータスかチェック
+func (s Status) Valid() bool {
+	return s == StatusOpen || s == StatusResolved || s == StatusQuarantined
+}
+
+// DeliveryStatus は外部通知（Slack）への配信状況
+type DeliveryStatus string
+
+const (
+	DeliveryPending   DeliveryStatus = "pending"   // 未配信（配信待ち・リトライ中）
+	DeliveryDelivered DeliveryStatus = "delivered" // 配信済み
+	DeliveryFailed    DeliveryStatus = "failed"    // リトライ上限到達
+	DeliverySkipped   DeliveryStatus = "skipped"   // 通知先が未設定のため送信しなかった
+)
+
+const (
+	MaxEmailLength   = 255
+	MaxMessageLength = 2000
+)
+
+var (
+	ErrInvalidMessage  = dom.NewError(dom.KindValidation, "invalid_contact_message", "email and message are required")
+	ErrMessageTooLong  = dom.NewError(dom.KindValidation, "contact_message_too_long", "email or message is too long")
+	ErrAlreadyResolved = dom.NewError(dom.KindConflict, "contact_already_resolved", "contact message already resolved")
+	ErrNotFound        = dom.NewError(dom.KindNotFound, "contact_not_found", "contact message not found")
+	ErrInvalidStatus   = dom.NewError(dom.KindValidation, "invalid_contact_status", "invalid contact status")
+
+	// スパム・濫用対策で拒否した場合のエラー
+	ErrInvalidFormToken = dom.NewError(dom.KindValidation, "invalid_form_token", "invalid or expired form token")
+	ErrSubmittedTooFast = dom.NewError(dom.KindValidation, "submitted_too_fast", "form submitted too fast")
+	ErrCaptchaFailed    = dom.NewError(dom.KindValidation, "captcha_failed", "captcha verification failed")
+	ErrRateLimited      = dom.NewError(dom.KindRateLimited, "rate_limited", "too many contact submissions")
+)
+
+// Message は問い合わせフォームから送信された内容
+type Message struct {
+	ID                int64
+	Email             string
+	Body              string
+	UserID            *string // 未ログインの場合は nil
+	IP                string
+	UserAgent         string
+	Status            Status
+	SpamScore         int      // スパム判定のスコア（高いほど疑わしい）
+	SpamReasons       []string // スコアの内訳（例: "links:4", "keyword:casino"）
+	DeliveryStatus    DeliveryStatus
+	DeliveryAttempts  int
+	LastDeliveryError *string
+	DeliveredAt       *time.Time
+	ResolvedAt        *time.Time
+	ResolvedBy        *string
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// NewMessage は入力を検証して未対応・未配信の問い合わせを生成する
+func NewMessage(email, body string, userID *string, ip, ua string, now time.Time) (*Message, error) {
+	email = strings.TrimSpace(email)
+	body = strings.TrimSpace(body)
+
+	if email == "" || body == "" {
+		return nil, ErrInvalidMessage
+	}
+	if len(email) > MaxEmailLength || len(body) > MaxMessageLength {
+		return nil, ErrMessageTooLong
+	}
+	if userID != nil && *userID == "" {
+		userID = nil
+	}
+
+	return &Message{
+		Email:          email,
+		Body:           body,
+		UserID:         userID,
+		IP:             ip,
+		UserAgent:      ua,
+		Status:         StatusOpen,
+		DeliveryStatus: DeliveryPending,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}, nil
+}
+
+// Quarantine スパム疑いとして保留にする（通知は行わない）
+func (m *Message) Quarantine(score int, reasons []string) {
+	m.Status = StatusQuarantined
+	m.SpamScore = score
+	m.SpamReasons = reasons
+}
+
+// IsQuarantined スパム疑いで保留中かチェック
+func (m *Message) IsQuarantined() bool {
+	return m.Status == StatusQuarantined
+}
+
+// MarkDelivered 配信成功を記録
+func (m *Message) MarkDelivered(at time.Time) {
+	m.DeliveryAttempts++
+	m.DeliveryStatus = DeliveryDelivered
+	m.DeliveredAt = &at
+	m.LastDeliveryError = nil
+	m.UpdatedAt = at
+}
+
+// MarkDeliverySkipped 通知先が未設定で送信しなかったことを記録（リトライしない）
+func (m *Message) MarkDeliverySkipped(at time.Time) {
+	m.DeliveryStatus = DeliverySkipped
+	m.LastDeliveryError = nil
+	m.UpdatedAt = at
+}
+
+// MarkDeliveryFailed 配信失敗を記録（final が true の場合はリトライ打ち切り）
+func (m *Message) MarkDeliveryFailed(cause error, final bool, at time.Time) {
+	m.DeliveryAttempts++
+	msg := cause.Error()
+	m.LastDeliveryError = &msg
+	if final {
+		m.DeliveryStatus = DeliveryFailed
+	}
+	m.UpdatedAt = at
+}
+
+// Resolve 管理者による対応済み化
+func (m *Message) Resolve(by string, at time.Time) error {
+	if m.Status == StatusResolved {
+		return ErrAlreadyResolved
+	}
+	m.Status = StatusResolved
+	m.ResolvedAt = &at
+	m.ResolvedBy = &by
+	m.UpdatedAt = at
+	return nil
+}
@@ -0,0 +1,99 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrorKind はエラーの種類（アダプタ層で HTTP のステータスに対応付ける）
+type ErrorKind string
+
+const (
+	KindValidation   ErrorKind = "validation"   // 入力が不正
+	KindUnauthorized ErrorKind = "unauthorized" // 認証されていない・認証に失敗した
+	KindForbidden    ErrorKind = "forbidden"    // 権限がない
+	KindNotFound     ErrorKind = "not_found"    // 対象が存在しない
+	KindConflict     ErrorKind = "conflict"     // 現在の状態と矛盾する
+	KindRateLimited  ErrorKind = "rate_limited" // 回数の上限を超えた
+)
+
+// Error は種類とクライアント向けのコードを持つドメイン・アプリケーションのエラー
+// パッケージ変数（センチネル）として定義して errors.Is で判定し、詳細は fmt.Errorf("%w: ...") で付ける
+// 型付きでないエラーは内部のエラーとして扱い、メッセージをクライアントに返さない
+type Error struct {
+	Kind    ErrorKind
+	Code    string // 機械可読なコード（例: "email_already_exists"）
+	Message string
+}
+
+// NewError は型付きのエラーを作る
+func NewError(kind ErrorKind, code, message string) *Error {
+	return &Error{Kind: kind, Code: code, Message: message}
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+var (
+	// ErrValidation は項目ごとの検証エラーの既定の種類
+	ErrValidation = NewError(KindValidation, "validation_failed", "validation failed")
+	// ErrUnauthorized は認証されていないリクエストのエラー
+	ErrUnauthorized = NewError(KindUnauthorized, "unauthorized", "unauthorized")
+	// ErrForbidden は権限のない操作のエラー
+	ErrForbidden = NewError(KindForbidden, "forbidden", "forbidden")
+)
+
+// FieldError は項目ごとの検証エラー
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// ValidationError は項目ごとの詳細を持つ検証エラー
+type ValidationError struct {
+	Err    error // 元のエラー（nil なら ErrValidation）
+	Fields []FieldError
+}
+
+// NewValidationError は項目ごとの検証エラーを作る
+func NewValidationError(fields ...FieldError) *ValidationError {
+	return &ValidationError{Err: ErrValidation, Fields: fields}
+}
+
+// InvalidField は1項目だけの検証エラーを作る（パスやクエリのパラメータの形式エラーなど）
+func InvalidField(field, message string) *ValidationError {
+	return NewValidationError(FieldError{Field: field, Message: message})
+}
+
+// InvalidInput は日時の形式エラーなど、項目ごとの詳細を持たない入力の不正をエラーにする（メッセージは err のまま）
+func InvalidInput(err error) *ValidationError {
+	return &ValidationError{Err: err}
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, 0, len(e.Fields))
+	for _, f := range e.Fields {
+		msgs = append(msgs, f.Field+": "+f.Message)
+	}
+	if len(msgs) == 0 {
+		return e.Unwrap().Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+func (e *ValidationError) Unwrap() error {
+	if e.Err == nil {
+		return ErrValidation
+	}
+	return e.Err
+}
+
+// AsError は err の連鎖から型付きのエラーを取り出す（型付きでなければ ok=false）
+func AsError(err error) (*Error, bool) {
+	var e *Error
+	if errors.As(err, &e) {
+		return e, true
+	}
+	return nil, false
+}
@@ -1,17 +1,21 @@
 package gym
 
 import (
-	"errors"
 	"regexp"
 	"strings"
+
+	dom "gogym-api/internal/domain/entities"
 )
 
+// ErrInvalidGymName はジムの名前が空・長すぎる場合のエラー
+var ErrInvalidGymName = dom.NewError(dom.KindValidation, "invalid_gym_name", "invalid gym name")
+
 var multiSpaceRegex = regexp.MustCompile(`\s+`)
 
 type Gym struct {
 	ID              int
-	Name            string `validate:"required,max=255"`
-	NormalizedName  string `validate:"required,max=255"`
+	Name            string
+	NormalizedName  string
 	Latitude        float64
 	Longitude       float64
 	SourceURL       string
@@ -45,13 +49,14 @@ func NewGym(name, address string, latitude, longitude float64) (*Gym, error) {
 	return gym, nil
 }
 
+// Validate は名前（1〜255バイト）をチェックする（正規化した名前は名前から作るため同じ条件を満たす）
 func (g *Gym) Validate() error {
 	if g.Name == "" {
-		return errors.New("invalid name")
+		return ErrInvalidGymName
 	}
 
 	if len(g.Name) > 255 {
-		return errors.New("invalid name")
+		return ErrInvalidGymName
 	}
 
 	return nil
@@ -0,0 +1,25 @@
+package domain
+
+// ErrUnsupportedLocale は対応していないロケールが指定された場合のエラー
+var ErrUnsupportedLocale = NewError(KindValidation, "unsupported_locale", "unsupported locale")
+
+const (
+	LocaleJa = "ja"
+	LocaleEn = "en"
+
+	// DefaultLocale は翻訳がない・ロケールが決まらない場合に使うロケール
+	DefaultLocale = LocaleJa
+)
+
+// SupportedLocales は対応しているロケール（優先順）
+var SupportedLocales = []string{LocaleJa, LocaleEn}
+
+// IsSupportedLocale は対応しているロケールかを返す
+func IsSupportedLocale(locale string) bool {
+	for _, l := range SupportedLocales {
+		if l == locale {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,41 @@
+package notification
+
+import "errors"
+
+// Channel は通知の送り先（用途）
+type Channel string
+
+const (
+	ChannelContact Channel = "contact" // 問い合わせ
+	ChannelSignups Channel = "signups" // 新規登録
+	ChannelErrors  Channel = "errors"  // エラー・障害
+)
+
+var (
+	ErrInvalidNotification  = errors.New("notification channel and title are required")
+	ErrChannelNotConfigured = errors.New("notification channel is not configured")
+)
+
+// Field はラベル付きの値（Slackのsection field / context、Discordのembed field）
+type Field struct {
+	Label string `json:"label"`
+	Value string `json:"value"`
+}
+
+// Notification は送信先サービスに依存しない通知内容
+// 各Notifierが Slack Block Kit や Discord Embed などの形式に変換する
+type Notification struct {
+	Channel Channel `json:"channel"`
+	Title   string  `json:"title"`
+	Text    string  `json:"text,omitempty"`    // 本文
+	Fields  []Field `json:"fields,omitempty"`  // 本文下に並べる主要項目
+	Context []Field `json:"context,omitempty"` // 補足情報（送信元メールアドレス、IP、UAなど）
+}
+
+// Validate は送信に必要な項目が揃っているかチェック
+func (n Notification) Validate() error {
+	if n.Channel == "" || n.Title == "" {
+		return ErrInvalidNotification
+	}
+	return nil
+}
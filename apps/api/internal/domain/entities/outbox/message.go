@@ -0,0 +1,102 @@
+package outbox
+
+import (
+	"errors"
+	"time"
+)
+
+// Status は送信待ちメッセージの状態
+type Status string
+
+const (
+	StatusPending    Status = "pending"    // 配信待ち（リトライ待ち含む）
+	StatusProcessing Status = "processing" // ディスパッチャが処理中
+	StatusDelivered  Status = "delivered"  // 配信済み
+	StatusDead       Status = "dead"       // リトライ上限到達（デッドレター）
+)
+
+var ErrInvalidMessage = errors.New("outbox topic and payload are required")
+
+// Message はトランザクショナルアウトボックスに積まれる外部送信メッセージ
+type Message struct {
+	ID            int64
+	Topic         string // 配信先ハンドラーの識別子（例: "contact.notify"）
+	Payload       []byte // JSON
+	Status        Status
+	Attempts      int
+	MaxAttempts   int
+	NextAttemptAt time.Time
+	LastError     *string
+	DeliveredAt   *time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// NewMessage は即時配信対象のメッセージを生成する
+func NewMessage(topic string, payload []byte, maxAttempts int, now time.Time) (*Message, error) {
+	if topic == "" || len(payload) == 0 {
+		return nil, ErrInvalidMessage
+	}
+	if maxAttempts <= 0 {
+		maxAttempts = 1
+	}
+
+	return &Message{
+		Topic:         topic,
+		Payload:       payload,
+		Status:        StatusPending,
+		MaxAttempts:   maxAttempts,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}, nil
+}
+
+// IsFinalAttempt 今回の試行が最後（失敗したらデッドレター）かどうか
+func (m *Message) IsFinalAttempt() bool {
+	return m.Attempts+1 >= m.MaxAttempts
+}
+
+// MarkDelivered 配信成功を記録
+func (m *Message) MarkDelivered(at time.Time) {
+	m.Attempts++
+	m.Status = StatusDelivered
+	m.DeliveredAt = &at
+	m.LastError = nil
+	m.UpdatedAt = at
+}
+
+// MarkFailed 配信失敗を記録し、次回試行時刻を指数バックオフで設定する
+// リトライ上限に達した場合はデッドレターにする
+func (m *Message) MarkFailed(cause error, at time.Time, baseDelay, maxDelay time.Duration) {
+	final := m.IsFinalAttempt()
+	m.Attempts++
+	msg := cause.Error()
+	m.LastError = &msg
+	m.UpdatedAt = at
+
+	if final {
+		m.Status = StatusDead
+		return
+	}
+	m.Status = StatusPending
+	m.NextAttemptAt = at.Add(Backoff(m.Attempts, baseDelay, maxDelay))
+}
+
+// Backoff は attempt 回目の失敗後の待機時間（base * 2^(attempt-1)、上限 max）
+func Backoff(attempt int, base, max time.Duration) time.Duration {
+	if attempt <= 0 || base <= 0 {
+		return 0
+	}
+	d := base
+	for i := 1; i < attempt; i++ {
+		d *= 2
+		if max > 0 && d >= max {
+			return max
+		}
+	}
+	if max > 0 && d > max {
+		return max
+	}
+	return d
+}
@@ -0,0 +1,8 @@
+package domain
+
+// UserPreferences はユーザーが設定した表示設定（未設定の項目はゼロ値）
+type UserPreferences struct {
+	Locale      string      // 表示ロケール（空なら Accept-Language に従う）
+	TimeZone    string      // IANA のタイムゾーン名（空ならクライアントの指定・デフォルトに従う）
+	WeightUnits WeightUnits // 重量の表示単位と丸め幅（ゼロ値なら kg・既定の幅）
+}
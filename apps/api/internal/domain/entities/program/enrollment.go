@@ -0,0 +1,199 @@
+package program
+
+import (
+	"fmt"
+	"time"
+
+	dom "gogym-api/internal/domain/entities"
+	dw "gogym-api/internal/domain/entities/workout"
+)
+
+var (
+	// ErrEnrollmentNotFound は進行中のプログラムがない場合のエラー
+	ErrEnrollmentNotFound = dom.NewError(dom.KindNotFound, "enrollment_not_found", "program enrollment not found")
+	// ErrInvalidEnrollment はプログラムの開始日などが不正な場合のエラー
+	ErrInvalidEnrollment = dom.NewError(dom.KindValidation, "invalid_enrollment", "invalid program enrollment")
+)
+
+// Enrollment はユーザーが開始日を決めて取り組んでいるプログラム（進行中は1ユーザー1件）
+type Enrollment struct {
+	ID        dom.ID
+	UserID    dom.ULID
+	Program   Program
+	StartDate time.Time // 1週目1日目の日付
+	EndedAt   *time.Time
+	CreatedAt time.Time
+}
+
+// NewEnrollment はプログラムへの参加を作成する
+func NewEnrollment(user dom.ULID, program Program, startDate time.Time) (*Enrollment, error) {
+	if user == "" || program.UserID != user {
+		return nil, fmt.Errorf("%w: program must belong to the user", ErrInvalidEnrollment)
+	}
+	if startDate.IsZero() {
+		return nil, fmt.Errorf("%w: start date required", ErrInvalidEnrollment)
+	}
+	return &Enrollment{
+		UserID:    user,
+		Program:   program,
+		StartDate: civilDate(startDate),
+	}, nil
+}
+
+// PlanStatus はある日付がプログラムの中でどういう日かを表す
+type PlanStatus string
+
+const (
+	PlanStatusNotStarted PlanStatus = "not_started" // 開始日より前
+	PlanStatusTraining   PlanStatus = "training"    // トレーニング日
+	PlanStatusRest       PlanStatus = "rest"        // 休養日
+	PlanStatusFinished   PlanStatus = "finished"    // 最終日より後
+)
+
+// DayPlan はある日付の予定
+type DayPlan struct {
+	Date     time.Time
+	Week     int // プログラムの期間外は 0
+	Day      int
+	Status   PlanStatus
+	Training *ProgramDay // トレーニング日のみ
+}
+
+// EndDate はプログラムの最終日を返す
+func (e Enrollment) EndDate() time.Time {
+	return e.StartDate.AddDate(0, 0, e.Program.LengthDays()-1)
+}
+
+// DateOf は week 週目の day 日目の日付を返す
+func (e Enrollment) DateOf(week, day int) time.Time {
+	return e.StartDate.AddDate(0, 0, (week-1)*daysPerWeek+day-1)
+}
+
+// PlanOn は date の予定を返す
+func (e Enrollment) PlanOn(date time.Time) DayPlan {
+	date = civilDate(date)
+	plan := DayPlan{Date: date}
+
+	offset := daysBetween(e.StartDate, date)
+	switch {
+	case offset < 0:
+		plan.Status = PlanStatusNotStarted
+		return plan
+	case offset >= e.Program.LengthDays():
+		plan.Status = PlanStatusFinished
+		return plan
+	}
+
+	plan.Week = offset/daysPerWeek + 1
+	plan.Day = offset%daysPerWeek + 1
+	plan.Training = e.Program.DayAt(plan.Week, plan.Day)
+	plan.Status = PlanStatusRest
+	if plan.Training != nil {
+		plan.Status = PlanStatusTraining
+	}
+	return plan
+}
+
+// DayStatus はトレーニング日の実施状況
+type DayStatus string
+
+const (
+	DayStatusDone     DayStatus = "done"     // 同じテンプレートのセッションを同じ週に行った
+	DayStatusMissed   DayStatus = "missed"   // 予定日を過ぎても行っていない
+	DayStatusUpcoming DayStatus = "upcoming" // 予定日が基準日以降でまだ行っていない
+)
+
+// DayAdherence はトレーニング日ごとの予定と実績
+type DayAdherence struct {
+	Day      ProgramDay
+	Date     time.Time
+	Status   DayStatus
+	RecordID *dw.ID // 実施したセッション
+}
+
+// Adherence はプログラムの予定に対する実施状況
+type Adherence struct {
+	Days      []DayAdherence
+	Planned   int // 基準日までに予定していた日数（当日は実施済みの場合のみ数える）
+	Performed int
+}
+
+// Rate は実施率（0〜1）を返す。予定がまだない場合は 0
+func (a Adherence) Rate() float64 {
+	if a.Planned == 0 {
+		return 0
+	}
+	return float64(a.Performed) / float64(a.Planned)
+}
+
+// Adherence は asOf 時点の実施状況を records（テンプレートから開始したセッション）から求める
+// トレーニング日には同じテンプレートのセッションを対応づける。予定日のセッションを優先し、
+// なければ同じ週の別の日のセッションでもよい（1つのセッションは1日にだけ対応づける）
+func (e Enrollment) Adherence(records []dw.WorkoutRecord, asOf time.Time) Adherence {
+	asOf = civilDate(asOf)
+	days := e.Program.Days
+	matched := make([]int, len(days)) // 対応づけた records の添字 + 1（0 は未対応）
+	used := make([]bool, len(records))
+
+	match := func(i int, sameDate bool) {
+		d := days[i]
+		date := e.DateOf(d.Week, d.Day)
+		weekStart, weekEnd := e.DateOf(d.Week, 1), e.DateOf(d.Week, daysPerWeek)
+		for j, r := range records {
+			if used[j] || r.TemplateID == nil || *r.TemplateID != d.TemplateID {
+				continue
+			}
+			performed := civilDate(r.PerformedDate)
+			if performed.After(asOf) {
+				continue
+			}
+			if sameDate && !performed.Equal(date) {
+				continue
+			}
+			if performed.Before(weekStart) || performed.After(weekEnd) {
+				continue
+			}
+			used[j] = true
+			matched[i] = j + 1
+			return
+		}
+	}
+	for i := range days {
+		match(i, true)
+	}
+	for i := range days {
+		if matched[i] == 0 {
+			match(i, false)
+		}
+	}
+
+	a := Adherence{Days: make([]DayAdherence, 0, len(days))}
+	for i, d := range days {
+		day := DayAdherence{Day: d, Date: e.DateOf(d.Week, d.Day)}
+		switch {
+		case matched[i] > 0:
+			day.Status = DayStatusDone
+			day.RecordID = records[matched[i]-1].ID
+			a.Planned++
+			a.Performed++
+		case day.Date.Before(asOf):
+			day.Status = DayStatusMissed
+			a.Planned++
+		default:
+			day.Status = DayStatusUpcoming
+		}
+		a.Days = append(a.Days, day)
+	}
+	return a
+}
+
+// civilDate は t の暦日（t のタイムゾーンでの年月日）を UTC 0時で返す
+func civilDate(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// daysBetween は from から to までの日数を返す
+func daysBetween(from, to time.Time) int {
+	return int(civilDate(to).Sub(civilDate(from)).Hours() / 24)
+}
@@ -0,0 +1,204 @@
+package program
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	dom "gogym-api/internal/domain/entities"
+	dt "gogym-api/internal/domain/entities/template"
+	dw "gogym-api/internal/domain/entities/workout"
+)
+
+var (
+	// ErrProgramNotFound はプログラムが存在しない（または他ユーザーのプログラム）場合のエラー
+	ErrProgramNotFound = dom.NewError(dom.KindNotFound, "program_not_found", "training program not found")
+	// ErrInvalidProgram はプログラムの期間・日程・処方が不正な場合のエラー
+	ErrInvalidProgram = dom.NewError(dom.KindValidation, "invalid_program", "invalid training program")
+)
+
+const (
+	maxProgramNameLength   = 100
+	maxProgramWeeks        = 52
+	daysPerWeek            = 7
+	maxPrescriptionsPerDay = 60
+	maxPercentOfMax        = 120.0
+	maxPrescribedReps      = 100
+	workingWeightStepKg    = 2.5 // 処方重量を丸める単位（一般的なプレートの組み合わせ）
+)
+
+// Program は週×日の日程でテンプレートを割り当てた複数週のプログラム（5/3/1、12週のPPLなど）
+type Program struct {
+	ID        dom.ID
+	UserID    dom.ULID
+	Name      string
+	Note      *string
+	Weeks     int
+	Days      []ProgramDay // Week, Day の昇順
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// ProgramDay はプログラムのトレーニング日（日程にない日は休養日）
+type ProgramDay struct {
+	ID            dom.ID
+	Week          int // 1始まりの週
+	Day           int // 週の中の日（1〜7、開始日と同じ曜日が1）
+	TemplateID    dom.ID
+	TemplateName  string         // 表示用（保存時は不要）
+	Prescriptions []Prescription // 種目ごとに SetNumber の昇順
+}
+
+// Prescription はトレーニングマックスに対する割合で指定したセット
+type Prescription struct {
+	ID           dom.ID
+	Exercise     dw.WorkoutExerciseRef
+	SetNumber    int     // 種目ごとの1始まりのセット番号
+	PercentOfMax float64 // トレーニングマックスに対する割合（%）
+	Reps         int
+	AMRAP        bool // 指定回数以上、できるだけ多く行う
+}
+
+// NewProgram はプログラムを作成し、日程を週・日の順に並べ、処方のセット番号を種目ごとに振り直して検証する
+func NewProgram(user dom.ULID, name string, note *string, weeks int, days []ProgramDay) (*Program, error) {
+	p := &Program{
+		UserID: user,
+		Name:   strings.TrimSpace(name),
+		Note:   note,
+		Weeks:  weeks,
+		Days:   make([]ProgramDay, 0, len(days)),
+	}
+	for _, d := range days {
+		prescriptions := make([]Prescription, len(d.Prescriptions))
+		setNumbers := make(map[dom.ID]int)
+		for i, rx := range d.Prescriptions {
+			setNumbers[rx.Exercise.ID]++
+			rx.SetNumber = setNumbers[rx.Exercise.ID]
+			prescriptions[i] = rx
+		}
+		d.Prescriptions = prescriptions
+		p.Days = append(p.Days, d)
+	}
+	slices.SortStableFunc(p.Days, func(a, b ProgramDay) int {
+		if a.Week != b.Week {
+			return a.Week - b.Week
+		}
+		return a.Day - b.Day
+	})
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Validate はプログラムの不変条件をチェックする
+func (p Program) Validate() error {
+	if p.UserID == "" {
+		return fmt.Errorf("%w: user required", ErrInvalidProgram)
+	}
+	if p.Name == "" || utf8.RuneCountInString(p.Name) > maxProgramNameLength {
+		return fmt.Errorf("%w: name must be 1-%d characters", ErrInvalidProgram, maxProgramNameLength)
+	}
+	if p.Weeks < 1 || p.Weeks > maxProgramWeeks {
+		return fmt.Errorf("%w: weeks must be 1-%d", ErrInvalidProgram, maxProgramWeeks)
+	}
+	if len(p.Days) == 0 {
+		return fmt.Errorf("%w: program must have at least one training day", ErrInvalidProgram)
+	}
+	seen := make(map[[2]int]bool, len(p.Days))
+	for _, d := range p.Days {
+		if d.Week < 1 || d.Week > p.Weeks || d.Day < 1 || d.Day > daysPerWeek {
+			return fmt.Errorf("%w: day must be within weeks 1-%d and days 1-%d", ErrInvalidProgram, p.Weeks, daysPerWeek)
+		}
+		key := [2]int{d.Week, d.Day}
+		if seen[key] {
+			return fmt.Errorf("%w: week %d day %d appears more than once", ErrInvalidProgram, d.Week, d.Day)
+		}
+		seen[key] = true
+		if err := d.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate はトレーニング日のテンプレートと処方をチェックする
+func (d ProgramDay) Validate() error {
+	if d.TemplateID <= 0 {
+		return fmt.Errorf("%w: template required for week %d day %d", ErrInvalidProgram, d.Week, d.Day)
+	}
+	if len(d.Prescriptions) > maxPrescriptionsPerDay {
+		return fmt.Errorf("%w: day can have at most %d prescriptions", ErrInvalidProgram, maxPrescriptionsPerDay)
+	}
+	for _, rx := range d.Prescriptions {
+		if rx.Exercise.ID <= 0 {
+			return fmt.Errorf("%w: prescription exercise required", ErrInvalidProgram)
+		}
+		if rx.PercentOfMax <= 0 || rx.PercentOfMax > maxPercentOfMax {
+			return fmt.Errorf("%w: percent of training max must be greater than 0 and at most %.0f", ErrInvalidProgram, maxPercentOfMax)
+		}
+		if rx.Reps < 1 || rx.Reps > maxPrescribedReps {
+			return fmt.Errorf("%w: prescribed reps must be 1-%d", ErrInvalidProgram, maxPrescribedReps)
+		}
+	}
+	return nil
+}
+
+// TemplateIDs は日程で使うテンプレートIDを重複なく返す
+func (p Program) TemplateIDs() []dom.ID {
+	ids := make([]dom.ID, 0, len(p.Days))
+	for _, d := range p.Days {
+		if !slices.Contains(ids, d.TemplateID) {
+			ids = append(ids, d.TemplateID)
+		}
+	}
+	return ids
+}
+
+// CheckTemplates は日程のテンプレートがすべて templates にあり、処方の種目がそのテンプレートに含まれることを確認する
+// テンプレート名も templates から埋める
+func (p *Program) CheckTemplates(templates map[dom.ID]dt.Template) error {
+	for i := range p.Days {
+		d := &p.Days[i]
+		t, ok := templates[d.TemplateID]
+		if !ok {
+			return dt.ErrTemplateNotFound
+		}
+		d.TemplateName = t.Name
+		exerciseIDs := t.ExerciseIDs()
+		for _, rx := range d.Prescriptions {
+			if !slices.Contains(exerciseIDs, rx.Exercise.ID) {
+				return fmt.Errorf("%w: exercise %d is not in template %q", ErrInvalidProgram, rx.Exercise.ID, t.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// LengthDays はプログラムの日数を返す
+func (p Program) LengthDays() int {
+	return p.Weeks * daysPerWeek
+}
+
+// DayAt は week 週目の day 日目のトレーニング日を返す（休養日は nil）
+func (p Program) DayAt(week, day int) *ProgramDay {
+	for i := range p.Days {
+		if p.Days[i].Week == week && p.Days[i].Day == day {
+			return &p.Days[i]
+		}
+	}
+	return nil
+}
+
+// WorkingWeight はトレーニングマックスから処方の重量（kg）を求める
+// 重量は stepKg（ユーザーのプレートの丸め幅、0 以下なら workingWeightStepKg）単位の最も近い値に丸める
+func (rx Prescription) WorkingWeight(trainingMaxKg, stepKg float64) float64 {
+	if stepKg <= 0 {
+		stepKg = workingWeightStepKg
+	}
+	w := trainingMaxKg * rx.PercentOfMax / 100
+	return math.Round(math.Round(w/stepKg)*stepKg*100) / 100
+}
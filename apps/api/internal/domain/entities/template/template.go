@@ -0,0 +1,149 @@
+package template
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	dom "gogym-api/internal/domain/entities"
+	dw "gogym-api/internal/domain/entities/workout"
+)
+
+var (
+	// ErrTemplateNotFound はテンプレートが存在しない（または他ユーザーのテンプレート）場合のエラー
+	ErrTemplateNotFound = dom.NewError(dom.KindNotFound, "template_not_found", "workout template not found")
+	// ErrInvalidTemplate はテンプレートの名前・種目の目標が不正な場合のエラー
+	ErrInvalidTemplate = dom.NewError(dom.KindValidation, "invalid_template", "invalid workout template")
+)
+
+const (
+	maxTemplateNameLength = 100
+	maxTemplateExercises  = 30
+	maxTargetSets         = 20
+	maxTargetReps         = 100
+)
+
+// Template は繰り返し行うワークアウト（プッシュの日・脚の日など）の型
+type Template struct {
+	ID        dom.ID
+	UserID    dom.ULID
+	Name      string
+	Note      *string
+	Exercises []TemplateExercise // Position の昇順
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// TemplateExercise はテンプレート内の種目と目標
+type TemplateExercise struct {
+	ID             dom.ID
+	Exercise       dw.WorkoutExerciseRef
+	Position       int // 1始まりの並び順
+	TargetSets     int
+	RepsMin        int
+	RepsMax        int
+	TargetWeightKg *float64 // 前回の記録がない場合に使う重量
+	Note           *string
+}
+
+// NewTemplate はテンプレートを作成し、種目の並び順を 1..N に振り直して検証する
+func NewTemplate(user dom.ULID, name string, note *string, exercises []TemplateExercise) (*Template, error) {
+	t := &Template{
+		UserID:    user,
+		Name:      strings.TrimSpace(name),
+		Note:      note,
+		Exercises: make([]TemplateExercise, len(exercises)),
+	}
+	for i, ex := range exercises {
+		ex.Position = i + 1
+		t.Exercises[i] = ex
+	}
+	if err := t.Validate(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// Validate はテンプレートの不変条件をチェックする
+func (t Template) Validate() error {
+	if t.UserID == "" {
+		return fmt.Errorf("%w: user required", ErrInvalidTemplate)
+	}
+	if t.Name == "" || utf8.RuneCountInString(t.Name) > maxTemplateNameLength {
+		return fmt.Errorf("%w: name must be 1-%d characters", ErrInvalidTemplate, maxTemplateNameLength)
+	}
+	if len(t.Exercises) == 0 || len(t.Exercises) > maxTemplateExercises {
+		return fmt.Errorf("%w: template must have 1-%d exercises", ErrInvalidTemplate, maxTemplateExercises)
+	}
+	seen := make(map[dom.ID]bool, len(t.Exercises))
+	for _, ex := range t.Exercises {
+		if err := ex.Validate(); err != nil {
+			return err
+		}
+		if seen[ex.Exercise.ID] {
+			return fmt.Errorf("%w: exercise %d appears more than once", ErrInvalidTemplate, ex.Exercise.ID)
+		}
+		seen[ex.Exercise.ID] = true
+	}
+	return nil
+}
+
+// Validate は種目の目標セット数・回数の範囲・重量をチェックする
+func (e TemplateExercise) Validate() error {
+	if e.Exercise.ID <= 0 {
+		return fmt.Errorf("%w: exercise required", ErrInvalidTemplate)
+	}
+	if e.TargetSets < 1 || e.TargetSets > maxTargetSets {
+		return fmt.Errorf("%w: target sets must be 1-%d", ErrInvalidTemplate, maxTargetSets)
+	}
+	if e.RepsMin < 1 || e.RepsMax < e.RepsMin || e.RepsMax > maxTargetReps {
+		return fmt.Errorf("%w: reps range must satisfy 1 <= min <= max <= %d", ErrInvalidTemplate, maxTargetReps)
+	}
+	if e.TargetWeightKg != nil && !dw.WeightKg(*e.TargetWeightKg).Valid() {
+		return fmt.Errorf("%w: target weight must be >= 0", ErrInvalidTemplate)
+	}
+	return nil
+}
+
+// ExerciseIDs はテンプレートに含まれる種目IDを並び順に返す
+func (t Template) ExerciseIDs() []dom.ID {
+	ids := make([]dom.ID, 0, len(t.Exercises))
+	for _, ex := range t.Exercises {
+		ids = append(ids, ex.Exercise.ID)
+	}
+	return ids
+}
+
+// PlanSets はテンプレートの種目の目標セットを作る
+// 前回の記録（ウォームアップを除く回数のセット）があればセット番号ごとに重量・種類を引き継ぎ、
+// 前回のセット数より多い分は最後のセットの重量を使う。前回の記録がなければ目標重量を使う
+func (e TemplateExercise) PlanSets(last []dw.WorkoutSet) []dw.WorkoutSet {
+	working := make([]dw.WorkoutSet, 0, len(last))
+	for _, s := range last {
+		if s.IsWorking() && s.IsRepBased() {
+			working = append(working, s)
+		}
+	}
+
+	sets := make([]dw.WorkoutSet, 0, e.TargetSets)
+	for n := 1; n <= e.TargetSets; n++ {
+		set := dw.WorkoutSet{
+			Exercise:  e.Exercise,
+			SetNumber: n,
+			Kind:      dw.SetKindWeightReps,
+			Type:      dw.SetTypeNormal,
+			Reps:      dw.Reps(e.RepsMin),
+		}
+		if len(working) > 0 {
+			prev := working[min(n, len(working))-1]
+			set.Kind = prev.KindOrDefault()
+			set.Weight = prev.Weight
+			set.BodyweightKg = prev.BodyweightKg
+		} else if e.TargetWeightKg != nil {
+			set.Weight = dw.WeightKg(*e.TargetWeightKg)
+		}
+		sets = append(sets, set)
+	}
+	return sets
+}
@@ -0,0 +1,18 @@
+package domain
+
+import "time"
+
+// ErrUnsupportedTimeZone は IANA のタイムゾーン名として解決できない場合のエラー
+var ErrUnsupportedTimeZone = NewError(KindValidation, "unsupported_time_zone", "unsupported time zone")
+
+const maxTimeZoneLength = 64
+
+// IsSupportedTimeZone は IANA のタイムゾーン名（例: "Asia/Tokyo", "America/New_York"）として解決できるかを返す
+// サーバーのローカル時刻を指す "Local" や空文字は受け付けない
+func IsSupportedTimeZone(name string) bool {
+	if name == "" || name == "Local" || len(name) > maxTimeZoneLength {
+		return false
+	}
+	_, err := time.LoadLocation(name)
+	return err == nil
+}
@@ -0,0 +1,120 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+)
+
+var (
+	// ErrUnsupportedWeightUnit は対応していない重量の単位が指定された場合のエラー
+	ErrUnsupportedWeightUnit = NewError(KindValidation, "unsupported_weight_unit", "unsupported weight unit")
+	// ErrInvalidWeightIncrement は重量の丸め幅が範囲外の場合のエラー
+	ErrInvalidWeightIncrement = NewError(KindValidation, "invalid_weight_increment", "invalid weight increment")
+)
+
+// WeightUnit は重量の表示・入力の単位（保存は常に kg）
+type WeightUnit string
+
+const (
+	WeightUnitKg WeightUnit = "kg"
+	WeightUnitLb WeightUnit = "lb"
+
+	// DefaultWeightUnit は単位が設定されていない場合に使う単位
+	DefaultWeightUnit = WeightUnitKg
+
+	// KgPerLb は 1 ポンドあたりのキログラム（国際ポンド）
+	KgPerLb = 0.45359237
+
+	maxWeightIncrement = 50.0
+)
+
+// Valid は対応している単位かを返す
+func (u WeightUnit) Valid() bool {
+	return u == WeightUnitKg || u == WeightUnitLb
+}
+
+// DefaultIncrement は単位ごとの既定の丸め幅（一般的なプレートの組み合わせ）を返す
+func (u WeightUnit) DefaultIncrement() float64 {
+	if u == WeightUnitLb {
+		return 5
+	}
+	return 2.5
+}
+
+// displayPrecision は表示値を丸める桁数（kg は保存と同じ 0.01、lb は保存時の誤差が出ない 0.1）
+func (u WeightUnit) displayPrecision() float64 {
+	if u == WeightUnitLb {
+		return 10
+	}
+	return 100
+}
+
+// WeightUnits はユーザーの重量の表示単位と丸め幅
+// ゼロ値は kg・既定の丸め幅として扱う
+type WeightUnits struct {
+	Unit      WeightUnit // 空ならデフォルトの単位
+	Increment float64    // Unit での丸め幅（0 なら単位ごとの既定値）
+}
+
+// Validate は単位と丸め幅を検証する
+func (w WeightUnits) Validate() error {
+	if w.Unit != "" && !w.Unit.Valid() {
+		return ErrUnsupportedWeightUnit
+	}
+	if w.Increment < 0 || w.Increment > maxWeightIncrement || math.IsNaN(w.Increment) {
+		return fmt.Errorf("%w: must be 0-%g", ErrInvalidWeightIncrement, maxWeightIncrement)
+	}
+	return nil
+}
+
+// UnitOrDefault は表示単位を返す（未設定ならデフォルト）
+func (w WeightUnits) UnitOrDefault() WeightUnit {
+	if w.Unit == "" {
+		return DefaultWeightUnit
+	}
+	return w.Unit
+}
+
+// IncrementOrDefault は表示単位での丸め幅を返す（未設定なら単位ごとの既定値）
+func (w WeightUnits) IncrementOrDefault() float64 {
+	if w.Increment > 0 {
+		return w.Increment
+	}
+	return w.UnitOrDefault().DefaultIncrement()
+}
+
+// IncrementKg は丸め幅を kg で返す
+func (w WeightUnits) IncrementKg() float64 {
+	return w.toKg(w.IncrementOrDefault())
+}
+
+// PlateIncrementKg は重量の提案で器具ごとの既定の幅の代わりに使う幅（kg）を返す
+// 丸め幅を設定している場合と、kg の既定の幅ではポンドのプレートに合わない lb の場合に ok=true
+func (w WeightUnits) PlateIncrementKg() (float64, bool) {
+	if w.Increment > 0 || w.UnitOrDefault() == WeightUnitLb {
+		return w.IncrementKg(), true
+	}
+	return 0, false
+}
+
+// FromKg は kg の重量を表示単位の値にする
+func (w WeightUnits) FromKg(kg float64) float64 {
+	unit := w.UnitOrDefault()
+	v := kg
+	if unit == WeightUnitLb {
+		v = kg / KgPerLb
+	}
+	return math.Round(v*unit.displayPrecision()) / unit.displayPrecision()
+}
+
+// ToKg は表示単位の値を保存用の kg（0.01 kg 単位）にする
+func (w WeightUnits) ToKg(v float64) float64 {
+	return math.Round(w.toKg(v)*100) / 100
+}
+
+func (w WeightUnits) toKg(v float64) float64 {
+	if w.UnitOrDefault() == WeightUnitLb {
+		return v * KgPerLb
+	}
+	return v
+}
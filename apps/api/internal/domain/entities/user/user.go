@@ -5,16 +5,24 @@ import (
 	"strings"
 	"time"
 
+	domain "gogym-api/internal/domain/entities"
+
 	"github.com/oklog/ulid/v2"
 )
 
+// ErrUserNotFound はユーザーが存在しない場合のエラー
+var ErrUserNotFound = domain.NewError(domain.KindNotFound, "user_not_found", "user not found")
+
 type User struct {
-	ID           ulid.ULID // ULID識別子
-	Name         string    // 表示名
-	Email        string    // メールアドレス（バリューオブジェクト）
-	PasswordHash string    // パスワードハッシュ
-	CreatedAt    time.Time // 作成日時
-	UpdatedAt    time.Time // 更新日時
+	ID           ulid.ULID          // ULID識別子
+	Name         string             // 表示名
+	Email        string             // メールアドレス（バリューオブジェクト）
+	PasswordHash string             // パスワードハッシュ
+	Locale       string             // 表示ロケール（空なら Accept-Language に従う）
+	TimeZone     string             // IANA のタイムゾーン名（空ならクライアントの指定・デフォルトに従う）
+	WeightUnits  domain.WeightUnits // 重量の表示単位と丸め幅（ゼロ値なら kg・既定の幅）
+	CreatedAt    time.Time          // 作成日時
+	UpdatedAt    time.Time          // 更新日時
 }
 
 func NewUser(id ulid.ULID, name, email, passwordHash string, now time.Time) *User {
@@ -43,7 +51,7 @@ func (u *User) Rename(newName string) error {
 		return errors.New("invalid name")
 	}
 	u.Name = n
-	u.UpdatedAt = time.Now() // 更新時刻を更新
+	u.UpdatedAt = time.Now().UTC() // 更新時刻を更新
 	return nil
 }
 
@@ -53,6 +61,37 @@ func (u *User) RotatePasswordHash(newHash string) error {
 		return errors.New("invalid password hash")
 	}
 	u.PasswordHash = newHash
-	u.UpdatedAt = time.Now() // 更新時刻を更新
+	u.UpdatedAt = time.Now().UTC() // 更新時刻を更新
+	return nil
+}
+
+// SetLocale: 表示ロケールを変更（空文字で未設定に戻す）
+func (u *User) SetLocale(locale string) error {
+	if locale != "" && !domain.IsSupportedLocale(locale) {
+		return domain.ErrUnsupportedLocale
+	}
+	u.Locale = locale
+	u.UpdatedAt = time.Now().UTC() // 更新時刻を更新
+	return nil
+}
+
+// SetTimeZone: タイムゾーンを変更（空文字で未設定に戻す）
+func (u *User) SetTimeZone(name string) error {
+	if name != "" && !domain.IsSupportedTimeZone(name) {
+		return domain.ErrUnsupportedTimeZone
+	}
+	u.TimeZone = name
+	u.UpdatedAt = time.Now().UTC() // 更新時刻を更新
+	return nil
+}
+
+// SetWeightUnits: 重量の表示単位と丸め幅を変更（ゼロ値で未設定に戻す）
+// 単位を変えて丸め幅を指定しなかった場合、前の単位の丸め幅は引き継がない
+func (u *User) SetWeightUnits(units domain.WeightUnits) error {
+	if err := units.Validate(); err != nil {
+		return err
+	}
+	u.WeightUnits = units
+	u.UpdatedAt = time.Now().UTC() // 更新時刻を更新
 	return nil
 }
@@ -1,13 +1,219 @@
 package workout
 
 import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
 	dom "gogym-api/internal/domain/entities"
 )
 
+var (
+	// ErrExerciseNotFound は種目が存在しない場合のエラー
+	ErrExerciseNotFound = dom.NewError(dom.KindNotFound, "exercise_not_found", "workout exercise not found")
+	// ErrInvalidExercise は種目の名前・メタデータが不正な場合のエラー
+	ErrInvalidExercise = dom.NewError(dom.KindValidation, "invalid_exercise", "invalid workout exercise")
+)
+
+// maxDefaultRestSec はセット間の休憩として設定できる最大秒数（DBの CHECK に合わせる）
+const maxDefaultRestSec = 3600
+
+// ValidateDefaultRest はセット間の休憩（秒）の設定値をチェックする（nil は設定の解除）
+func ValidateDefaultRest(sec *int) error {
+	if sec != nil && (*sec < 0 || *sec > maxDefaultRestSec) {
+		return fmt.Errorf("%w: default rest must be 0-%d seconds", ErrInvalidExercise, maxDefaultRestSec)
+	}
+	return nil
+}
+
+// maxTrainingMaxKg はトレーニングマックスとして設定できる最大重量（DBの DECIMAL(6,2) に収まる範囲）
+const maxTrainingMaxKg = 1000.0
+
+// ValidateTrainingMax はトレーニングマックス（kg）の設定値をチェックする（nil は設定の解除）
+func ValidateTrainingMax(kg *float64) error {
+	if kg != nil && (*kg <= 0 || *kg > maxTrainingMaxKg) {
+		return fmt.Errorf("%w: training max must be greater than 0 and at most %.0f kg", ErrInvalidExercise, maxTrainingMaxKg)
+	}
+	return nil
+}
+
+// maxExerciseNameLength は種目名の最大文字数（DBの VARCHAR(100) に合わせる）
+const maxExerciseNameLength = 100
+
+// Muscle は種目が主に・補助的に使う筋肉
+type Muscle string
+
+const (
+	MuscleChest      Muscle = "chest"
+	MuscleUpperChest Muscle = "upper_chest"
+	MuscleFrontDelts Muscle = "front_delts"
+	MuscleSideDelts  Muscle = "side_delts"
+	MuscleRearDelts  Muscle = "rear_delts"
+	MuscleLats       Muscle = "lats"
+	MuscleTraps      Muscle = "traps"
+	MuscleRhomboids  Muscle = "rhomboids"
+	MuscleLowerBack  Muscle = "lower_back"
+	MuscleBiceps     Muscle = "biceps"
+	MuscleTriceps    Muscle = "triceps"
+	MuscleBrachialis Muscle = "brachialis"
+	MuscleForearms   Muscle = "forearms"
+	MuscleAbs        Muscle = "abs"
+	MuscleObliques   Muscle = "obliques"
+	MuscleQuads      Muscle = "quads"
+	MuscleHamstrings Muscle = "hamstrings"
+	MuscleGlutes     Muscle = "glutes"
+	MuscleAdductors  Muscle = "adductors"
+	MuscleAbductors  Muscle = "abductors"
+	MuscleCalves     Muscle = "calves"
+	MuscleHipFlexors Muscle = "hip_flexors"
+	MuscleNeck       Muscle = "neck"
+)
+
+var muscles = map[Muscle]bool{
+	MuscleChest: true, MuscleUpperChest: true, MuscleFrontDelts: true, MuscleSideDelts: true,
+	MuscleRearDelts: true, MuscleLats: true, MuscleTraps: true, MuscleRhomboids: true,
+	MuscleLowerBack: true, MuscleBiceps: true, MuscleTriceps: true, MuscleBrachialis: true,
+	MuscleForearms: true, MuscleAbs: true, MuscleObliques: true, MuscleQuads: true,
+	MuscleHamstrings: true, MuscleGlutes: true, MuscleAdductors: true, MuscleAbductors: true,
+	MuscleCalves: true, MuscleHipFlexors: true, MuscleNeck: true,
+}
+
+// Valid は定義済みの筋肉かチェック
+func (m Muscle) Valid() bool {
+	return muscles[m]
+}
+
+// Equipment は種目で使う器具
+type Equipment string
+
+const (
+	EquipmentBarbell    Equipment = "barbell"
+	EquipmentDumbbell   Equipment = "dumbbell"
+	EquipmentMachine    Equipment = "machine"
+	EquipmentCable      Equipment = "cable"
+	EquipmentBodyweight Equipment = "bodyweight"
+	EquipmentKettlebell Equipment = "kettlebell"
+	EquipmentBand       Equipment = "band"
+	EquipmentOther      Equipment = "other"
+)
+
+// Valid は定義済みの器具かチェック
+func (e Equipment) Valid() bool {
+	switch e {
+	case EquipmentBarbell, EquipmentDumbbell, EquipmentMachine, EquipmentCable,
+		EquipmentBodyweight, EquipmentKettlebell, EquipmentBand, EquipmentOther:
+		return true
+	}
+	return false
+}
+
+// MovementPattern は種目の動作パターン
+type MovementPattern string
+
+const (
+	MovementHorizontalPush MovementPattern = "horizontal_push"
+	MovementVerticalPush   MovementPattern = "vertical_push"
+	MovementHorizontalPull MovementPattern = "horizontal_pull"
+	MovementVerticalPull   MovementPattern = "vertical_pull"
+	MovementSquat          MovementPattern = "squat"
+	MovementHinge          MovementPattern = "hinge"
+	MovementLunge          MovementPattern = "lunge"
+	MovementCarry          MovementPattern = "carry"
+	MovementRotation       MovementPattern = "rotation"
+	MovementCore           MovementPattern = "core"
+	MovementIsolation      MovementPattern = "isolation"
+	MovementCardio         MovementPattern = "cardio"
+	MovementOther          MovementPattern = "other"
+)
+
+// Valid は定義済みの動作パターンかチェック
+func (m MovementPattern) Valid() bool {
+	switch m {
+	case MovementHorizontalPush, MovementVerticalPush, MovementHorizontalPull, MovementVerticalPull,
+		MovementSquat, MovementHinge, MovementLunge, MovementCarry, MovementRotation,
+		MovementCore, MovementIsolation, MovementCardio, MovementOther:
+		return true
+	}
+	return false
+}
+
 // WorkoutExerciseRef represents a reference to a workout exercise
 type WorkoutExerciseRef struct {
-	ID     dom.ID
-	Name   string
-	PartID *dom.ID
-	Owner  *dom.ULID // nil ならプリセット、値があればユーザー作成
+	ID               dom.ID
+	Key              string // プリセットの識別子（ユーザー作成の種目は空）
+	Name             string // 基本の名前（翻訳がない場合に使う）
+	Translations     []WorkoutExerciseTranslation
+	PartID           *dom.ID
+	Owner            *dom.ULID // nil ならプリセット、値があればユーザー作成
+	PrimaryMuscles   []Muscle
+	SecondaryMuscles []Muscle
+	SecondaryParts   []string        // 補助的に使う部位のキー（所属する部位は含めない）
+	Equipment        Equipment       // 未設定の場合は空
+	MovementPattern  MovementPattern // 未設定の場合は空
+	Unilateral       bool            // 片手・片脚ずつ行う種目
+	Hidden           bool            // ユーザーが非表示にしたプリセット
+	DefaultRestSec   *int            // ユーザーが設定したセット間の休憩（秒）
+	TrainingMaxKg    *float64        // ユーザーが設定したトレーニングマックス（プログラムの重量計算に使う）
+}
+
+// Validate は種目名とメタデータの不変条件をチェックする
+// 器具・動作パターンは未設定（空）を許可する
+func (e WorkoutExerciseRef) Validate() error {
+	name := strings.TrimSpace(e.Name)
+	if name == "" || utf8.RuneCountInString(name) > maxExerciseNameLength {
+		return fmt.Errorf("%w: name must be 1-%d characters", ErrInvalidExercise, maxExerciseNameLength)
+	}
+	if e.Equipment != "" && !e.Equipment.Valid() {
+		return fmt.Errorf("%w: unknown equipment %q", ErrInvalidExercise, e.Equipment)
+	}
+	if e.MovementPattern != "" && !e.MovementPattern.Valid() {
+		return fmt.Errorf("%w: unknown movement pattern %q", ErrInvalidExercise, e.MovementPattern)
+	}
+	for _, m := range append(append([]Muscle{}, e.PrimaryMuscles...), e.SecondaryMuscles...) {
+		if !m.Valid() {
+			return fmt.Errorf("%w: unknown muscle %q", ErrInvalidExercise, m)
+		}
+	}
+	seen := make(map[string]bool, len(e.SecondaryParts))
+	for _, key := range e.SecondaryParts {
+		if strings.TrimSpace(key) == "" || seen[key] {
+			return fmt.Errorf("%w: secondary parts must be unique non-empty keys", ErrInvalidExercise)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// IsPreset はプリセット（全ユーザー共通）の種目かを返す
+func (e WorkoutExerciseRef) IsPreset() bool {
+	return e.Owner == nil
+}
+
+// LocalizedName は指定ロケールの名前を返す
+// 翻訳がなければデフォルトロケールの翻訳、それもなければ基本の名前を返す
+func (e WorkoutExerciseRef) LocalizedName(locale string) string {
+	names := make(map[string]string, len(e.Translations))
+	for _, t := range e.Translations {
+		names[t.Locale] = t.Name
+	}
+	return pickName(names, locale, e.Name)
+}
+
+// WorkoutExerciseTranslation represents a translation of a workout exercise name
+type WorkoutExerciseTranslation struct {
+	ID                ID
+	WorkoutExerciseID ID
+	Locale            string
+	Name              string
+}
+
+// pickName はロケール → デフォルトロケール → fallback の順で名前を選ぶ
+func pickName(names map[string]string, locale, fallback string) string {
+	if name := names[locale]; name != "" {
+		return name
+	}
+	if name := names[dom.DefaultLocale]; name != "" {
+		return name
+	}
+	return fallback
 }
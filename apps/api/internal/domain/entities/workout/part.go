@@ -16,3 +16,43 @@ type WorkoutPartTranslation struct {
 	Locale        string
 	Name          string
 }
+
+// IsPreset はプリセット（全ユーザー共通）の部位かを返す
+func (p WorkoutPart) IsPreset() bool {
+	return p.Owner == nil
+}
+
+// LocalizedName は指定ロケールの部位名を返す（翻訳がなければキー）
+func (p WorkoutPart) LocalizedName(locale string) string {
+	names := make(map[string]string, len(p.Translations))
+	for _, t := range p.Translations {
+		names[t.Locale] = t.Name
+	}
+	return pickName(names, locale, p.Key)
+}
+
+// MergeWorkoutParts はユーザーの部位を同じキーのプリセット部位にまとめる
+// プリセットにないキーのユーザー部位はプリセット部位の後ろに並べる
+func MergeWorkoutParts(parts []WorkoutPart) []WorkoutPart {
+	presetIdx := map[string]int{}
+	merged := make([]WorkoutPart, 0, len(parts))
+	for _, p := range parts {
+		if p.IsPreset() {
+			presetIdx[p.Key] = len(merged)
+			merged = append(merged, p)
+		}
+	}
+
+	for _, p := range parts {
+		if p.IsPreset() {
+			continue
+		}
+		if i, ok := presetIdx[p.Key]; ok {
+			merged[i].Exercises = append(merged[i].Exercises, p.Exercises...)
+			continue
+		}
+		merged = append(merged, p)
+	}
+
+	return merged
+}
@@ -0,0 +1,269 @@
+package workout
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	dom "gogym-api/internal/domain/entities"
+)
+
+// ErrInvalidProgression は漸進的過負荷の設定が不正な場合のエラー
+var ErrInvalidProgression = dom.NewError(dom.KindValidation, "invalid_progression", "invalid progression config")
+
+// ProgressionStrategy は重量・回数の伸ばし方
+type ProgressionStrategy string
+
+const (
+	ProgressionDouble ProgressionStrategy = "double_progression" // 回数を範囲の上限まで伸ばしてから重量を上げる
+	ProgressionLinear ProgressionStrategy = "linear"             // 目標回数をこなせたら毎回重量を上げる
+)
+
+// Valid は定義済みの伸ばし方かを返す
+func (s ProgressionStrategy) Valid() bool {
+	switch s {
+	case ProgressionDouble, ProgressionLinear:
+		return true
+	}
+	return false
+}
+
+// ProgressionRule は提案に使ったルール
+type ProgressionRule string
+
+const (
+	RuleNoHistory      ProgressionRule = "no_history"      // 分析できる記録がない
+	RuleIncreaseWeight ProgressionRule = "increase_weight" // 重量を上げる（補助付きは補助を減らす）
+	RuleAddReps        ProgressionRule = "add_reps"        // 同じ重量で回数を増やす
+	RuleRepeat         ProgressionRule = "repeat"          // 目標に届かなかったので同じ重量・回数をもう一度
+	RuleDeload         ProgressionRule = "deload"          // 同じ重量で続けて届かなかったので重量を下げる
+)
+
+const (
+	maxProgressionSessions = 10
+	maxProgressionReps     = 100
+	maxDeloadPercent       = 50.0
+	maxProgressionStepKg   = 50.0
+)
+
+// ProgressionConfig は提案のルールの設定
+type ProgressionConfig struct {
+	Strategy          ProgressionStrategy
+	RepsMin           int       // linear ではこの回数を目標にする
+	RepsMax           int       // double_progression で重量を上げる回数
+	Increment         *WeightKg // 重量を上げる幅（nil なら器具ごとの既定値）
+	Sessions          int       // 分析する直近のセッション数
+	DeloadAfterMisses int       // 同じ重量で続けて目標に届かなかったらディロードするセッション数
+	DeloadPercent     float64   // ディロードで下げる割合（%）
+}
+
+// DefaultProgressionConfig は提案の既定の設定を返す（8〜12回のダブルプログレッション）
+func DefaultProgressionConfig() ProgressionConfig {
+	return ProgressionConfig{
+		Strategy:          ProgressionDouble,
+		RepsMin:           8,
+		RepsMax:           12,
+		Sessions:          3,
+		DeloadAfterMisses: 2,
+		DeloadPercent:     10,
+	}
+}
+
+// Validate は設定値をチェックする
+func (c ProgressionConfig) Validate() error {
+	if !c.Strategy.Valid() {
+		return fmt.Errorf("%w: unknown strategy %q", ErrInvalidProgression, c.Strategy)
+	}
+	if c.RepsMin < 1 || c.RepsMax < c.RepsMin || c.RepsMax > maxProgressionReps {
+		return fmt.Errorf("%w: reps range must satisfy 1 <= min <= max <= %d", ErrInvalidProgression, maxProgressionReps)
+	}
+	if c.Increment != nil && (*c.Increment < 0 || *c.Increment > maxProgressionStepKg) {
+		return fmt.Errorf("%w: increment must be 0-%.0f kg", ErrInvalidProgression, maxProgressionStepKg)
+	}
+	if c.Sessions < 1 || c.Sessions > maxProgressionSessions {
+		return fmt.Errorf("%w: sessions must be 1-%d", ErrInvalidProgression, maxProgressionSessions)
+	}
+	if c.DeloadAfterMisses < 1 || c.DeloadAfterMisses > c.Sessions {
+		return fmt.Errorf("%w: deload_after must be 1-%d (number of sessions)", ErrInvalidProgression, c.Sessions)
+	}
+	if c.DeloadPercent <= 0 || c.DeloadPercent > maxDeloadPercent {
+		return fmt.Errorf("%w: deload percent must be greater than 0 and at most %.0f", ErrInvalidProgression, maxDeloadPercent)
+	}
+	return nil
+}
+
+// DefaultIncrement は器具ごとの重量を上げる既定の幅を返す
+// 自重・バンドは重量ではなく回数で伸ばすため 0
+func DefaultIncrement(e Equipment) WeightKg {
+	switch e {
+	case EquipmentDumbbell:
+		return 2
+	case EquipmentMachine:
+		return 5
+	case EquipmentKettlebell:
+		return 4
+	case EquipmentBodyweight, EquipmentBand:
+		return 0
+	}
+	return 2.5
+}
+
+// SessionPerformance はセッションでの種目の出来（最も高い負荷の作業セットで判定する）
+type SessionPerformance struct {
+	PerformedDate time.Time
+	Weight        WeightKg // 最も重い重量（補助付きは最も軽い補助重量）
+	Reps          []Reps   // その重量で行った各セットの回数
+	Missed        bool     // 下限（linear では目標）の回数に届かないセットがあった
+	ReachedTop    bool     // すべてのセットが重量を上げる回数に届いた
+}
+
+// ProgressionSuggestion は次のセッションの重量・回数の提案
+type ProgressionSuggestion struct {
+	Exercise  WorkoutExerciseRef
+	Config    ProgressionConfig
+	Rule      ProgressionRule
+	Kind      SetKind
+	Weight    WeightKg
+	Reps      Reps
+	Sets      int
+	Increment WeightKg             // 実際に使った幅
+	Misses    int                  // 同じ重量で続けて目標に届かなかったセッション数
+	History   []SessionPerformance // 新しい順
+}
+
+// SuggestProgression は直近のセッション（新しい順）から exerciseID の次の重量・回数を提案する
+// 分析するのはウォームアップ以外の回数のセットで、最新のセッションと同じ種類のセットに限る
+func SuggestProgression(exerciseID ID, records []WorkoutRecord, cfg ProgressionConfig) ProgressionSuggestion {
+	s := ProgressionSuggestion{Config: cfg, Rule: RuleNoHistory}
+
+	for _, r := range records {
+		if len(s.History) == cfg.Sessions {
+			break
+		}
+		sets := make([]WorkoutSet, 0)
+		for _, set := range r.SetsOf(exerciseID) {
+			if !set.IsWorking() || !set.IsRepBased() || set.Reps <= 0 {
+				continue
+			}
+			if s.Kind != "" && set.KindOrDefault() != s.Kind {
+				continue
+			}
+			sets = append(sets, set)
+		}
+		if len(sets) == 0 {
+			continue
+		}
+		if s.Kind == "" {
+			s.Exercise = sets[0].Exercise
+			s.Kind = sets[0].KindOrDefault()
+		}
+		s.History = append(s.History, s.performance(r.PerformedDate, sets))
+	}
+	if len(s.History) == 0 {
+		return s
+	}
+
+	s.Increment = DefaultIncrement(s.Exercise.Equipment)
+	if s.Exercise.Equipment == "" && s.Kind == SetKindBodyweight {
+		s.Increment = DefaultIncrement(EquipmentBodyweight)
+	}
+	if cfg.Increment != nil {
+		s.Increment = *cfg.Increment
+	}
+
+	last := s.History[0]
+	s.Sets = len(last.Reps)
+	s.Weight = last.Weight
+	for _, p := range s.History {
+		if !p.Missed || p.Weight != last.Weight {
+			break
+		}
+		s.Misses++
+	}
+
+	minReps := slices.Min(last.Reps)
+	switch {
+	case s.Misses >= cfg.DeloadAfterMisses:
+		s.Rule = RuleDeload
+		s.Weight = s.deloadWeight(last.Weight)
+		s.Reps = Reps(cfg.RepsMin)
+	case last.Missed:
+		s.Rule = RuleRepeat
+		s.Reps = Reps(cfg.RepsMin)
+	case (cfg.Strategy == ProgressionLinear || last.ReachedTop) && s.Increment > 0:
+		s.Rule = RuleIncreaseWeight
+		s.Weight = s.increase(last.Weight)
+		s.Reps = Reps(cfg.RepsMin)
+		if s.Kind == SetKindAssisted && s.Weight == 0 {
+			s.Kind = SetKindBodyweight // 補助が要らなくなったら自重で行う
+		}
+	default:
+		// 重量を上げられない（幅が 0）場合は上限を超えても回数で伸ばす
+		s.Rule = RuleAddReps
+		s.Reps = minReps + 1
+		if s.Increment > 0 {
+			s.Reps = min(s.Reps, Reps(cfg.RepsMax))
+		}
+	}
+	return s
+}
+
+// performance はセッションの作業セットから出来を求める
+func (s ProgressionSuggestion) performance(date time.Time, sets []WorkoutSet) SessionPerformance {
+	top := sets[0].Weight
+	for _, set := range sets[1:] {
+		if (s.Kind == SetKindAssisted && set.Weight < top) || (s.Kind != SetKindAssisted && set.Weight > top) {
+			top = set.Weight
+		}
+	}
+
+	target := s.Config.RepsMax
+	if s.Config.Strategy == ProgressionLinear {
+		target = s.Config.RepsMin
+	}
+	p := SessionPerformance{PerformedDate: date, Weight: top, ReachedTop: true}
+	for _, set := range sets {
+		if set.Weight != top {
+			continue
+		}
+		p.Reps = append(p.Reps, set.Reps)
+		if int(set.Reps) < s.Config.RepsMin {
+			p.Missed = true
+		}
+		if int(set.Reps) < target {
+			p.ReachedTop = false
+		}
+	}
+	return p
+}
+
+// increase は重量を1段階重くする（補助付きは補助を減らす）
+// 前回の重量が幅の単位からずれていても、プレートで組める重量（幅の倍数）に丸める
+func (s ProgressionSuggestion) increase(w WeightKg) WeightKg {
+	if s.Kind == SetKindAssisted {
+		return max(s.roundToIncrement(float64(w-s.Increment)), 0)
+	}
+	return s.roundToIncrement(float64(w + s.Increment))
+}
+
+// roundToIncrement は重量を最も近い幅の倍数に丸める（幅が 0 なら小数第2位までに丸めるだけ）
+func (s ProgressionSuggestion) roundToIncrement(w float64) WeightKg {
+	if s.Increment > 0 {
+		w = math.Round(w/float64(s.Increment)) * float64(s.Increment)
+	}
+	return WeightKg(math.Round(w*100) / 100)
+}
+
+// deloadWeight はディロード後の重量を返す
+// 補助付きは補助を1段階増やし、それ以外は DeloadPercent 下げて幅の単位に切り下げる
+func (s ProgressionSuggestion) deloadWeight(w WeightKg) WeightKg {
+	if s.Kind == SetKindAssisted {
+		return w + max(s.Increment, 1)
+	}
+	deloaded := float64(w) * (1 - s.Config.DeloadPercent/100)
+	if s.Increment > 0 {
+		deloaded = math.Floor(deloaded/float64(s.Increment)) * float64(s.Increment)
+	}
+	return WeightKg(math.Round(deloaded*100) / 100)
+}
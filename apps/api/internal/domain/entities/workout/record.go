@@ -1,8 +1,11 @@
 package workout
 
 import (
-	"errors"
+	"fmt"
+	"sort"
 	"time"
+
+	dom "gogym-api/internal/domain/entities"
 )
 
 // ConditionLevel represents the physical condition level (1-5)
@@ -17,12 +20,21 @@ const (
 	Cond5       ConditionLevel = 5
 )
 
+var (
+	// ErrRecordNotFound はワークアウト記録が存在しない（または他ユーザーの記録）場合のエラー
+	ErrRecordNotFound = dom.NewError(dom.KindNotFound, "workout_record_not_found", "workout record not found")
+	// ErrInvalidRecord はワークアウト記録の時刻・セット番号が不正な場合のエラー
+	ErrInvalidRecord = dom.NewError(dom.KindValidation, "invalid_workout_record", "invalid workout record")
+)
+
 // WorkoutRecord represents a complete workout session
+// 1日に複数のセッション（朝のラン、夜の筋トレなど）を記録できる
 type WorkoutRecord struct {
 	ID            *ID
 	UserID        ULID
 	GymID         *ID
 	GymName       *string    // ジム名（表示用）
+	TemplateID    *ID        // テンプレートから開始したセッションの場合のテンプレート
 	PerformedDate time.Time  // DATE を day-start に固定、時刻は別扱い
 	StartedAt     *time.Time // 実日時（PerformedDateに紐づけて作る）
 	EndedAt       *time.Time
@@ -37,7 +49,7 @@ type WorkoutRecord struct {
 // NewWorkoutRecord creates a new workout record
 func NewWorkoutRecord(user ULID, performedDate time.Time) (*WorkoutRecord, error) {
 	if user == "" {
-		return nil, errors.New("user required")
+		return nil, fmt.Errorf("%w: user required", ErrInvalidRecord)
 	}
 	// performedDate は時刻00:00に正規化しておく設計が楽
 	pd := time.Date(performedDate.Year(), performedDate.Month(), performedDate.Day(), 0, 0, 0, 0, performedDate.Location())
@@ -52,7 +64,7 @@ func NewWorkoutRecord(user ULID, performedDate time.Time) (*WorkoutRecord, error
 // SetTimes sets the start and end times for the workout
 func (r *WorkoutRecord) SetTimes(start, end *time.Time) error {
 	if start != nil && end != nil && start.After(*end) {
-		return errors.New("start must be <= end")
+		return fmt.Errorf("%w: start must be <= end", ErrInvalidRecord)
 	}
 	r.StartedAt, r.EndedAt = start, end
 	r.recalcDuration()
@@ -74,21 +86,74 @@ func (r *WorkoutRecord) recalcDuration() {
 // AddSet adds a workout set to the record
 func (r *WorkoutRecord) AddSet(s WorkoutSet) error {
 	if s.SetNumber <= 0 {
-		return errors.New("setNumber must be >= 1")
+		return fmt.Errorf("%w: setNumber must be >= 1", ErrInvalidRecord)
+	}
+	if s.Kind == "" {
+		s.Kind = SetKindWeightReps
+	}
+	if s.Type == "" {
+		s.Type = SetTypeNormal
 	}
-	if !s.Weight.Valid() || !s.Reps.Valid() {
-		return errors.New("invalid weight or reps")
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	// 推定1RMはセットの記録値から求める（ウォームアップは PR の対象外）
+	s.EstimatedMax = nil
+	if e1rm, ok := s.EstimateOneRepMax(); ok {
+		s.EstimatedMax = &e1rm
 	}
 	// (exerciseID, setNumber) の一意性
 	for _, cur := range r.Sets {
 		if cur.Exercise.ID == s.Exercise.ID && cur.SetNumber == s.SetNumber {
-			return errors.New("duplicate setNumber for the exercise")
+			return fmt.Errorf("%w: duplicate setNumber for the exercise", ErrInvalidRecord)
 		}
 	}
 	r.Sets = append(r.Sets, s)
 	return nil
 }
 
+// SetsOf は指定種目のセットを記録の順に返す
+func (r *WorkoutRecord) SetsOf(exerciseID ID) []WorkoutSet {
+	var sets []WorkoutSet
+	for _, s := range r.Sets {
+		if s.Exercise.ID == exerciseID {
+			sets = append(sets, s)
+		}
+	}
+	return sets
+}
+
+// Volume はセッションの総ボリューム（ウォームアップを除く負荷×回数の合計）を返す
+func (r *WorkoutRecord) Volume() WeightKg {
+	var total WeightKg
+	for _, s := range r.Sets {
+		total += s.Volume()
+	}
+	return total
+}
+
+// DeriveRest は同じ種目の直前のセット（セット番号順）との完了時刻の差を休憩として設定する
+// どちらかの完了時刻がない、または差が正でない場合は休憩なし
+func (r *WorkoutRecord) DeriveRest() {
+	order := make([]int, len(r.Sets))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool { return r.Sets[order[a]].SetNumber < r.Sets[order[b]].SetNumber })
+
+	prev := map[ID]*time.Time{}
+	for _, i := range order {
+		s := &r.Sets[i]
+		s.Rest = nil
+		if last := prev[s.Exercise.ID]; last != nil && s.CompletedAt != nil {
+			if rest := s.CompletedAt.Sub(*last); rest > 0 {
+				s.Rest = &rest
+			}
+		}
+		prev[s.Exercise.ID] = s.CompletedAt
+	}
+}
+
 // ReorderSets reorders sets for a given exercise (1..N)
 func (r *WorkoutRecord) ReorderSets(exerciseID ID) {
 	// 同一 exercise の setNumber を 1..N に詰め直すユーティリティ（必要なら）
@@ -0,0 +1,48 @@
+package workout
+
+import (
+	"sort"
+	"time"
+)
+
+// RestSummary は種目ごとのセット間の休憩の集計
+type RestSummary struct {
+	Exercise WorkoutExerciseRef
+	Average  time.Duration
+	Samples  int // 休憩を求められたセット数
+}
+
+// ExceedsDefault は平均の休憩がユーザーの設定した休憩より長いかを返す
+func (s RestSummary) ExceedsDefault() bool {
+	return s.Exercise.DefaultRestSec != nil && s.Average > time.Duration(*s.Exercise.DefaultRestSec)*time.Second
+}
+
+// SummarizeRest はセッションのセット間の休憩を種目ごとに平均し、種目ID順に返す
+// 休憩は WorkoutRecord.DeriveRest で求めた値を使い、休憩のない種目は含めない
+func SummarizeRest(records []WorkoutRecord) []RestSummary {
+	byExercise := map[ID]*RestSummary{}
+	totals := map[ID]time.Duration{}
+	for _, r := range records {
+		for _, s := range r.Sets {
+			if s.Rest == nil {
+				continue
+			}
+			sum, ok := byExercise[s.Exercise.ID]
+			if !ok {
+				sum = &RestSummary{Exercise: s.Exercise}
+				byExercise[s.Exercise.ID] = sum
+			}
+			sum.Samples++
+			totals[s.Exercise.ID] += *s.Rest
+		}
+	}
+
+	summaries := make([]RestSummary, 0, len(byExercise))
+	for id, sum := range byExercise {
+		sum.Average = totals[id] / time.Duration(sum.Samples)
+		summaries = append(summaries, *sum)
+	}
+	sort.Slice(summaries, func(i, j int) bool { return summaries[i].Exercise.ID < summaries[j].Exercise.ID })
+
+	return summaries
+}
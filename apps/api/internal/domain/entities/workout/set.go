@@ -1,9 +1,18 @@
 package workout
 
 import (
+	"fmt"
+	"math"
+	"strings"
 	"time"
+	"unicode/utf8"
+
+	dom "gogym-api/internal/domain/entities"
 )
 
+// ErrInvalidSet はセットの種類に対して記録値が不正な場合のエラー
+var ErrInvalidSet = dom.NewError(dom.KindValidation, "invalid_set", "invalid workout set")
+
 type WeightKg float64
 
 type Reps int
@@ -12,14 +21,234 @@ func (w WeightKg) Valid() bool { return w >= 0 }
 
 func (r Reps) Valid() bool { return r >= 0 }
 
+// SetKind はセットの記録方法
+type SetKind string
+
+const (
+	SetKindWeightReps SetKind = "weight_reps" // 重量×回数（既定）
+	SetKindBodyweight SetKind = "bodyweight"  // 自重×回数（Weight は加重）
+	SetKindAssisted   SetKind = "assisted"    // 補助付き×回数（Weight は補助重量）
+	SetKindTimed      SetKind = "timed"       // 時間（プランクなど）
+	SetKindDistance   SetKind = "distance"    // 距離（ラン・ローイングなど）
+)
+
+// Valid は定義済みのセット種類かを返す
+func (k SetKind) Valid() bool {
+	switch k {
+	case SetKindWeightReps, SetKindBodyweight, SetKindAssisted, SetKindTimed, SetKindDistance:
+		return true
+	}
+	return false
+}
+
+// SetType はセットの目的・やり方の区分
+type SetType string
+
+const (
+	SetTypeNormal  SetType = "normal"  // 通常のメインセット（既定）
+	SetTypeWarmup  SetType = "warmup"  // ウォームアップ（ボリューム・PRの集計から除く）
+	SetTypeDrop    SetType = "drop"    // ドロップセット
+	SetTypeFailure SetType = "failure" // 限界まで行ったセット
+)
+
+// Valid は定義済みのセット区分かを返す
+func (t SetType) Valid() bool {
+	switch t {
+	case SetTypeNormal, SetTypeWarmup, SetTypeDrop, SetTypeFailure:
+		return true
+	}
+	return false
+}
+
+const (
+	minRPE                 = 1.0
+	maxRPE                 = 10.0
+	maxRIR                 = 10
+	maxSupersetGroupLength = 20
+	epleyRepsDivisor       = 30.0 // Epley 式: 1RM = 負荷 × (1 + 回数/30)
+)
+
 type WorkoutSet struct {
-	ID           *ID
-	Exercise     WorkoutExerciseRef
-	SetNumber    int
-	Weight       WeightKg
-	Reps         Reps
-	EstimatedMax *float64
-	Note         *string
-	CreatedAt    time.Time
-	UpdatedAt    time.Time
+	ID            *ID
+	Exercise      WorkoutExerciseRef
+	SetNumber     int
+	Kind          SetKind  // 空なら重量×回数
+	Weight        WeightKg // 重量×回数では重量、自重では加重、補助付きでは補助重量
+	Reps          Reps
+	DurationSec   *int
+	DistanceM     *float64
+	BodyweightKg  *WeightKg      // 記録時の体重（自重・補助付きの負荷計算に使う）
+	Type          SetType        // 空なら通常セット
+	RPE           *float64       // 主観的運動強度（1〜10、0.5刻み）
+	RIR           *int           // 余力の回数（Reps In Reserve）
+	SupersetGroup *string        // 同じキーの種目をスーパーセットとしてまとめる（例: "A"）
+	EstimatedMax  *float64       // 推定1RM（ウォームアップ以外の回数セットのみ）
+	CompletedAt   *time.Time     // セットを終えた時刻（クライアントが記録）
+	Rest          *time.Duration // 派生値：同じ種目の直前のセットからの休憩（WorkoutRecord.DeriveRest）
+	Note          *string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// KindOrDefault はセットの種類を返す（未指定なら重量×回数）
+func (s WorkoutSet) KindOrDefault() SetKind {
+	if s.Kind == "" {
+		return SetKindWeightReps
+	}
+	return s.Kind
+}
+
+// TypeOrDefault はセットの区分を返す（未指定なら通常セット）
+func (s WorkoutSet) TypeOrDefault() SetType {
+	if s.Type == "" {
+		return SetTypeNormal
+	}
+	return s.Type
+}
+
+// IsWorking はボリューム・PRの集計対象（ウォームアップ以外）のセットかを返す
+func (s WorkoutSet) IsWorking() bool {
+	return s.TypeOrDefault() != SetTypeWarmup
+}
+
+// IsEmpty は種類ごとの主な記録値が入っていない（入力途中の）セットかを返す
+func (s WorkoutSet) IsEmpty() bool {
+	switch s.KindOrDefault() {
+	case SetKindWeightReps:
+		return s.Weight == 0 && s.Reps == 0
+	case SetKindBodyweight, SetKindAssisted:
+		return s.Reps == 0
+	case SetKindTimed:
+		return s.DurationSec == nil || *s.DurationSec == 0
+	case SetKindDistance:
+		return s.DistanceM == nil || *s.DistanceM == 0
+	}
+	return false
+}
+
+// Validate はセットの種類に応じて記録値を検証する
+func (s WorkoutSet) Validate() error {
+	kind := s.KindOrDefault()
+	if !kind.Valid() {
+		return fmt.Errorf("%w: unknown kind %q", ErrInvalidSet, s.Kind)
+	}
+	if !s.Weight.Valid() || !s.Reps.Valid() {
+		return fmt.Errorf("%w: weight and reps must be >= 0", ErrInvalidSet)
+	}
+	if s.DurationSec != nil && *s.DurationSec < 0 {
+		return fmt.Errorf("%w: duration must be >= 0", ErrInvalidSet)
+	}
+	if s.DistanceM != nil && *s.DistanceM < 0 {
+		return fmt.Errorf("%w: distance must be >= 0", ErrInvalidSet)
+	}
+	if s.BodyweightKg != nil && *s.BodyweightKg <= 0 {
+		return fmt.Errorf("%w: bodyweight must be > 0", ErrInvalidSet)
+	}
+	if s.IsEmpty() {
+		return fmt.Errorf("%w: %s set has no value", ErrInvalidSet, kind)
+	}
+	if err := s.validateAnnotations(); err != nil {
+		return err
+	}
+
+	switch kind {
+	case SetKindWeightReps, SetKindBodyweight, SetKindAssisted:
+		if s.DurationSec != nil || s.DistanceM != nil {
+			return fmt.Errorf("%w: %s set cannot have duration or distance", ErrInvalidSet, kind)
+		}
+	case SetKindTimed:
+		if s.DistanceM != nil {
+			return fmt.Errorf("%w: timed set cannot have distance", ErrInvalidSet)
+		}
+	}
+	if kind == SetKindAssisted {
+		if s.Weight == 0 {
+			return fmt.Errorf("%w: assisted set requires assistance weight", ErrInvalidSet)
+		}
+		if s.BodyweightKg != nil && s.Weight > *s.BodyweightKg {
+			return fmt.Errorf("%w: assistance weight exceeds bodyweight", ErrInvalidSet)
+		}
+	}
+
+	return nil
+}
+
+// validateAnnotations はセット区分・RPE・RIR・スーパーセットのキーを検証する
+func (s WorkoutSet) validateAnnotations() error {
+	if !s.TypeOrDefault().Valid() {
+		return fmt.Errorf("%w: unknown type %q", ErrInvalidSet, s.Type)
+	}
+	if s.RPE != nil {
+		rpe := *s.RPE
+		if rpe < minRPE || rpe > maxRPE || math.Mod(rpe*2, 1) != 0 {
+			return fmt.Errorf("%w: rpe must be %.0f-%.0f in 0.5 steps", ErrInvalidSet, minRPE, maxRPE)
+		}
+	}
+	if s.RIR != nil && (*s.RIR < 0 || *s.RIR > maxRIR) {
+		return fmt.Errorf("%w: rir must be 0-%d", ErrInvalidSet, maxRIR)
+	}
+	if s.SupersetGroup != nil {
+		group := strings.TrimSpace(*s.SupersetGroup)
+		if group == "" || utf8.RuneCountInString(group) > maxSupersetGroupLength {
+			return fmt.Errorf("%w: superset group must be 1-%d characters", ErrInvalidSet, maxSupersetGroupLength)
+		}
+	}
+	return nil
+}
+
+// EffectiveLoad は体にかかった負荷（kg）を返す
+// 自重・補助付きのセットで体重の記録がない場合は ok=false
+func (s WorkoutSet) EffectiveLoad() (load WeightKg, ok bool) {
+	switch s.KindOrDefault() {
+	case SetKindBodyweight:
+		if s.BodyweightKg == nil {
+			return 0, false
+		}
+		return *s.BodyweightKg + s.Weight, true
+	case SetKindAssisted:
+		if s.BodyweightKg == nil {
+			return 0, false
+		}
+		return max(*s.BodyweightKg-s.Weight, 0), true
+	}
+	return s.Weight, true
+}
+
+// IsRepBased は回数で記録する種類のセットかを返す
+func (s WorkoutSet) IsRepBased() bool {
+	switch s.KindOrDefault() {
+	case SetKindWeightReps, SetKindBodyweight, SetKindAssisted:
+		return true
+	}
+	return false
+}
+
+// Volume はセットのボリューム（負荷×回数）を返す
+// ウォームアップ・時間・距離のセットと、負荷が分からないセットは 0
+func (s WorkoutSet) Volume() WeightKg {
+	if !s.IsWorking() || !s.IsRepBased() {
+		return 0
+	}
+	load, ok := s.EffectiveLoad()
+	if !ok {
+		return 0
+	}
+	return load * WeightKg(s.Reps)
+}
+
+// EstimateOneRepMax は Epley 式で推定1RMを返す
+// ウォームアップ・時間・距離のセットと、負荷や回数がないセットは ok=false
+func (s WorkoutSet) EstimateOneRepMax() (float64, bool) {
+	if !s.IsWorking() || !s.IsRepBased() || s.Reps <= 0 {
+		return 0, false
+	}
+	load, ok := s.EffectiveLoad()
+	if !ok || load <= 0 {
+		return 0, false
+	}
+	if s.Reps == 1 {
+		return float64(load), true
+	}
+	e1rm := float64(load) * (1 + float64(s.Reps)/epleyRepsDivisor)
+	return math.Round(e1rm*100) / 100, true
 }
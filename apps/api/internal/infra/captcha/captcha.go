@@ -0,0 +1,70 @@
+// Package captcha は siteverify 形式（Cloudflare Turnstile / hCaptcha / reCAPTCHA 互換）の CAPTCHA 検証クライアント
+package captcha
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"gogym-api/internal/configs"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+type Client struct {
+	httpClient *http.Client
+	verifyURL  string
+	secret     string
+}
+
+func NewClient(cc configs.CaptchaConfig) (*Client, error) {
+	return &Client{
+		httpClient: &http.Client{Timeout: 5 * time.Second},
+		verifyURL:  cc.VerifyURL,
+		secret:     cc.Secret,
+	}, nil
+}
+
+type verifyResponse struct {
+	Success    bool     `json:"success"`
+	ErrorCodes []string `json:"error-codes"`
+}
+
+// Enabled はシークレットが設定されている場合のみ検証を行う
+func (c *Client) Enabled() bool {
+	return c.secret != ""
+}
+
+// Verify はトークンをプロバイダーの siteverify API で検証する
+func (c *Client) Verify(ctx context.Context, token, remoteIP string) (bool, error) {
+	form := url.Values{}
+	form.Set("secret", c.secret)
+	form.Set("response", token)
+	if remoteIP != "" {
+		form.Set("remoteip", remoteIP)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.verifyURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return false, fmt.Errorf("captcha verify request failed: status=%d", resp.StatusCode)
+	}
+
+	var body verifyResponse
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return false, fmt.Errorf("failed to decode captcha verify response: %w", err)
+	}
+
+	return body.Success, nil
+}
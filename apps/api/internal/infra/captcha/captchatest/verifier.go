@@ -0,0 +1,39 @@
+// Package captchatest はテスト用の CAPTCHA 検証の偽実装を提供する
+package captchatest
+
+import (
+	"context"
+	"sync"
+)
+
+// Verifier は ValidToken と一致するトークンのみ成功とする偽の検証器
+type Verifier struct {
+	ValidToken string
+	Err        error // 設定すると Verify がこのエラーを返す
+	Disabled   bool
+
+	mu    sync.Mutex
+	calls []string
+}
+
+func (v *Verifier) Enabled() bool {
+	return !v.Disabled
+}
+
+func (v *Verifier) Verify(ctx context.Context, token, remoteIP string) (bool, error) {
+	v.mu.Lock()
+	v.calls = append(v.calls, token)
+	v.mu.Unlock()
+
+	if v.Err != nil {
+		return false, v.Err
+	}
+	return token == v.ValidToken, nil
+}
+
+// Calls は Verify に渡されたトークンを返す
+func (v *Verifier) Calls() []string {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return append([]string(nil), v.calls...)
+}
@@ -0,0 +1,135 @@
+// Package catalog はバイナリに埋め込んだプリセット種目カタログを読み込む
+package catalog
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+
+	dom "gogym-api/internal/domain/entities"
+	dw "gogym-api/internal/domain/entities/workout"
+)
+
+//go:embed presets.json
+var presetsJSON []byte
+
+type file struct {
+	Version   int        `json:"version"`
+	Parts     []part     `json:"parts"`
+	Exercises []exercise `json:"exercises"`
+}
+
+type part struct {
+	Key   string            `json:"key"`
+	Names map[string]string `json:"names"`
+}
+
+type exercise struct {
+	Key              string            `json:"key"`
+	Part             string            `json:"part"`
+	Names            map[string]string `json:"names"`
+	PrimaryMuscles   []string          `json:"primary_muscles"`
+	SecondaryMuscles []string          `json:"secondary_muscles"`
+	SecondaryParts   []string          `json:"secondary_parts"`
+	Equipment        string            `json:"equipment"`
+	MovementPattern  string            `json:"movement_pattern"`
+	Unilateral       bool              `json:"unilateral"`
+}
+
+// Catalog は埋め込みのプリセットカタログ
+type Catalog struct{}
+
+func NewCatalog() *Catalog {
+	return &Catalog{}
+}
+
+// Presets はプリセットの部位と、部位ごとの種目を返す
+func (c *Catalog) Presets() ([]dw.WorkoutPart, error) {
+	return parse(presetsJSON)
+}
+
+func parse(data []byte) ([]dw.WorkoutPart, error) {
+	var f file
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("failed to parse preset catalog: %w", err)
+	}
+
+	parts := make([]dw.WorkoutPart, 0, len(f.Parts))
+	partIdx := map[string]int{}
+	for _, p := range f.Parts {
+		if p.Key == "" || p.Names[dom.DefaultLocale] == "" {
+			return nil, fmt.Errorf("preset part %q: key and ja name are required", p.Key)
+		}
+		if _, dup := partIdx[p.Key]; dup {
+			return nil, fmt.Errorf("preset part %q: duplicate key", p.Key)
+		}
+
+		translations := make([]dw.WorkoutPartTranslation, 0, len(p.Names))
+		for _, locale := range dom.SupportedLocales {
+			if name := p.Names[locale]; name != "" {
+				translations = append(translations, dw.WorkoutPartTranslation{Locale: locale, Name: name})
+			}
+		}
+
+		partIdx[p.Key] = len(parts)
+		parts = append(parts, dw.WorkoutPart{Key: p.Key, Translations: translations})
+	}
+
+	seen := map[string]bool{}
+	for _, e := range f.Exercises {
+		i, ok := partIdx[e.Part]
+		if !ok {
+			return nil, fmt.Errorf("preset exercise %q: unknown part %q", e.Key, e.Part)
+		}
+		if e.Key == "" || e.Names[dom.DefaultLocale] == "" {
+			return nil, fmt.Errorf("preset exercise %q: key and ja name are required", e.Key)
+		}
+		if seen[e.Key] {
+			return nil, fmt.Errorf("preset exercise %q: duplicate key", e.Key)
+		}
+		seen[e.Key] = true
+
+		translations := make([]dw.WorkoutExerciseTranslation, 0, len(e.Names))
+		for _, locale := range dom.SupportedLocales {
+			if name := e.Names[locale]; name != "" {
+				translations = append(translations, dw.WorkoutExerciseTranslation{Locale: locale, Name: name})
+			}
+		}
+
+		ref := dw.WorkoutExerciseRef{
+			Key:              e.Key,
+			Name:             e.Names[dom.DefaultLocale],
+			Translations:     translations,
+			PrimaryMuscles:   toMuscles(e.PrimaryMuscles),
+			SecondaryMuscles: toMuscles(e.SecondaryMuscles),
+			SecondaryParts:   e.SecondaryParts,
+			Equipment:        dw.Equipment(e.Equipment),
+			MovementPattern:  dw.MovementPattern(e.MovementPattern),
+			Unilateral:       e.Unilateral,
+		}
+		if err := ref.Validate(); err != nil {
+			return nil, fmt.Errorf("preset exercise %q: %w", e.Key, err)
+		}
+		// プリセットは器具・動作パターンを必ず持ち、補助部位はカタログの部位を参照する
+		if ref.Equipment == "" || ref.MovementPattern == "" {
+			return nil, fmt.Errorf("preset exercise %q: equipment and movement pattern are required", e.Key)
+		}
+		for _, key := range ref.SecondaryParts {
+			if _, ok := partIdx[key]; !ok || key == e.Part {
+				return nil, fmt.Errorf("preset exercise %q: invalid secondary part %q", e.Key, key)
+			}
+		}
+
+		parts[i].Exercises = append(parts[i].Exercises, ref)
+	}
+
+	return parts, nil
+}
+
+func toMuscles(values []string) []dw.Muscle {
+	out := make([]dw.Muscle, 0, len(values))
+	for _, v := range values {
+		out = append(out, dw.Muscle(v))
+	}
+	return out
+}
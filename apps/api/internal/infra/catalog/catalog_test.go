@@ -0,0 +1,70 @@
+package catalog
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	dw "gogym-api/internal/domain/entities/workout"
+)
+
+func TestCatalog_Presets(t *testing.T) {
+	t.Parallel()
+
+	t.Run("正常系: 埋め込みカタログを読み込める", func(t *testing.T) {
+		t.Parallel()
+
+		parts, err := NewCatalog().Presets()
+		require.NoError(t, err)
+		require.Len(t, parts, 6)
+
+		total := 0
+		for _, p := range parts {
+			require.Len(t, p.Translations, 2)
+			require.NotEmpty(t, p.Exercises, p.Key)
+			for _, e := range p.Exercises {
+				require.Len(t, e.Translations, 2, e.Key)
+				require.NotEmpty(t, e.PrimaryMuscles, e.Key)
+				require.NotEmpty(t, e.MovementPattern, e.Key)
+				require.True(t, e.IsPreset(), e.Key)
+			}
+			total += len(p.Exercises)
+		}
+		require.GreaterOrEqual(t, total, 200)
+	})
+
+	t.Run("正常系: 補助部位・片側フラグを読み込める", func(t *testing.T) {
+		t.Parallel()
+
+		data := `{"parts":[{"key":"legs","names":{"ja":"脚"}},{"key":"back","names":{"ja":"背中"}}],"exercises":[
+			{"key":"a","part":"legs","names":{"ja":"A"},"primary_muscles":["quads"],"secondary_parts":["back"],"equipment":"dumbbell","movement_pattern":"lunge","unilateral":true}]}`
+		parts, err := parse([]byte(data))
+		require.NoError(t, err)
+
+		ex := parts[0].Exercises[0]
+		require.Equal(t, []string{"back"}, ex.SecondaryParts)
+		require.Equal(t, dw.MovementLunge, ex.MovementPattern)
+		require.True(t, ex.Unilateral)
+	})
+
+	t.Run("異常系: 存在しない部位・筋肉・器具を参照するとエラー", func(t *testing.T) {
+		t.Parallel()
+
+		base := `{"parts":[{"key":"chest","names":{"ja":"胸"}},{"key":"arms","names":{"ja":"腕"}}],"exercises":[%s]}`
+		cases := []string{
+			`{"key":"a","part":"legs","names":{"ja":"A"},"equipment":"barbell","movement_pattern":"squat"}`,
+			`{"key":"a","part":"chest","names":{"ja":"A"},"primary_muscles":["wings"],"equipment":"barbell","movement_pattern":"horizontal_push"}`,
+			`{"key":"a","part":"chest","names":{"ja":"A"},"equipment":"spaceship","movement_pattern":"horizontal_push"}`,
+			`{"key":"a","part":"chest","names":{"ja":"A"},"equipment":"barbell","movement_pattern":"dance"}`,
+			`{"key":"a","part":"chest","names":{"ja":"A"},"equipment":"barbell"}`,
+			`{"key":"a","part":"chest","names":{"ja":"A"},"equipment":"barbell","movement_pattern":"horizontal_push","secondary_parts":["legs"]}`,
+			`{"key":"a","part":"chest","names":{"ja":"A"},"equipment":"barbell","movement_pattern":"horizontal_push","secondary_parts":["chest"]}`,
+			`{"key":"a","part":"chest","names":{"ja":"A"},"equipment":"barbell","movement_pattern":"horizontal_push"},{"key":"a","part":"chest","names":{"ja":"B"},"equipment":"barbell","movement_pattern":"horizontal_push"}`,
+		}
+		for _, c := range cases {
+			_, err := parse([]byte(fmt.Sprintf(base, c)))
+			require.Error(t, err, c)
+		}
+	})
+}
@@ -12,15 +12,21 @@ import (
 	"gorm.io/gorm/logger"
 )
 
-func NewDB(cfg configs.DatabaseConfig) (*gorm.DB, error) {
-	dsn := fmt.Sprintf(
-		"host=%s user=%s password=%s dbname=%s port=%s sslmode=disable TimeZone=Asia/Tokyo",
+// DSN はPostgreSQLの接続文字列を組み立てる
+// TIMESTAMP 列は UTC の時刻を保存する（ユーザーのタイムゾーンへの変換は入出力時に util.TimeZone で行う）
+func DSN(cfg configs.DatabaseConfig) string {
+	return fmt.Sprintf(
+		"host=%s user=%s password=%s dbname=%s port=%s sslmode=disable TimeZone=UTC",
 		cfg.Host,
 		cfg.User,
 		cfg.Password,
 		cfg.Database,
 		cfg.Port,
 	)
+}
+
+func NewDB(cfg configs.DatabaseConfig) (*gorm.DB, error) {
+	dsn := DSN(cfg)
 
 	// 環境に応じたログレベルの設定
 	var newLogger logger.Interface
@@ -35,11 +41,18 @@ func NewDB(cfg configs.DatabaseConfig) (*gorm.DB, error) {
 
 	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
 		Logger: newLogger,
+		// created_at などの自動設定もサーバーのローカル時刻ではなく UTC で保存する
+		NowFunc: func() time.Time { return time.Now().UTC() },
 	})
 	if err != nil {
 		return nil, fmt.Errorf("データベース接続に失敗しました: %w", err)
 	}
 
+	// SQL ごとのスパンを記録する（トレーサーは tracing.Setup で登録したグローバルのもの）
+	if err := db.Use(newTracingPlugin(nil)); err != nil {
+		return nil, fmt.Errorf("トレースの設定に失敗しました: %w", err)
+	}
+
 	// 接続プール設定のため、内部のsql.DBインスタンスを取得
 	sqlDB, err := db.DB()
 	if err != nil {
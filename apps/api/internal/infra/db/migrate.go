@@ -0,0 +1,185 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+
+	"gogym-api/internal/configs"
+	"gogym-api/internal/infra/db/migrations"
+
+	"github.com/golang-migrate/migrate/v4"
+	pgxmigrate "github.com/golang-migrate/migrate/v4/database/pgx/v5"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+	_ "github.com/jackc/pgx/v5/stdlib" // database/sql 用の pgx ドライバ
+)
+
+// ErrSchemaBehind はDBのスキーマがバイナリに埋め込まれたマイグレーションより古い場合のエラー
+var ErrSchemaBehind = errors.New("database schema is behind the embedded migrations")
+
+// MigrationStatus は適用済みバージョンと埋め込みマイグレーションの最新バージョン
+type MigrationStatus struct {
+	Version uint // 適用済みバージョン（未適用なら 0）
+	Dirty   bool // 前回のマイグレーションが途中で失敗している
+	Latest  uint // 埋め込まれている最新バージョン
+}
+
+// Pending は未適用のマイグレーションがあるかを返す
+func (s MigrationStatus) Pending() bool {
+	return s.Version < s.Latest
+}
+
+// Migrator は埋め込んだマイグレーションを golang-migrate で適用する
+// migrate の Close は接続ごと閉じるため、アプリ本体とは別の接続を使う
+type Migrator struct {
+	m *migrate.Migrate
+}
+
+func NewMigrator(cfg configs.DatabaseConfig) (*Migrator, error) {
+	sqlDB, err := sql.Open("pgx", DSN(cfg))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open migration connection: %w", err)
+	}
+
+	driver, err := pgxmigrate.WithInstance(sqlDB, &pgxmigrate.Config{})
+	if err != nil {
+		_ = sqlDB.Close()
+		return nil, fmt.Errorf("failed to initialize migration driver: %w", err)
+	}
+
+	src, err := iofs.New(migrations.FS, ".")
+	if err != nil {
+		_ = driver.Close()
+		return nil, fmt.Errorf("failed to load embedded migrations: %w", err)
+	}
+
+	m, err := migrate.NewWithInstance("iofs", src, cfg.Database, driver)
+	if err != nil {
+		_ = driver.Close()
+		return nil, fmt.Errorf("failed to initialize migrator: %w", err)
+	}
+	m.Log = migrateLogger{}
+
+	return &Migrator{m: m}, nil
+}
+
+// Up は未適用のマイグレーションをすべて適用する
+func (mg *Migrator) Up() error {
+	if err := mg.m.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return err
+	}
+	return nil
+}
+
+// Down は直近 n 件のマイグレーションを巻き戻す
+func (mg *Migrator) Down(n int) error {
+	if n <= 0 {
+		return fmt.Errorf("down steps must be positive: %d", n)
+	}
+	if err := mg.m.Steps(-n); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return err
+	}
+	return nil
+}
+
+// Force は dirty 状態を解除し、バージョンを強制的に設定する（マイグレーション自体は実行しない）
+func (mg *Migrator) Force(version int) error {
+	return mg.m.Force(version)
+}
+
+// Status は適用済みバージョンと最新バージョンを返す
+func (mg *Migrator) Status() (MigrationStatus, error) {
+	latest, err := LatestMigrationVersion()
+	if err != nil {
+		return MigrationStatus{}, err
+	}
+
+	version, dirty, err := mg.m.Version()
+	if errors.Is(err, migrate.ErrNilVersion) {
+		return MigrationStatus{Latest: latest}, nil
+	}
+	if err != nil {
+		return MigrationStatus{}, err
+	}
+
+	return MigrationStatus{Version: version, Dirty: dirty, Latest: latest}, nil
+}
+
+// CheckSchema はスキーマが最新でない・dirty の場合に ErrSchemaBehind を返す
+func (mg *Migrator) CheckSchema() error {
+	status, err := mg.Status()
+	if err != nil {
+		return err
+	}
+	if status.Dirty || status.Pending() {
+		return fmt.Errorf("%w: version=%d dirty=%t latest=%d", ErrSchemaBehind, status.Version, status.Dirty, status.Latest)
+	}
+	return nil
+}
+
+func (mg *Migrator) Close() error {
+	srcErr, dbErr := mg.m.Close()
+	return errors.Join(srcErr, dbErr)
+}
+
+// SchemaStatus はアプリ本体の接続で schema_migrations を読み、適用済みバージョンと最新バージョンを返す
+// readiness の確認用（Migrator と違い接続を作らない）
+func SchemaStatus(ctx context.Context, db *sql.DB) (MigrationStatus, error) {
+	latest, err := LatestMigrationVersion()
+	if err != nil {
+		return MigrationStatus{}, err
+	}
+
+	var (
+		version int64
+		dirty   bool
+	)
+	err = db.QueryRowContext(ctx, "SELECT version, dirty FROM schema_migrations LIMIT 1").Scan(&version, &dirty)
+	if errors.Is(err, sql.ErrNoRows) {
+		return MigrationStatus{Latest: latest}, nil
+	}
+	if err != nil {
+		return MigrationStatus{}, fmt.Errorf("failed to read schema version: %w", err)
+	}
+
+	return MigrationStatus{Version: uint(version), Dirty: dirty, Latest: latest}, nil
+}
+
+// LatestMigrationVersion は埋め込まれたマイグレーションの最新バージョンを返す
+func LatestMigrationVersion() (uint, error) {
+	src, err := iofs.New(migrations.FS, ".")
+	if err != nil {
+		return 0, err
+	}
+	defer src.Close()
+
+	version, err := src.First()
+	if err != nil {
+		return 0, err
+	}
+	for {
+		next, err := src.Next(version)
+		if errors.Is(err, os.ErrNotExist) {
+			return version, nil
+		}
+		if err != nil {
+			return 0, err
+		}
+		version = next
+	}
+}
+
+// migrateLogger は golang-migrate のログを slog に流す
+type migrateLogger struct{}
+
+func (migrateLogger) Printf(format string, v ...interface{}) {
+	slog.Info(strings.TrimSpace(fmt.Sprintf(format, v...)))
+}
+
+func (migrateLogger) Verbose() bool {
+	return false
+}
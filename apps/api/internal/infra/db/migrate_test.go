@@ -0,0 +1,36 @@
+package db
+
+import (
+	"io/fs"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"gogym-api/internal/infra/db/migrations"
+)
+
+func TestLatestMigrationVersion(t *testing.T) {
+	t.Parallel()
+
+	files, err := fs.Glob(migrations.FS, "*.up.sql")
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+
+	var want uint
+	for _, f := range files {
+		// すべての up に対応する down が埋め込まれていること
+		down := strings.TrimSuffix(f, ".up.sql") + ".down.sql"
+		_, err := fs.Stat(migrations.FS, down)
+		require.NoError(t, err, "missing %s", down)
+
+		v, err := strconv.ParseUint(strings.SplitN(f, "_", 2)[0], 10, 64)
+		require.NoError(t, err)
+		want = max(want, uint(v))
+	}
+
+	got, err := LatestMigrationVersion()
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+}
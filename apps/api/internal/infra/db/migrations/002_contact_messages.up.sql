@@ -0,0 +1,25 @@
+-- Contact messages table: 問い合わせフォームの送信内容（Slack通知前に永続化）
+CREATE TABLE contact_messages (
+    id BIGSERIAL PRIMARY KEY,
+    email VARCHAR(255) NOT NULL,
+    message TEXT NOT NULL,
+    user_id CHAR(26) NULL,
+    ip VARCHAR(64) NOT NULL DEFAULT '',
+    user_agent VARCHAR(512) NOT NULL DEFAULT '',
+    status VARCHAR(20) NOT NULL DEFAULT 'open',
+    delivery_status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    delivery_attempts INT NOT NULL DEFAULT 0,
+    last_delivery_error TEXT NULL,
+    delivered_at TIMESTAMP NULL,
+    resolved_at TIMESTAMP NULL,
+    resolved_by CHAR(26) NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_contact_messages_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE SET NULL,
+    CONSTRAINT fk_contact_messages_resolved_by FOREIGN KEY (resolved_by) REFERENCES users(id) ON DELETE SET NULL,
+    CONSTRAINT chk_contact_messages_status CHECK (status IN ('open', 'resolved')),
+    CONSTRAINT chk_contact_messages_delivery_status CHECK (delivery_status IN ('pending', 'delivered', 'failed'))
+);
+
+CREATE INDEX idx_contact_messages_status_created ON contact_messages(status, created_at DESC);
+CREATE INDEX idx_contact_messages_delivery ON contact_messages(delivery_status);
@@ -0,0 +1,21 @@
+-- Outbox messages table: 外部通知（Slack等）の送信待ちキュー
+-- 業務データと同一トランザクションで登録し、バックグラウンドのディスパッチャが配信する
+CREATE TABLE outbox_messages (
+    id BIGSERIAL PRIMARY KEY,
+    topic VARCHAR(100) NOT NULL,
+    payload JSONB NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    attempts INT NOT NULL DEFAULT 0,
+    max_attempts INT NOT NULL,
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    locked_until TIMESTAMP NULL,
+    last_error TEXT NULL,
+    delivered_at TIMESTAMP NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_outbox_messages_status CHECK (status IN ('pending', 'processing', 'delivered', 'dead'))
+);
+
+-- 配信対象（pending / リース切れの processing）を next_attempt_at 順に引くためのインデックス
+CREATE INDEX idx_outbox_messages_due ON outbox_messages(status, next_attempt_at);
+CREATE INDEX idx_outbox_messages_topic ON outbox_messages(topic);
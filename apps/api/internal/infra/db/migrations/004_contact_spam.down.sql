@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS idx_contact_messages_email_created;
+DROP INDEX IF EXISTS idx_contact_messages_ip_created;
+
+ALTER TABLE contact_messages DROP COLUMN IF EXISTS spam_reasons;
+ALTER TABLE contact_messages DROP COLUMN IF EXISTS spam_score;
+
+UPDATE contact_messages SET status = 'open' WHERE status = 'quarantined';
+ALTER TABLE contact_messages DROP CONSTRAINT chk_contact_messages_status;
+ALTER TABLE contact_messages ADD CONSTRAINT chk_contact_messages_status CHECK (status IN ('open', 'resolved'));
@@ -0,0 +1,9 @@
+-- 問い合わせのスパム対策: 隔離ステータスとスコア、送信元ごとの件数集計用インデックス
+ALTER TABLE contact_messages DROP CONSTRAINT chk_contact_messages_status;
+ALTER TABLE contact_messages ADD CONSTRAINT chk_contact_messages_status CHECK (status IN ('open', 'resolved', 'quarantined'));
+
+ALTER TABLE contact_messages ADD COLUMN spam_score INT NOT NULL DEFAULT 0;
+ALTER TABLE contact_messages ADD COLUMN spam_reasons TEXT NULL;
+
+CREATE INDEX idx_contact_messages_ip_created ON contact_messages(ip, created_at);
+CREATE INDEX idx_contact_messages_email_created ON contact_messages(LOWER(email), created_at);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_workout_records_user_date_started;
+CREATE INDEX idx_workout_records_user_date ON workout_records(user_id, performed_date);
@@ -0,0 +1,4 @@
+-- 1日に複数のワークアウトセッションを持てるようにする
+-- 日付ごとの一覧を開始時刻順に取得するためのインデックスに置き換える
+DROP INDEX IF EXISTS idx_workout_records_user_date;
+CREATE INDEX idx_workout_records_user_date_started ON workout_records(user_id, performed_date, started_at, id);
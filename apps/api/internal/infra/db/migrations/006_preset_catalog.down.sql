@@ -0,0 +1,11 @@
+-- ユーザーごとの部位コピーは復元しない（プリセット部位・種目の行は記録が参照しているため残す）
+DROP TABLE IF EXISTS workout_exercise_translations;
+DROP TABLE IF EXISTS user_hidden_exercises;
+
+DROP INDEX IF EXISTS uq_workout_exercises_preset_key;
+DROP INDEX IF EXISTS uq_workout_parts_preset_key;
+
+ALTER TABLE workout_exercises DROP COLUMN IF EXISTS equipment;
+ALTER TABLE workout_exercises DROP COLUMN IF EXISTS secondary_muscles;
+ALTER TABLE workout_exercises DROP COLUMN IF EXISTS primary_muscles;
+ALTER TABLE workout_exercises DROP COLUMN IF EXISTS key;
@@ -0,0 +1,54 @@
+-- プリセット種目カタログ: user_id が NULL の部位・種目を全ユーザー共通のプリセットとする
+-- 名前や筋肉などの内容はアプリ起動時に埋め込みカタログから同期する
+
+ALTER TABLE workout_exercises ADD COLUMN key VARCHAR(100) NULL;
+ALTER TABLE workout_exercises ADD COLUMN primary_muscles VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE workout_exercises ADD COLUMN secondary_muscles VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE workout_exercises ADD COLUMN equipment VARCHAR(20) NULL;
+
+CREATE UNIQUE INDEX uq_workout_parts_preset_key ON workout_parts(key) WHERE user_id IS NULL;
+CREATE UNIQUE INDEX uq_workout_exercises_preset_key ON workout_exercises(key) WHERE user_id IS NULL;
+
+-- プリセット部位（翻訳は起動時の同期で登録）
+INSERT INTO workout_parts (key, user_id) VALUES
+    ('chest', NULL), ('shoulders', NULL), ('back', NULL), ('arms', NULL), ('legs', NULL), ('others', NULL)
+ON CONFLICT (key) WHERE user_id IS NULL DO NOTHING;
+
+-- これまでユーザーごとにコピーしていた部位を、同じキーのプリセット部位に付け替えて削除する
+UPDATE workout_exercises e
+SET workout_part_id = p.id
+FROM workout_parts up
+JOIN workout_parts p ON p.key = up.key AND p.user_id IS NULL
+WHERE e.workout_part_id = up.id AND up.user_id IS NOT NULL;
+
+DELETE FROM workout_parts up
+WHERE up.user_id IS NOT NULL
+  AND EXISTS (SELECT 1 FROM workout_parts p WHERE p.key = up.key AND p.user_id IS NULL);
+
+-- ユーザーが非表示にしたプリセット種目
+CREATE TABLE user_hidden_exercises (
+    user_id CHAR(26) NOT NULL,
+    workout_exercise_id INT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, workout_exercise_id),
+    CONSTRAINT fk_user_hidden_exercises_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT fk_user_hidden_exercises_exercise FOREIGN KEY (workout_exercise_id) REFERENCES workout_exercises(id) ON DELETE CASCADE
+);
+
+-- 種目名の多言語対応: workout_part_translations と同じ形の翻訳テーブル
+CREATE TABLE workout_exercise_translations (
+    id SERIAL PRIMARY KEY,
+    workout_exercise_id INT NOT NULL,
+    locale VARCHAR(5) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_workout_exercise_translations_exercise FOREIGN KEY (workout_exercise_id) REFERENCES workout_exercises(id) ON DELETE CASCADE,
+    CONSTRAINT uq_workout_exercise_translations UNIQUE (workout_exercise_id, locale)
+);
+
+CREATE INDEX idx_workout_exercise_translations_locale ON workout_exercise_translations(locale);
+
+-- 既存のプリセット種目の名前を日本語の翻訳として登録（英語名などは起動時の同期で登録）
+INSERT INTO workout_exercise_translations (workout_exercise_id, locale, name)
+SELECT id, 'ja', name FROM workout_exercises WHERE user_id IS NULL;
@@ -0,0 +1,2 @@
+-- ユーザーが選ぶ表示ロケール（NULL なら Accept-Language に従う）
+ALTER TABLE users ADD COLUMN locale VARCHAR(5) NULL;
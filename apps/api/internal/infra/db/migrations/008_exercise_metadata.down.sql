@@ -0,0 +1,3 @@
+ALTER TABLE workout_exercises DROP COLUMN IF EXISTS is_unilateral;
+ALTER TABLE workout_exercises DROP COLUMN IF EXISTS secondary_parts;
+ALTER TABLE workout_exercises DROP COLUMN IF EXISTS movement_pattern;
@@ -0,0 +1,4 @@
+-- 種目のメタデータ: 動作パターン・補助部位・片側フラグ
+ALTER TABLE workout_exercises ADD COLUMN movement_pattern VARCHAR(30) NULL;
+ALTER TABLE workout_exercises ADD COLUMN secondary_parts VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE workout_exercises ADD COLUMN is_unilateral BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,7 @@
+-- 重量×回数以外のセットは元のスキーマで表現できないため削除する
+DELETE FROM workout_sets WHERE set_kind <> 'weight_reps';
+ALTER TABLE workout_sets DROP CONSTRAINT IF EXISTS chk_workout_sets_kind;
+ALTER TABLE workout_sets DROP COLUMN IF EXISTS bodyweight_kg;
+ALTER TABLE workout_sets DROP COLUMN IF EXISTS distance_m;
+ALTER TABLE workout_sets DROP COLUMN IF EXISTS duration_sec;
+ALTER TABLE workout_sets DROP COLUMN IF EXISTS set_kind;
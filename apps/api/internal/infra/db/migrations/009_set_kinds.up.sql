@@ -0,0 +1,8 @@
+-- 重量×回数以外のセット（自重・補助付き・時間・距離）を記録できるようにする
+-- weight_kg は種類ごとに重量・加重・補助重量として使う
+ALTER TABLE workout_sets ADD COLUMN set_kind VARCHAR(20) NOT NULL DEFAULT 'weight_reps';
+ALTER TABLE workout_sets ADD COLUMN duration_sec INT NULL;
+ALTER TABLE workout_sets ADD COLUMN distance_m DECIMAL(10,2) NULL;
+ALTER TABLE workout_sets ADD COLUMN bodyweight_kg DECIMAL(6,2) NULL;
+ALTER TABLE workout_sets ADD CONSTRAINT chk_workout_sets_kind
+    CHECK (set_kind IN ('weight_reps', 'bodyweight', 'assisted', 'timed', 'distance'));
@@ -0,0 +1,7 @@
+ALTER TABLE workout_sets DROP CONSTRAINT IF EXISTS chk_workout_sets_rir;
+ALTER TABLE workout_sets DROP CONSTRAINT IF EXISTS chk_workout_sets_rpe;
+ALTER TABLE workout_sets DROP CONSTRAINT IF EXISTS chk_workout_sets_type;
+ALTER TABLE workout_sets DROP COLUMN IF EXISTS superset_group;
+ALTER TABLE workout_sets DROP COLUMN IF EXISTS rir;
+ALTER TABLE workout_sets DROP COLUMN IF EXISTS rpe;
+ALTER TABLE workout_sets DROP COLUMN IF EXISTS set_type;
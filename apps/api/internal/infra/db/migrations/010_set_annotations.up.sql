@@ -0,0 +1,9 @@
+-- セットの区分（ウォームアップ・ドロップ・限界）、RPE・RIR、スーパーセットのキー
+ALTER TABLE workout_sets ADD COLUMN set_type VARCHAR(20) NOT NULL DEFAULT 'normal';
+ALTER TABLE workout_sets ADD COLUMN rpe DECIMAL(3,1) NULL;
+ALTER TABLE workout_sets ADD COLUMN rir INT NULL;
+ALTER TABLE workout_sets ADD COLUMN superset_group VARCHAR(20) NULL;
+ALTER TABLE workout_sets ADD CONSTRAINT chk_workout_sets_type
+    CHECK (set_type IN ('normal', 'warmup', 'drop', 'failure'));
+ALTER TABLE workout_sets ADD CONSTRAINT chk_workout_sets_rpe CHECK (rpe IS NULL OR rpe BETWEEN 1 AND 10);
+ALTER TABLE workout_sets ADD CONSTRAINT chk_workout_sets_rir CHECK (rir IS NULL OR rir BETWEEN 0 AND 10);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS user_exercise_settings;
+ALTER TABLE workout_sets DROP COLUMN IF EXISTS completed_at;
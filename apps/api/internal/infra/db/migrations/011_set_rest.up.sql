@@ -0,0 +1,16 @@
+-- セットの完了時刻（クライアントが送る実時刻）。セット間の休憩はここから求める
+-- created_at はセットの置き換えのたびに振り直されるため休憩の計算には使えない
+ALTER TABLE workout_sets ADD COLUMN completed_at TIMESTAMP NULL;
+
+-- ユーザーごとの種目設定（プリセット種目にも設定できる）
+CREATE TABLE user_exercise_settings (
+    user_id CHAR(26) NOT NULL,
+    workout_exercise_id INT NOT NULL,
+    default_rest_sec INT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, workout_exercise_id),
+    CONSTRAINT fk_user_exercise_settings_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT fk_user_exercise_settings_exercise FOREIGN KEY (workout_exercise_id) REFERENCES workout_exercises(id) ON DELETE CASCADE,
+    CONSTRAINT chk_user_exercise_settings_rest CHECK (default_rest_sec IS NULL OR default_rest_sec BETWEEN 0 AND 3600)
+);
@@ -0,0 +1,4 @@
+ALTER TABLE workout_records DROP CONSTRAINT IF EXISTS fk_workout_records_template;
+ALTER TABLE workout_records DROP COLUMN IF EXISTS template_id;
+DROP TABLE IF EXISTS workout_template_exercises;
+DROP TABLE IF EXISTS workout_templates;
@@ -0,0 +1,37 @@
+-- ワークアウトのテンプレート（ルーティン）
+CREATE TABLE workout_templates (
+    id SERIAL PRIMARY KEY,
+    user_id CHAR(26) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    note TEXT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL,
+    CONSTRAINT fk_workout_templates_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_workout_templates_user ON workout_templates(user_id);
+
+-- テンプレート内の種目と目標（並び順は position）
+CREATE TABLE workout_template_exercises (
+    id SERIAL PRIMARY KEY,
+    workout_template_id INT NOT NULL,
+    workout_exercise_id INT NOT NULL,
+    position INT NOT NULL,
+    target_sets INT NOT NULL,
+    reps_min INT NOT NULL,
+    reps_max INT NOT NULL,
+    target_weight_kg DECIMAL(6,2) NULL,
+    note VARCHAR(255) NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_workout_template_exercises_template FOREIGN KEY (workout_template_id) REFERENCES workout_templates(id) ON DELETE CASCADE,
+    CONSTRAINT fk_workout_template_exercises_exercise FOREIGN KEY (workout_exercise_id) REFERENCES workout_exercises(id) ON DELETE CASCADE,
+    CONSTRAINT uq_workout_template_exercises_order UNIQUE (workout_template_id, position),
+    CONSTRAINT chk_workout_template_exercises_reps CHECK (reps_min >= 1 AND reps_max >= reps_min)
+);
+
+-- テンプレートから開始したセッション
+ALTER TABLE workout_records ADD COLUMN template_id INT NULL;
+ALTER TABLE workout_records ADD CONSTRAINT fk_workout_records_template
+    FOREIGN KEY (template_id) REFERENCES workout_templates(id) ON DELETE SET NULL;
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS program_enrollments;
+DROP TABLE IF EXISTS training_program_prescriptions;
+DROP TABLE IF EXISTS training_program_days;
+DROP TABLE IF EXISTS training_programs;
+ALTER TABLE user_exercise_settings DROP CONSTRAINT IF EXISTS chk_user_exercise_settings_training_max;
+ALTER TABLE user_exercise_settings DROP COLUMN IF EXISTS training_max_kg;
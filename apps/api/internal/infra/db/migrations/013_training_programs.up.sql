@@ -0,0 +1,67 @@
+-- 種目ごとのトレーニングマックス（プログラムの %1RM 処方から重量を求める）
+ALTER TABLE user_exercise_settings ADD COLUMN training_max_kg DECIMAL(6,2) NULL;
+ALTER TABLE user_exercise_settings ADD CONSTRAINT chk_user_exercise_settings_training_max
+    CHECK (training_max_kg IS NULL OR training_max_kg > 0);
+
+-- 複数週のプログラム（週×日の日程でテンプレートを割り当てる）
+CREATE TABLE training_programs (
+    id SERIAL PRIMARY KEY,
+    user_id CHAR(26) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    note TEXT NULL,
+    weeks INT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL,
+    CONSTRAINT fk_training_programs_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT chk_training_programs_weeks CHECK (weeks BETWEEN 1 AND 52)
+);
+
+CREATE INDEX idx_training_programs_user ON training_programs(user_id);
+
+-- プログラムのトレーニング日（日程にない日は休養日）
+CREATE TABLE training_program_days (
+    id SERIAL PRIMARY KEY,
+    training_program_id INT NOT NULL,
+    week INT NOT NULL,
+    day INT NOT NULL,
+    workout_template_id INT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_training_program_days_program FOREIGN KEY (training_program_id) REFERENCES training_programs(id) ON DELETE CASCADE,
+    CONSTRAINT fk_training_program_days_template FOREIGN KEY (workout_template_id) REFERENCES workout_templates(id) ON DELETE CASCADE,
+    CONSTRAINT uq_training_program_days_schedule UNIQUE (training_program_id, week, day),
+    CONSTRAINT chk_training_program_days_day CHECK (week >= 1 AND day BETWEEN 1 AND 7)
+);
+
+-- トレーニング日の %1RM 処方（種目ごとのセット番号順）
+CREATE TABLE training_program_prescriptions (
+    id SERIAL PRIMARY KEY,
+    training_program_day_id INT NOT NULL,
+    workout_exercise_id INT NOT NULL,
+    set_number INT NOT NULL,
+    percent_of_max DECIMAL(5,2) NOT NULL,
+    reps INT NOT NULL,
+    amrap BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_training_program_prescriptions_day FOREIGN KEY (training_program_day_id) REFERENCES training_program_days(id) ON DELETE CASCADE,
+    CONSTRAINT fk_training_program_prescriptions_exercise FOREIGN KEY (workout_exercise_id) REFERENCES workout_exercises(id) ON DELETE CASCADE,
+    CONSTRAINT uq_training_program_prescriptions_set UNIQUE (training_program_day_id, workout_exercise_id, set_number),
+    CONSTRAINT chk_training_program_prescriptions_values CHECK (percent_of_max > 0 AND reps >= 1)
+);
+
+-- プログラムへの参加（進行中は1ユーザー1件）
+CREATE TABLE program_enrollments (
+    id SERIAL PRIMARY KEY,
+    user_id CHAR(26) NOT NULL,
+    training_program_id INT NOT NULL,
+    start_date DATE NOT NULL,
+    ended_at TIMESTAMP NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_program_enrollments_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT fk_program_enrollments_program FOREIGN KEY (training_program_id) REFERENCES training_programs(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX uq_program_enrollments_active ON program_enrollments(user_id) WHERE ended_at IS NULL;
@@ -0,0 +1,9 @@
+UPDATE workout_records
+SET ended_at = (ended_at AT TIME ZONE 'UTC') AT TIME ZONE 'Asia/Tokyo'
+WHERE ended_at IS NOT NULL;
+
+UPDATE workout_records
+SET started_at = (started_at AT TIME ZONE 'UTC') AT TIME ZONE 'Asia/Tokyo'
+WHERE started_at IS NOT NULL;
+
+ALTER TABLE users DROP COLUMN time_zone;
@@ -0,0 +1,13 @@
+-- ユーザーが選ぶタイムゾーン（IANA 名。NULL ならクライアントの指定・Asia/Tokyo に従う）
+ALTER TABLE users ADD COLUMN time_zone VARCHAR(64) NULL;
+
+-- 開始・終了時刻は入力された HH:mm（日本時間）をそのまま保存していたため、UTC に揃える
+-- 以降 TIMESTAMP 列は UTC の時刻を保存し、接続のタイムゾーンも UTC にする
+UPDATE workout_records
+SET started_at = (started_at AT TIME ZONE 'Asia/Tokyo') AT TIME ZONE 'UTC'
+WHERE started_at IS NOT NULL;
+
+UPDATE workout_records
+SET ended_at = (ended_at AT TIME ZONE 'Asia/Tokyo') AT TIME ZONE 'UTC'
+WHERE ended_at IS NOT NULL;
+
@@ -0,0 +1,4 @@
+ALTER TABLE users DROP CONSTRAINT IF EXISTS chk_users_weight_increment;
+ALTER TABLE users DROP CONSTRAINT IF EXISTS chk_users_weight_unit;
+ALTER TABLE users DROP COLUMN weight_increment;
+ALTER TABLE users DROP COLUMN weight_unit;
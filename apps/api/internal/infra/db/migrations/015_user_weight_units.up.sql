@@ -0,0 +1,9 @@
+-- 重量の表示単位と丸め幅（重量は常に kg で保存し、入出力時に変換する）
+-- weight_unit が NULL なら kg、weight_increment が NULL なら単位ごとの既定値（2.5kg / 5lb）
+ALTER TABLE users ADD COLUMN weight_unit VARCHAR(2) NULL;
+ALTER TABLE users ADD COLUMN weight_increment DECIMAL(5,2) NULL;
+
+ALTER TABLE users ADD CONSTRAINT chk_users_weight_unit
+    CHECK (weight_unit IS NULL OR weight_unit IN ('kg', 'lb'));
+ALTER TABLE users ADD CONSTRAINT chk_users_weight_increment
+    CHECK (weight_increment IS NULL OR (weight_increment > 0 AND weight_increment <= 50));
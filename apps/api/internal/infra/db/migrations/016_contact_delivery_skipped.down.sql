@@ -0,0 +1,5 @@
+UPDATE contact_messages SET delivery_status = 'pending' WHERE delivery_status = 'skipped';
+
+ALTER TABLE contact_messages DROP CONSTRAINT chk_contact_messages_delivery_status;
+ALTER TABLE contact_messages ADD CONSTRAINT chk_contact_messages_delivery_status
+    CHECK (delivery_status IN ('pending', 'delivered', 'failed'));
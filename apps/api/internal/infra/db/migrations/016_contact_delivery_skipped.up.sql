@@ -0,0 +1,4 @@
+-- 通知先が未設定で送信しなかった問い合わせを skipped として記録する
+ALTER TABLE contact_messages DROP CONSTRAINT chk_contact_messages_delivery_status;
+ALTER TABLE contact_messages ADD CONSTRAINT chk_contact_messages_delivery_status
+    CHECK (delivery_status IN ('pending', 'delivered', 'failed', 'skipped'));
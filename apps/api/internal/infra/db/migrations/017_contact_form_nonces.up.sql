@@ -0,0 +1,8 @@
+-- 使用済みのフォームトークンのノンス（同じトークンでの再送信を拒否する）
+-- expires_at はトークンの有効期限で、過ぎた行は送信時に削除する
+CREATE TABLE contact_form_nonces (
+    nonce VARCHAR(64) PRIMARY KEY,
+    expires_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX idx_contact_form_nonces_expires ON contact_form_nonces(expires_at);
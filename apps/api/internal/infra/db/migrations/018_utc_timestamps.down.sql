@@ -0,0 +1,68 @@
+-- 018 で UTC に揃えた TIMESTAMP 列を日本時間の時刻に戻す
+DO $$
+DECLARE
+    col RECORD;
+BEGIN
+    FOR col IN
+        SELECT c.table_name, c.column_name
+        FROM (VALUES
+            ('users', 'created_at'),
+            ('users', 'updated_at'),
+            ('users', 'deleted_at'),
+            ('gyms', 'created_at'),
+            ('gyms', 'updated_at'),
+            ('gyms', 'deleted_at'),
+            ('refresh_tokens', 'revoked_at'),
+            ('refresh_tokens', 'expires_at'),
+            ('refresh_tokens', 'created_at'),
+            ('refresh_tokens', 'deleted_at'),
+            ('workout_parts', 'created_at'),
+            ('workout_parts', 'updated_at'),
+            ('workout_parts', 'deleted_at'),
+            ('workout_part_translations', 'created_at'),
+            ('workout_part_translations', 'updated_at'),
+            ('workout_exercises', 'created_at'),
+            ('workout_exercises', 'updated_at'),
+            ('workout_exercises', 'deleted_at'),
+            ('workout_exercise_translations', 'created_at'),
+            ('workout_exercise_translations', 'updated_at'),
+            ('user_hidden_exercises', 'created_at'),
+            ('workout_records', 'created_at'),
+            ('workout_records', 'updated_at'),
+            ('workout_records', 'deleted_at'),
+            ('workout_sets', 'completed_at'),
+            ('workout_sets', 'created_at'),
+            ('workout_sets', 'updated_at'),
+            ('workout_sets', 'deleted_at'),
+            ('user_exercise_settings', 'created_at'),
+            ('user_exercise_settings', 'updated_at'),
+            ('workout_templates', 'created_at'),
+            ('workout_templates', 'updated_at'),
+            ('workout_templates', 'deleted_at'),
+            ('workout_template_exercises', 'created_at'),
+            ('workout_template_exercises', 'updated_at'),
+            ('training_programs', 'created_at'),
+            ('training_programs', 'updated_at'),
+            ('training_programs', 'deleted_at'),
+            ('training_program_days', 'created_at'),
+            ('training_program_days', 'updated_at'),
+            ('training_program_prescriptions', 'created_at'),
+            ('training_program_prescriptions', 'updated_at'),
+            ('program_enrollments', 'ended_at'),
+            ('program_enrollments', 'created_at'),
+            ('program_enrollments', 'updated_at')
+        ) AS target(table_name, column_name)
+        JOIN information_schema.columns c
+          ON c.table_name = target.table_name AND c.column_name = target.column_name
+        JOIN information_schema.tables t
+          ON t.table_schema = c.table_schema AND t.table_name = c.table_name
+        WHERE c.table_schema = current_schema()
+          AND t.table_type = 'BASE TABLE'
+          AND c.data_type = 'timestamp without time zone'
+    LOOP
+        EXECUTE format(
+            'UPDATE %I SET %I = (%I AT TIME ZONE ''UTC'') AT TIME ZONE ''Asia/Tokyo'' WHERE %I IS NOT NULL',
+            col.table_name, col.column_name, col.column_name, col.column_name
+        );
+    END LOOP;
+END $$;
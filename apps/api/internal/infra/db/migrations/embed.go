@@ -0,0 +1,9 @@
+// Package migrations はSQLマイグレーションファイルをバイナリに埋め込む
+package migrations
+
+import "embed"
+
+// FS は golang-migrate 形式（NNN_name.up.sql / NNN_name.down.sql）のマイグレーション
+//
+//go:embed *.sql
+var FS embed.FS
@@ -0,0 +1,97 @@
+package db
+
+import (
+	"errors"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+const (
+	tracerName = "gogym-api/internal/infra/db"
+	pluginName = "otel"
+	spanKey    = "otel:span"
+)
+
+// tracingPlugin は GORM の各操作（SQL 1 文）をスパンとして記録する
+// SQL はプレースホルダのまま記録し、バインド値（メールアドレスなど）は含めない
+type tracingPlugin struct {
+	tracer trace.Tracer
+}
+
+// newTracingPlugin は tp のトレーサーで記録するプラグインを返す（nil ならグローバルのプロバイダー）
+func newTracingPlugin(tp trace.TracerProvider) *tracingPlugin {
+	if tp == nil {
+		tp = otel.GetTracerProvider()
+	}
+	return &tracingPlugin{tracer: tp.Tracer(tracerName)}
+}
+
+func (p *tracingPlugin) Name() string {
+	return pluginName
+}
+
+func (p *tracingPlugin) Initialize(db *gorm.DB) error {
+	cb := db.Callback()
+	hooks := []struct {
+		op     string
+		before func(name string, fn func(*gorm.DB)) error
+		after  func(name string, fn func(*gorm.DB)) error
+	}{
+		{"create", cb.Create().Before("gorm:create").Register, cb.Create().After("gorm:create").Register},
+		{"query", cb.Query().Before("gorm:query").Register, cb.Query().After("gorm:query").Register},
+		{"update", cb.Update().Before("gorm:update").Register, cb.Update().After("gorm:update").Register},
+		{"delete", cb.Delete().Before("gorm:delete").Register, cb.Delete().After("gorm:delete").Register},
+		{"row", cb.Row().Before("gorm:row").Register, cb.Row().After("gorm:row").Register},
+		{"raw", cb.Raw().Before("gorm:raw").Register, cb.Raw().After("gorm:raw").Register},
+	}
+	for _, h := range hooks {
+		if err := h.before(pluginName+":before_"+h.op, p.start(h.op)); err != nil {
+			return err
+		}
+		if err := h.after(pluginName+":after_"+h.op, p.end); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *tracingPlugin) start(op string) func(*gorm.DB) {
+	return func(tx *gorm.DB) {
+		if tx.Statement.Context == nil {
+			return
+		}
+		ctx, span := p.tracer.Start(tx.Statement.Context, "gorm."+op, trace.WithSpanKind(trace.SpanKindClient))
+		tx.Statement.Context = ctx
+		tx.InstanceSet(spanKey, span)
+	}
+}
+
+func (p *tracingPlugin) end(tx *gorm.DB) {
+	v, ok := tx.InstanceGet(spanKey)
+	if !ok {
+		return
+	}
+	span, ok := v.(trace.Span)
+	if !ok {
+		return
+	}
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.system.name", "postgresql"),
+		attribute.String("db.query.text", tx.Statement.SQL.String()),
+		attribute.Int64("db.response.returned_rows", tx.Statement.RowsAffected),
+	)
+	if tx.Statement.Table != "" {
+		span.SetAttributes(attribute.String("db.collection.name", tx.Statement.Table))
+	}
+	// 見つからないことは正常系として扱う
+	if err := tx.Error; err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
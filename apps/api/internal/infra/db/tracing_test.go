@@ -0,0 +1,70 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+type tracedRecord struct {
+	ID     int
+	UserID string
+}
+
+func TestTracingPlugin(t *testing.T) {
+	t.Parallel()
+
+	// DryRun かつ暗黙のトランザクションなしなら SQL を組み立てるだけで DB に接続しない
+	newDB := func(t *testing.T) (*gorm.DB, *tracetest.SpanRecorder) {
+		t.Helper()
+
+		recorder := tracetest.NewSpanRecorder()
+		tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+		t.Cleanup(func() { _ = tp.Shutdown(context.Background()) })
+
+		db, err := gorm.Open(postgres.Open("host=localhost port=1"), &gorm.Config{DryRun: true, DisableAutomaticPing: true, SkipDefaultTransaction: true})
+		require.NoError(t, err)
+		require.NoError(t, db.Use(newTracingPlugin(tp)))
+		return db, recorder
+	}
+
+	attrs := func(span sdktrace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+		m := map[attribute.Key]attribute.Value{}
+		for _, kv := range span.Attributes() {
+			m[kv.Key] = kv.Value
+		}
+		return m
+	}
+
+	t.Run("正常系: SQL 1 文ごとに親スパンの子としてスパンを記録する", func(t *testing.T) {
+		t.Parallel()
+
+		db, recorder := newDB(t)
+		parent, span := sdktrace.NewTracerProvider().Tracer("test").Start(context.Background(), "WorkoutUseCase.CreateWorkoutRecord")
+		defer span.End()
+
+		require.NoError(t, db.WithContext(parent).Create(&tracedRecord{UserID: "01FGZ9K6TV3J5ZZZQX6Z9X6K7W"}).Error)
+		require.NoError(t, db.WithContext(parent).Where("user_id = ?", "01FGZ9K6TV3J5ZZZQX6Z9X6K7W").Find(&[]tracedRecord{}).Error)
+
+		spans := recorder.Ended()
+		require.Len(t, spans, 2)
+		require.Equal(t, "gorm.create", spans[0].Name())
+		require.Equal(t, "gorm.query", spans[1].Name())
+		for _, s := range spans {
+			require.Equal(t, span.SpanContext().TraceID(), s.SpanContext().TraceID())
+			require.Equal(t, span.SpanContext().SpanID(), s.Parent().SpanID())
+			require.Equal(t, "traced_records", attrs(s)["db.collection.name"].AsString())
+		}
+
+		// バインド値は記録しない
+		query := attrs(spans[1])["db.query.text"].AsString()
+		require.Contains(t, query, "user_id = $1")
+		require.NotContains(t, query, "01FGZ9K6TV3J5ZZZQX6Z9X6K7W")
+	})
+}
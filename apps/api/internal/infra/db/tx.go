@@ -0,0 +1,43 @@
+package db
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"gorm.io/gorm"
+)
+
+type txKey struct{}
+
+// Transactor はコンテキスト経由でトランザクションを共有する
+// WithinTransaction 内で Conn を使うリポジトリは同じトランザクションに参加する
+type Transactor struct {
+	db *gorm.DB
+}
+
+func NewTransactor(db *gorm.DB) *Transactor {
+	return &Transactor{db: db}
+}
+
+// WithinTransaction は fn をトランザクション内で実行する（エラー時はロールバック）
+// すでにトランザクション内の場合はそのトランザクションをそのまま使う
+func (t *Transactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return fn(ctx)
+	}
+
+	ctx, span := otel.Tracer(tracerName).Start(ctx, "db.transaction")
+	defer span.End()
+
+	return t.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, txKey{}, tx))
+	})
+}
+
+// Conn はコンテキストにトランザクションがあればそれを、なければ db を返す
+func Conn(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return tx.WithContext(ctx)
+	}
+	return db.WithContext(ctx)
+}
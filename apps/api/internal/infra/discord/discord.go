@@ -0,0 +1,88 @@
+package discord
+
+import (
+	"context"
+	"gogym-api/internal/configs"
+	"gogym-api/internal/infra/notify"
+	"net/http"
+
+	dn "gogym-api/internal/domain/entities/notification"
+)
+
+type Client struct {
+	httpClient *http.Client
+	webhooks   map[dn.Channel]string
+}
+
+func NewClient(dc configs.DiscordConfig) (*Client, error) {
+	return &Client{
+		httpClient: notify.NewHTTPClient(),
+		webhooks: map[dn.Channel]string{
+			dn.ChannelContact: dc.ContactWebhookURL,
+			dn.ChannelSignups: dc.SignupsWebhookURL,
+			dn.ChannelErrors:  dc.ErrorsWebhookURL,
+		},
+	}, nil
+}
+
+// Discord Webhook のペイロード（Embed 1件）
+// https://discord.com/developers/docs/resources/webhook#execute-webhook
+type payload struct {
+	Embeds []embed `json:"embeds"`
+}
+
+type embed struct {
+	Title       string       `json:"title"`
+	Description string       `json:"description,omitempty"`
+	Color       int          `json:"color,omitempty"`
+	Fields      []embedField `json:"fields,omitempty"`
+	Footer      *embedFooter `json:"footer,omitempty"`
+}
+
+type embedField struct {
+	Name   string `json:"name"`
+	Value  string `json:"value"`
+	Inline bool   `json:"inline"`
+}
+
+type embedFooter struct {
+	Text string `json:"text"`
+}
+
+// チャンネルごとの埋め込みの色
+var channelColors = map[dn.Channel]int{
+	dn.ChannelContact: 0x3B82F6,
+	dn.ChannelSignups: 0x22C55E,
+	dn.ChannelErrors:  0xEF4444,
+}
+
+func (c *Client) Name() string {
+	return "discord"
+}
+
+func (c *Client) Configured(ch dn.Channel) bool {
+	return c.webhooks[ch] != ""
+}
+
+func (c *Client) Notify(ctx context.Context, n dn.Notification) error {
+	return notify.PostJSON(ctx, c.httpClient, c.webhooks[n.Channel], buildPayload(n))
+}
+
+// buildPayload は Fields をインライン項目、Context を項目とフッターに変換する
+func buildPayload(n dn.Notification) payload {
+	e := embed{
+		Title:       n.Title,
+		Description: n.Text,
+		Color:       channelColors[n.Channel],
+	}
+
+	for _, f := range n.Fields {
+		e.Fields = append(e.Fields, embedField{Name: f.Label, Value: f.Value, Inline: true})
+	}
+	for _, f := range n.Context {
+		e.Fields = append(e.Fields, embedField{Name: f.Label, Value: f.Value, Inline: false})
+	}
+	e.Footer = &embedFooter{Text: "gogym · " + string(n.Channel)}
+
+	return payload{Embeds: []embed{e}}
+}
@@ -0,0 +1,49 @@
+package discord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"gogym-api/internal/configs"
+	dn "gogym-api/internal/domain/entities/notification"
+	"gogym-api/internal/infra/notify/notifytest"
+)
+
+func TestClient_Notify(t *testing.T) {
+	t.Parallel()
+
+	t.Run("正常系: Embed 形式でチャンネルのWebhookに送信する", func(t *testing.T) {
+		t.Parallel()
+
+		srv := notifytest.NewServer(t)
+		client, err := NewClient(configs.DiscordConfig{SignupsWebhookURL: srv.URLFor("/signups")})
+		require.NoError(t, err)
+
+		err = client.Notify(context.Background(), dn.Notification{
+			Channel: dn.ChannelSignups,
+			Title:   "🎉 New user signed up",
+			Fields:  []dn.Field{{Label: "Name", Value: "Taro"}},
+			Context: []dn.Field{{Label: "Email", Value: "taro@example.com"}},
+		})
+		require.NoError(t, err)
+
+		reqs := srv.Requests()
+		require.Len(t, reqs, 1)
+		require.Equal(t, "/signups", reqs[0].Path)
+
+		var got payload
+		reqs[0].Decode(t, &got)
+		require.Len(t, got.Embeds, 1)
+
+		e := got.Embeds[0]
+		require.Equal(t, "🎉 New user signed up", e.Title)
+		require.Equal(t, channelColors[dn.ChannelSignups], e.Color)
+		require.Equal(t, []embedField{
+			{Name: "Name", Value: "Taro", Inline: true},
+			{Name: "Email", Value: "taro@example.com", Inline: false},
+		}, e.Fields)
+		require.Equal(t, "gogym · signups", e.Footer.Text)
+	})
+}
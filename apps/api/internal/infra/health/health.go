@@ -0,0 +1,170 @@
+// Package health は liveness / readiness のエンドポイントと、readiness で確認する依存先（DB・スキーマ・通知）の状態を提供する
+package health
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	dn "gogym-api/internal/domain/entities/notification"
+	"gogym-api/internal/infra/db"
+	"gogym-api/internal/infra/notify"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Status は依存先ごとの状態
+type Status string
+
+const (
+	StatusOK            Status = "ok"
+	StatusUnavailable   Status = "unavailable"
+	StatusNotConfigured Status = "not_configured" // 通知の送信先が1つも設定されていない（readiness には影響しない）
+)
+
+// channels は readiness で設定状況を返す通知チャンネル
+var channels = []dn.Channel{dn.ChannelContact, dn.ChannelSignups, dn.ChannelErrors}
+
+// Pinger は DB への疎通確認（*sql.DB）
+type Pinger interface {
+	PingContext(ctx context.Context) error
+}
+
+// SchemaStatusFunc は適用済みのマイグレーションのバージョンを返す（db.SchemaStatus）
+type SchemaStatusFunc func(ctx context.Context) (db.MigrationStatus, error)
+
+// Report は readiness の判定結果
+type Report struct {
+	Status       Status                   `json:"status"`
+	ShuttingDown bool                     `json:"shutting_down"`
+	Database     DatabaseCheck            `json:"database"`
+	Migrations   MigrationCheck           `json:"migrations"`
+	Notifiers    map[string]NotifierCheck `json:"notifiers"`
+}
+
+type DatabaseCheck struct {
+	Status    Status `json:"status"`
+	LatencyMS int64  `json:"latency_ms"`
+	Error     string `json:"error,omitempty"`
+}
+
+type MigrationCheck struct {
+	Status  Status `json:"status"`
+	Version uint   `json:"version"`
+	Latest  uint   `json:"latest"`
+	Dirty   bool   `json:"dirty"`
+	Error   string `json:"error,omitempty"`
+}
+
+// NotifierCheck は送信先ごとの設定済みチャンネル
+// 通知はアウトボックス経由で再送されるため、未設定でも readiness は失敗させない
+type NotifierCheck struct {
+	Status   Status       `json:"status"`
+	Channels []dn.Channel `json:"channels"`
+}
+
+// Checker は依存先を確認し、シャットダウン中は readiness を失敗させる
+type Checker struct {
+	db           Pinger
+	schema       SchemaStatusFunc
+	timeout      time.Duration
+	notifiers    []notify.Notifier
+	shuttingDown atomic.Bool
+}
+
+// NewChecker は timeout 以内に DB・スキーマを確認する Checker を返す
+func NewChecker(db Pinger, schema SchemaStatusFunc, timeout time.Duration, notifiers ...notify.Notifier) *Checker {
+	return &Checker{
+		db:        db,
+		schema:    schema,
+		timeout:   timeout,
+		notifiers: notifiers,
+	}
+}
+
+// StartShutdown 以降の readiness は 503 を返す（ロードバランサーに切り離させてから HTTP を止める）
+func (c *Checker) StartShutdown() {
+	c.shuttingDown.Store(true)
+}
+
+// Check は依存先の状態を確認する
+func (c *Checker) Check(ctx context.Context) Report {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	report := Report{
+		Status:       StatusOK,
+		ShuttingDown: c.shuttingDown.Load(),
+		Database:     c.checkDatabase(ctx),
+		Notifiers:    c.checkNotifiers(),
+	}
+	// DB に繋がらなければスキーマも読めないため確認しない
+	if report.Database.Status == StatusOK {
+		report.Migrations = c.checkMigrations(ctx)
+	} else {
+		report.Migrations = MigrationCheck{Status: StatusUnavailable, Error: "database unavailable"}
+	}
+
+	if report.ShuttingDown || report.Database.Status != StatusOK || report.Migrations.Status != StatusOK {
+		report.Status = StatusUnavailable
+	}
+	return report
+}
+
+func (c *Checker) checkDatabase(ctx context.Context) DatabaseCheck {
+	start := time.Now()
+	err := c.db.PingContext(ctx)
+	check := DatabaseCheck{Status: StatusOK, LatencyMS: time.Since(start).Milliseconds()}
+	if err != nil {
+		check.Status = StatusUnavailable
+		check.Error = err.Error()
+	}
+	return check
+}
+
+func (c *Checker) checkMigrations(ctx context.Context) MigrationCheck {
+	status, err := c.schema(ctx)
+	if err != nil {
+		return MigrationCheck{Status: StatusUnavailable, Error: err.Error()}
+	}
+
+	check := MigrationCheck{Status: StatusOK, Version: status.Version, Latest: status.Latest, Dirty: status.Dirty}
+	if status.Dirty || status.Pending() {
+		check.Status = StatusUnavailable
+		check.Error = db.ErrSchemaBehind.Error()
+	}
+	return check
+}
+
+func (c *Checker) checkNotifiers() map[string]NotifierCheck {
+	checks := make(map[string]NotifierCheck, len(c.notifiers))
+	for _, n := range c.notifiers {
+		check := NotifierCheck{Status: StatusNotConfigured, Channels: []dn.Channel{}}
+		for _, ch := range channels {
+			if n.Configured(ch) {
+				check.Channels = append(check.Channels, ch)
+			}
+		}
+		if len(check.Channels) > 0 {
+			check.Status = StatusOK
+		}
+		checks[n.Name()] = check
+	}
+	return checks
+}
+
+// Live はプロセスが応答できれば 200 を返す（依存先は確認しない）
+func (c *Checker) Live(ctx echo.Context) error {
+	return ctx.NoContent(http.StatusOK)
+}
+
+// Ready は依存先の確認結果を返す（受け付けられない場合は 503）
+func (c *Checker) Ready(ctx echo.Context) error {
+	report := c.Check(ctx.Request().Context())
+	status := http.StatusOK
+	if report.Status != StatusOK {
+		status = http.StatusServiceUnavailable
+	}
+	return ctx.JSON(status, report)
+}
@@ -0,0 +1,130 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	dn "gogym-api/internal/domain/entities/notification"
+	"gogym-api/internal/infra/db"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+type stubPinger struct {
+	err error
+}
+
+func (p stubPinger) PingContext(ctx context.Context) error {
+	if p.err != nil {
+		return p.err
+	}
+	return ctx.Err()
+}
+
+// blockingPinger はコンテキストが終わるまで応答しない DB
+type blockingPinger struct{}
+
+func (blockingPinger) PingContext(ctx context.Context) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+type stubNotifier struct {
+	name       string
+	configured map[dn.Channel]bool
+}
+
+func (n stubNotifier) Name() string                                { return n.name }
+func (n stubNotifier) Configured(ch dn.Channel) bool               { return n.configured[ch] }
+func (stubNotifier) Notify(context.Context, dn.Notification) error { return nil }
+
+func schemaAt(version, latest uint, dirty bool) SchemaStatusFunc {
+	return func(context.Context) (db.MigrationStatus, error) {
+		return db.MigrationStatus{Version: version, Latest: latest, Dirty: dirty}, nil
+	}
+}
+
+func TestChecker_Ready(t *testing.T) {
+	t.Parallel()
+
+	slack := stubNotifier{name: "slack", configured: map[dn.Channel]bool{dn.ChannelContact: true, dn.ChannelErrors: true}}
+	discord := stubNotifier{name: "discord"}
+
+	ready := func(c *Checker) (int, Report) {
+		e := echo.New()
+		rec := httptest.NewRecorder()
+		ctx := e.NewContext(httptest.NewRequest(http.MethodGet, "/readyz", nil), rec)
+		require.NoError(t, c.Ready(ctx))
+
+		var report Report
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &report))
+		return rec.Code, report
+	}
+
+	t.Run("正常系: DB・スキーマが最新なら 200 と内訳を返す", func(t *testing.T) {
+		t.Parallel()
+
+		code, report := ready(NewChecker(stubPinger{}, schemaAt(12, 12, false), time.Second, slack, discord))
+		require.Equal(t, http.StatusOK, code)
+		require.Equal(t, StatusOK, report.Status)
+		require.False(t, report.ShuttingDown)
+		require.Equal(t, StatusOK, report.Database.Status)
+		require.Equal(t, MigrationCheck{Status: StatusOK, Version: 12, Latest: 12}, report.Migrations)
+
+		// 通知の未設定は readiness に影響しない
+		require.Equal(t, NotifierCheck{Status: StatusOK, Channels: []dn.Channel{dn.ChannelContact, dn.ChannelErrors}}, report.Notifiers["slack"])
+		require.Equal(t, NotifierCheck{Status: StatusNotConfigured, Channels: []dn.Channel{}}, report.Notifiers["discord"])
+	})
+
+	t.Run("異常系: DB に繋がらなければ 503", func(t *testing.T) {
+		t.Parallel()
+
+		code, report := ready(NewChecker(stubPinger{err: errors.New("connection refused")}, schemaAt(12, 12, false), time.Second))
+		require.Equal(t, http.StatusServiceUnavailable, code)
+		require.Equal(t, StatusUnavailable, report.Status)
+		require.Equal(t, "connection refused", report.Database.Error)
+		require.Equal(t, StatusUnavailable, report.Migrations.Status)
+	})
+
+	t.Run("異常系: DB の応答がタイムアウトすれば 503", func(t *testing.T) {
+		t.Parallel()
+
+		code, report := ready(NewChecker(blockingPinger{}, schemaAt(12, 12, false), 10*time.Millisecond))
+		require.Equal(t, http.StatusServiceUnavailable, code)
+		require.Equal(t, context.DeadlineExceeded.Error(), report.Database.Error)
+	})
+
+	t.Run("異常系: 未適用・dirty のマイグレーションがあれば 503", func(t *testing.T) {
+		t.Parallel()
+
+		code, report := ready(NewChecker(stubPinger{}, schemaAt(11, 12, false), time.Second))
+		require.Equal(t, http.StatusServiceUnavailable, code)
+		require.Equal(t, MigrationCheck{Status: StatusUnavailable, Version: 11, Latest: 12, Error: db.ErrSchemaBehind.Error()}, report.Migrations)
+
+		code, report = ready(NewChecker(stubPinger{}, schemaAt(12, 12, true), time.Second))
+		require.Equal(t, http.StatusServiceUnavailable, code)
+		require.True(t, report.Migrations.Dirty)
+	})
+
+	t.Run("異常系: シャットダウン開始後は依存先が正常でも 503（liveness は 200 のまま）", func(t *testing.T) {
+		t.Parallel()
+
+		c := NewChecker(stubPinger{}, schemaAt(12, 12, false), time.Second)
+		c.StartShutdown()
+
+		code, report := ready(c)
+		require.Equal(t, http.StatusServiceUnavailable, code)
+		require.True(t, report.ShuttingDown)
+		require.Equal(t, StatusOK, report.Database.Status)
+
+		rec := httptest.NewRecorder()
+		require.NoError(t, c.Live(echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/livez", nil), rec)))
+		require.Equal(t, http.StatusOK, rec.Code)
+	})
+}
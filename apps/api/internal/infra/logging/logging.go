@@ -0,0 +1,50 @@
+// Package logging はリクエスト単位の属性（リクエストID・ユーザーID・ルート）を付けて slog のログを出力する
+// メールアドレスと IP アドレスの属性は出力前にマスクする
+package logging
+
+import (
+	"context"
+	"io"
+	"log/slog"
+)
+
+type attrsKey struct{}
+
+// WithAttrs はコンテキストにログの属性を追加する
+// slog.InfoContext などコンテキスト付きで出したログにはすべてこの属性が付く
+func WithAttrs(ctx context.Context, attrs ...slog.Attr) context.Context {
+	parent, _ := ctx.Value(attrsKey{}).([]slog.Attr)
+	merged := make([]slog.Attr, 0, len(parent)+len(attrs))
+	merged = append(merged, parent...)
+	merged = append(merged, attrs...)
+	return context.WithValue(ctx, attrsKey{}, merged)
+}
+
+// NewHandler は本番（APP_ENV=production）では JSON、それ以外ではテキストで出力するハンドラーを返す
+func NewHandler(w io.Writer, env string) slog.Handler {
+	opts := &slog.HandlerOptions{ReplaceAttr: Redact}
+	if env == "production" {
+		return &contextHandler{Handler: slog.NewJSONHandler(w, opts)}
+	}
+	return &contextHandler{Handler: slog.NewTextHandler(w, opts)}
+}
+
+// contextHandler は WithAttrs でコンテキストに積んだ属性をログに付ける
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h *contextHandler) Handle(ctx context.Context, r slog.Record) error {
+	if attrs, ok := ctx.Value(attrsKey{}).([]slog.Attr); ok {
+		r.AddAttrs(attrs...)
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h *contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+func (h *contextHandler) WithGroup(name string) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithGroup(name)}
+}
@@ -0,0 +1,62 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("正常系: 本番ではコンテキストの属性を付けて JSON で出力する", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+		logger := slog.New(NewHandler(&buf, "production"))
+
+		ctx := WithAttrs(context.Background(), slog.String("request_id", "rid-1"), slog.String("route", "/api/v1/user"))
+		ctx = WithAttrs(ctx, slog.String("user_id", "01FGZ9K6TV3J5ZZZQX6Z9X6K7W"))
+		logger.InfoContext(ctx, "Request rejected", "status", 409)
+
+		var got map[string]any
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &got))
+		require.Equal(t, "Request rejected", got["msg"])
+		require.Equal(t, "rid-1", got["request_id"])
+		require.Equal(t, "/api/v1/user", got["route"])
+		require.Equal(t, "01FGZ9K6TV3J5ZZZQX6Z9X6K7W", got["user_id"])
+		require.EqualValues(t, 409, got["status"])
+	})
+
+	t.Run("正常系: 本番以外はテキストで出力する", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+		slog.New(NewHandler(&buf, "development")).InfoContext(context.Background(), "Starting server")
+
+		require.Contains(t, buf.String(), `msg="Starting server"`)
+		require.NotContains(t, buf.String(), "request_id")
+	})
+
+	t.Run("正常系: メールアドレスと IP アドレスをマスクする", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+		logger := slog.New(NewHandler(&buf, "production"))
+		logger.With("email", "user@example.com").Warn("Contact submission quarantined",
+			"ip", "203.0.113.42", "clientIP", "2001:db8:1234:5678::1", "remote_ip", "unknown")
+
+		var got map[string]any
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &got))
+		require.Equal(t, "u***@example.com", got["email"])
+		require.Equal(t, "203.0.113.0/24", got["ip"])
+		require.Equal(t, "2001:db8:1234::/48", got["clientIP"])
+		require.Equal(t, "[REDACTED]", got["remote_ip"])
+		require.NotContains(t, buf.String(), "user@example.com")
+		require.NotContains(t, buf.String(), "203.0.113.42")
+	})
+}
@@ -0,0 +1,51 @@
+package logging
+
+import (
+	"log/slog"
+	"net/netip"
+	"strings"
+)
+
+const redacted = "[REDACTED]"
+
+// Redact はメールアドレス・IP アドレスの属性をマスクする（slog.HandlerOptions.ReplaceAttr 用）
+// キー名で判定する（email, ip, clientIP など大文字小文字・区切りは問わない）
+func Redact(_ []string, a slog.Attr) slog.Attr {
+	switch normalizeKey(a.Key) {
+	case "email", "useremail":
+		return slog.String(a.Key, maskEmail(a.Value.String()))
+	case "ip", "clientip", "remoteip":
+		return slog.String(a.Key, maskIP(a.Value.String()))
+	}
+	return a
+}
+
+func normalizeKey(key string) string {
+	return strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(key))
+}
+
+// maskEmail はローカル部の先頭1文字とドメインだけを残す（user@example.com → u***@example.com）
+func maskEmail(email string) string {
+	local, domain, ok := strings.Cut(email, "@")
+	if !ok || local == "" {
+		return redacted
+	}
+	return local[:1] + "***@" + domain
+}
+
+// maskIP はネットワーク部だけを残す（IPv4 は /24、IPv6 は /48）
+func maskIP(ip string) string {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return redacted
+	}
+	bits := 24
+	if addr.Is6() && !addr.Is4In6() {
+		bits = 48
+	}
+	prefix, err := addr.Unmap().Prefix(bits)
+	if err != nil {
+		return redacted
+	}
+	return prefix.String()
+}
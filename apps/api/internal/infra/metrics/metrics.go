@@ -0,0 +1,133 @@
+// Package metrics は HTTP リクエスト・DB の接続プール・業務イベントの Prometheus メトリクスを集計して公開する
+package metrics
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"strconv"
+	"time"
+
+	dn "gogym-api/internal/domain/entities/notification"
+	"gogym-api/internal/infra/notify"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "gogym"
+
+// Metrics はアプリケーションのメトリクスのレジストリ
+// 業務イベントのメソッドは各ユースケースの出力ポート（WorkoutSaved など）を満たす
+type Metrics struct {
+	registry *prometheus.Registry
+
+	httpRequests *prometheus.CounterVec
+	httpDuration *prometheus.HistogramVec
+
+	workoutsSaved       prometheus.Counter
+	signUps             prometheus.Counter
+	loginsFailed        prometheus.Counter
+	notificationsFailed *prometheus.CounterVec
+}
+
+// New はプロセス・Go ランタイムのメトリクスを含むレジストリを作る
+func New() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		httpRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "http_requests_total",
+			Help:      "HTTP requests by method, route template and status.",
+		}, []string{"method", "route", "status"}),
+		httpDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "http_request_duration_seconds",
+			Help:      "HTTP request latency by method, route template and status.",
+			Buckets:   []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10},
+		}, []string{"method", "route", "status"}),
+		workoutsSaved: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "workouts_saved_total",
+			Help:      "Workout records created or updated.",
+		}),
+		signUps: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "signups_total",
+			Help:      "Users signed up.",
+		}),
+		loginsFailed: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "logins_failed_total",
+			Help:      "Login attempts rejected for invalid credentials.",
+		}),
+		notificationsFailed: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "notification_deliveries_failed_total",
+			Help:      "Failed notification deliveries by notifier (slack, discord, webhook) and channel.",
+		}, []string{"notifier", "channel"}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.httpRequests,
+		m.httpDuration,
+		m.workoutsSaved,
+		m.signUps,
+		m.loginsFailed,
+		m.notificationsFailed,
+	)
+	return m
+}
+
+// RegisterDB は接続プールの統計（sql.DBStats）をゲージとして公開する
+func (m *Metrics) RegisterDB(db *sql.DB) error {
+	return m.registry.Register(collectors.NewDBStatsCollector(db, namespace))
+}
+
+// Handler は Prometheus のテキスト形式でメトリクスを返す
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{Registry: m.registry})
+}
+
+// ObserveHTTPRequest はリクエスト数とレイテンシを記録する（route はルートのテンプレート、例: /api/v1/workouts/records/:id）
+func (m *Metrics) ObserveHTTPRequest(method, route string, status int, elapsed time.Duration) {
+	code := strconv.Itoa(status)
+	m.httpRequests.WithLabelValues(method, route, code).Inc()
+	m.httpDuration.WithLabelValues(method, route, code).Observe(elapsed.Seconds())
+}
+
+// WorkoutSaved はセッションの保存（作成・更新）を数える
+func (m *Metrics) WorkoutSaved() {
+	m.workoutsSaved.Inc()
+}
+
+// SignedUp はユーザー登録を数える
+func (m *Metrics) SignedUp() {
+	m.signUps.Inc()
+}
+
+// LoginFailed は認証情報の誤りによるログインの失敗を数える
+func (m *Metrics) LoginFailed() {
+	m.loginsFailed.Inc()
+}
+
+// CountFailures は送信に失敗した通知を数える送信先を返す
+func (m *Metrics) CountFailures(n notify.Notifier) notify.Notifier {
+	return &countingNotifier{Notifier: n, failed: m.notificationsFailed}
+}
+
+type countingNotifier struct {
+	notify.Notifier
+	failed *prometheus.CounterVec
+}
+
+func (n *countingNotifier) Notify(ctx context.Context, notification dn.Notification) error {
+	err := n.Notifier.Notify(ctx, notification)
+	if err != nil {
+		n.failed.WithLabelValues(n.Name(), string(notification.Channel)).Inc()
+	}
+	return err
+}
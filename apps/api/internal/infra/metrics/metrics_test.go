@@ -0,0 +1,105 @@
+package metrics
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	dn "gogym-api/internal/domain/entities/notification"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+type stubNotifier struct {
+	err error
+}
+
+func (stubNotifier) Name() string                                    { return "slack" }
+func (stubNotifier) Configured(dn.Channel) bool                      { return true }
+func (n stubNotifier) Notify(context.Context, dn.Notification) error { return n.err }
+
+// scrape は /metrics の本文を返す
+func scrape(t *testing.T, m *Metrics) string {
+	t.Helper()
+
+	rec := httptest.NewRecorder()
+	m.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	body, err := io.ReadAll(rec.Body)
+	require.NoError(t, err)
+	return string(body)
+}
+
+func TestMetrics(t *testing.T) {
+	t.Parallel()
+
+	t.Run("正常系: HTTPリクエストをルートのテンプレートとステータスごとに数える", func(t *testing.T) {
+		t.Parallel()
+
+		m := New()
+		m.ObserveHTTPRequest(http.MethodGet, "/api/v1/workouts/records/:id", http.StatusOK, 20*time.Millisecond)
+		m.ObserveHTTPRequest(http.MethodGet, "/api/v1/workouts/records/:id", http.StatusOK, 30*time.Millisecond)
+		m.ObserveHTTPRequest(http.MethodGet, "/api/v1/workouts/records/:id", http.StatusNotFound, time.Millisecond)
+
+		require.Equal(t, 2.0, testutil.ToFloat64(m.httpRequests.WithLabelValues(http.MethodGet, "/api/v1/workouts/records/:id", "200")))
+		require.Equal(t, 1.0, testutil.ToFloat64(m.httpRequests.WithLabelValues(http.MethodGet, "/api/v1/workouts/records/:id", "404")))
+
+		body := scrape(t, m)
+		require.Contains(t, body, `gogym_http_request_duration_seconds_count{method="GET",route="/api/v1/workouts/records/:id",status="200"} 2`)
+		require.Contains(t, body, "go_goroutines")
+	})
+
+	t.Run("正常系: 業務イベントを数える", func(t *testing.T) {
+		t.Parallel()
+
+		m := New()
+		m.WorkoutSaved()
+		m.WorkoutSaved()
+		m.SignedUp()
+		m.LoginFailed()
+
+		require.Equal(t, 2.0, testutil.ToFloat64(m.workoutsSaved))
+		require.Equal(t, 1.0, testutil.ToFloat64(m.signUps))
+		require.Equal(t, 1.0, testutil.ToFloat64(m.loginsFailed))
+	})
+
+	t.Run("正常系: 接続プールの統計を公開する", func(t *testing.T) {
+		t.Parallel()
+
+		// sql.Open は接続しないため DB がなくても統計は取れる
+		db, err := sql.Open("pgx", "postgres://localhost:1/unused")
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = db.Close() })
+		db.SetMaxOpenConns(7)
+
+		m := New()
+		require.NoError(t, m.RegisterDB(db))
+
+		body := scrape(t, m)
+		require.Contains(t, body, `go_sql_max_open_connections{db_name="gogym"} 7`)
+		require.Contains(t, body, "go_sql_in_use_connections")
+	})
+
+	t.Run("異常系: 送信に失敗した通知を送信先とチャンネルごとに数える", func(t *testing.T) {
+		t.Parallel()
+
+		m := New()
+		ok := m.CountFailures(stubNotifier{})
+		failing := m.CountFailures(stubNotifier{err: errors.New("slack down")})
+
+		n := dn.Notification{Channel: dn.ChannelSignups, Title: "signed up"}
+		require.NoError(t, ok.Notify(context.Background(), n))
+		require.Error(t, failing.Notify(context.Background(), n))
+		require.Equal(t, "slack", failing.Name())
+
+		require.Equal(t, 1.0, testutil.ToFloat64(m.notificationsFailed.WithLabelValues("slack", "signups")))
+	})
+}
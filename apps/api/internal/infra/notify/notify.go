@@ -0,0 +1,97 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	dn "gogym-api/internal/domain/entities/notification"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+// Notifier は通知の送信先（Slack / Discord / 汎用Webhook）
+type Notifier interface {
+	// Name はログ用の送信先名
+	Name() string
+	// Configured はチャンネルの送信先が設定されているか
+	Configured(ch dn.Channel) bool
+	Notify(ctx context.Context, n dn.Notification) error
+}
+
+// Multi は設定済みのすべての送信先に通知する
+// 一部だけ失敗した場合もエラーを返すため、リトライ時は成功済みの送信先にも再送される（at-least-once）
+type Multi struct {
+	notifiers []Notifier
+}
+
+func NewMulti(notifiers ...Notifier) *Multi {
+	return &Multi{notifiers: notifiers}
+}
+
+// Notify は チャンネルが設定された送信先すべてに通知する
+func (m *Multi) Notify(ctx context.Context, n dn.Notification) error {
+	if err := n.Validate(); err != nil {
+		return err
+	}
+
+	sent := 0
+	var errs []error
+	for _, nt := range m.notifiers {
+		if !nt.Configured(n.Channel) {
+			continue
+		}
+		sent++
+		if err := nt.Notify(ctx, n); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", nt.Name(), err))
+		}
+	}
+
+	if sent == 0 {
+		return fmt.Errorf("%w: %s", dn.ErrChannelNotConfigured, n.Channel)
+	}
+	return errors.Join(errs...)
+}
+
+// NewHTTPClient は送信先への HTTP クライアント（送信ごとにクライアントスパンを記録する）
+func NewHTTPClient() *http.Client {
+	return &http.Client{
+		Timeout:   5 * time.Second,
+		Transport: otelhttp.NewTransport(http.DefaultTransport),
+	}
+}
+
+// PostJSON は body をJSONでPOSTし、2xx以外をエラーにする
+func PostJSON(ctx context.Context, client *http.Client, url string, body any) error {
+	if url == "" {
+		return dn.ErrChannelNotConfigured
+	}
+
+	b, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(res.Body, 512))
+		return fmt.Errorf("webhook request failed: status=%d body=%q", res.StatusCode, string(msg))
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package notify_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"gogym-api/internal/configs"
+	dn "gogym-api/internal/domain/entities/notification"
+	"gogym-api/internal/infra/notify"
+	"gogym-api/internal/infra/notify/notifytest"
+	"gogym-api/internal/infra/slack"
+	"gogym-api/internal/infra/webhook"
+)
+
+func TestMulti_Notify(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	errorNotification := dn.Notification{Channel: dn.ChannelErrors, Title: "boom"}
+
+	t.Run("正常系: 設定済みの送信先すべてに送信する", func(t *testing.T) {
+		t.Parallel()
+
+		slackSrv := notifytest.NewServer(t)
+		hookSrv := notifytest.NewServer(t)
+
+		sc, err := slack.NewClient(configs.SlackConfig{ErrorsWebhookURL: slackSrv.URLFor("/errors")})
+		require.NoError(t, err)
+		wc, err := webhook.NewClient(configs.WebhookConfig{URL: hookSrv.URLFor("/hook")})
+		require.NoError(t, err)
+
+		require.NoError(t, notify.NewMulti(sc, wc).Notify(ctx, errorNotification))
+
+		require.Len(t, slackSrv.Requests(), 1)
+		require.Len(t, hookSrv.Requests(), 1)
+
+		var got map[string]any
+		hookSrv.Requests()[0].Decode(t, &got)
+		require.Equal(t, "errors", got["channel"])
+		require.Equal(t, "boom", got["title"])
+		require.NotEmpty(t, got["sent_at"])
+	})
+
+	t.Run("正常系: チャンネル未設定の送信先はスキップする", func(t *testing.T) {
+		t.Parallel()
+
+		hookSrv := notifytest.NewServer(t)
+
+		sc, err := slack.NewClient(configs.SlackConfig{}) // 全チャンネル未設定
+		require.NoError(t, err)
+		wc, err := webhook.NewClient(configs.WebhookConfig{URL: hookSrv.URLFor("/hook")})
+		require.NoError(t, err)
+
+		require.NoError(t, notify.NewMulti(sc, wc).Notify(ctx, errorNotification))
+		require.Len(t, hookSrv.Requests(), 1)
+	})
+
+	t.Run("異常系: どの送信先も未設定の場合は ErrChannelNotConfigured", func(t *testing.T) {
+		t.Parallel()
+
+		sc, err := slack.NewClient(configs.SlackConfig{})
+		require.NoError(t, err)
+
+		err = notify.NewMulti(sc).Notify(ctx, errorNotification)
+		require.ErrorIs(t, err, dn.ErrChannelNotConfigured)
+	})
+
+	t.Run("異常系: 一部の送信先が失敗した場合はエラーを返す", func(t *testing.T) {
+		t.Parallel()
+
+		slackSrv := notifytest.NewServer(t)
+		slackSrv.RespondWith(http.StatusServiceUnavailable)
+		hookSrv := notifytest.NewServer(t)
+
+		sc, err := slack.NewClient(configs.SlackConfig{ErrorsWebhookURL: slackSrv.URLFor("/errors")})
+		require.NoError(t, err)
+		wc, err := webhook.NewClient(configs.WebhookConfig{URL: hookSrv.URLFor("/hook")})
+		require.NoError(t, err)
+
+		err = notify.NewMulti(sc, wc).Notify(ctx, errorNotification)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "slack")
+		require.Len(t, hookSrv.Requests(), 1)
+	})
+}
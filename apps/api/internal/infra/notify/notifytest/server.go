@@ -0,0 +1,80 @@
+// Package notifytest はWebhook送信のテスト用HTTPサーバーを提供する
+package notifytest
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+// Request は受信したWebhookリクエスト
+type Request struct {
+	Path        string
+	ContentType string
+	Body        []byte
+}
+
+// Decode は受信したJSONを v にデコードする
+func (r Request) Decode(t testing.TB, v any) {
+	t.Helper()
+	if err := json.Unmarshal(r.Body, v); err != nil {
+		t.Fatalf("failed to decode webhook body: %v (%s)", err, r.Body)
+	}
+}
+
+// Server はリクエストを記録し、指定したステータスを返すテスト用サーバー
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	requests []Request
+	status   int
+}
+
+// NewServer は 200 を返すテストサーバーを起動する（テスト終了時に停止）
+func NewServer(t testing.TB) *Server {
+	t.Helper()
+
+	s := &Server{status: http.StatusOK}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		s.mu.Lock()
+		s.requests = append(s.requests, Request{
+			Path:        r.URL.Path,
+			ContentType: r.Header.Get("Content-Type"),
+			Body:        body,
+		})
+		status := s.status
+		s.mu.Unlock()
+
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+// URLFor はテストサーバー上のパスのURLを返す（チャンネルごとのWebhook URLとして使う）
+func (s *Server) URLFor(path string) string {
+	return s.URL + path
+}
+
+// RespondWith は以降のレスポンスステータスを変更する
+func (s *Server) RespondWith(status int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.status = status
+}
+
+// Requests は受信したリクエストのコピーを返す
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]Request, len(s.requests))
+	copy(out, s.requests)
+	return out
+}
@@ -4,13 +4,21 @@ package server
 import (
 	"encoding/json"
 	"gogym-api/internal/configs"
+	appmw "gogym-api/internal/middleware"
 	"log/slog"
+	"slices"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/labstack/echo/otelecho"
 )
 
-func NewEcho(httpCfg configs.HTTPConfig) *echo.Echo {
+// unobservedPaths はトレース・メトリクスの対象外にするパス（監視・プローブからの定期的なリクエスト）
+var unobservedPaths = []string{"/metrics", "/healthz", "/livez", "/readyz"}
+
+// NewEcho は共通ミドルウェアを設定した Echo を作る
+// recorder にはルートのテンプレートとステータスごとのリクエスト数・レイテンシを記録する（/metrics・プローブは除く）
+func NewEcho(httpCfg configs.HTTPConfig, recorder appmw.HTTPMetricsRecorder) *echo.Echo {
 	e := echo.New()
 	e.HideBanner = true
 
@@ -21,28 +29,34 @@ func NewEcho(httpCfg configs.HTTPConfig) *echo.Echo {
 	e.Use(middleware.RequestID()) // リクエストIDを付与
 	e.Use(middleware.Secure())    // セキュリティヘッダを付与（XSS/Clickjacking などの軽減）
 	e.Use(middleware.Gzip())      // Gzip 圧縮を有効化
+	// 以降のログにリクエストIDとルートを付ける
+	e.Use(appmw.RequestContextMiddleware())
 	e.Use(middleware.CORSWithConfig(middleware.CORSConfig{
 		AllowOrigins:     httpCfg.CORS.AllowOrigins,
 		AllowMethods:     httpCfg.CORS.AllowMethods,
 		AllowHeaders:     httpCfg.CORS.AllowHeaders,
 		AllowCredentials: httpCfg.CORS.AllowCreds,
 	}))
+	// リクエストごとのサーバースパンを開始する（traceparent があれば呼び出し元のトレースに繋げる）
+	e.Use(otelecho.Middleware("gogym-api", otelecho.WithSkipper(func(c echo.Context) bool {
+		return slices.Contains(unobservedPaths, c.Path())
+	})))
 	e.Use(middleware.RequestLoggerWithConfig(middleware.RequestLoggerConfig{
 		LogLatency: true,
 		LogMethod:  true,
 		LogURI:     true,
 		LogStatus:  true,
 		LogValuesFunc: func(c echo.Context, v middleware.RequestLoggerValues) error {
-			slog.Info("HTTP Request",
+			slog.InfoContext(c.Request().Context(), "HTTP Request",
 				"method", v.Method,
 				"uri", v.URI,
 				"status", v.Status,
 				"latency", v.Latency.String(),
-				"rid", c.Response().Header().Get(echo.HeaderXRequestID),
 			)
 			return nil
 		},
 	}))
+	e.Use(appmw.MetricsMiddleware(recorder, unobservedPaths...))
 
 	return e
 }
@@ -1,59 +1,91 @@
 package slack
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
-	"errors"
 	"gogym-api/internal/configs"
+	"gogym-api/internal/infra/notify"
 	"net/http"
-	"time"
+
+	dn "gogym-api/internal/domain/entities/notification"
 )
 
 type Client struct {
 	httpClient *http.Client
-	contactURL string
+	webhooks   map[dn.Channel]string
 }
 
 func NewClient(sc configs.SlackConfig) (*Client, error) {
 	return &Client{
-		httpClient: &http.Client{Timeout: 5 * time.Second},
-		contactURL: sc.ContactWebhookURL,
+		httpClient: notify.NewHTTPClient(),
+		webhooks: map[dn.Channel]string{
+			dn.ChannelContact: sc.ContactWebhookURL,
+			dn.ChannelSignups: sc.SignupsWebhookURL,
+			dn.ChannelErrors:  sc.ErrorsWebhookURL,
+		},
 	}, nil
 }
 
+// Block Kit のペイロード
+// https://api.slack.com/reference/block-kit/blocks
 type payload struct {
+	Text   string  `json:"text"` // 通知プレビュー用のフォールバック
+	Blocks []block `json:"blocks"`
+}
+
+type block struct {
+	Type     string       `json:"type"`
+	Text     *textObject  `json:"text,omitempty"`
+	Fields   []textObject `json:"fields,omitempty"`
+	Elements []textObject `json:"elements,omitempty"`
+}
+
+type textObject struct {
+	Type string `json:"type"`
 	Text string `json:"text"`
 }
 
-func (c *Client) NotifyContact(ctx context.Context, text string) error {
-	return c.postJSON(ctx, c.contactURL, payload{Text: text})
+func (c *Client) Name() string {
+	return "slack"
 }
 
-func (c *Client) postJSON(ctx context.Context, url string, body any) error {
-	if url == "" {
-		return errors.New("slack webhook url is empty")
+func (c *Client) Configured(ch dn.Channel) bool {
+	return c.webhooks[ch] != ""
+}
+
+func (c *Client) Notify(ctx context.Context, n dn.Notification) error {
+	return notify.PostJSON(ctx, c.httpClient, c.webhooks[n.Channel], buildPayload(n))
+}
+
+// buildPayload は通知を header / section / fields / context のブロックに変換する
+func buildPayload(n dn.Notification) payload {
+	blocks := []block{
+		{Type: "header", Text: &textObject{Type: "plain_text", Text: n.Title}},
 	}
 
-	b, err := json.Marshal(body)
-	if err != nil {
-		return err
+	if n.Text != "" {
+		blocks = append(blocks, block{Type: "section", Text: &textObject{Type: "mrkdwn", Text: n.Text}})
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(b))
-	if err != nil {
-		return err
+	if len(n.Fields) > 0 {
+		fields := make([]textObject, 0, len(n.Fields))
+		for _, f := range n.Fields {
+			fields = append(fields, textObject{Type: "mrkdwn", Text: "*" + f.Label + "*\n" + f.Value})
+		}
+		blocks = append(blocks, block{Type: "section", Fields: fields})
 	}
-	req.Header.Set("Content-Type", "application/json")
 
-	res, err := c.httpClient.Do(req)
-	if err != nil {
-		return err
+	if len(n.Context) > 0 {
+		elements := make([]textObject, 0, len(n.Context))
+		for _, f := range n.Context {
+			elements = append(elements, textObject{Type: "mrkdwn", Text: "*" + f.Label + ":* " + f.Value})
+		}
+		blocks = append(blocks, block{Type: "context", Elements: elements})
 	}
-	defer res.Body.Close()
 
-	if res.StatusCode < 200 || res.StatusCode >= 300 {
-		return errors.New("slack webhook request failed")
+	text := n.Title
+	if n.Text != "" {
+		text += "\n" + n.Text
 	}
-	return nil
+
+	return payload{Text: text, Blocks: blocks}
 }
@@ -0,0 +1,89 @@
+package slack
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"gogym-api/internal/configs"
+	dn "gogym-api/internal/domain/entities/notification"
+	"gogym-api/internal/infra/notify/notifytest"
+)
+
+func TestClient_Notify(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	n := dn.Notification{
+		Channel: dn.ChannelContact,
+		Title:   "📩 Contact Form Submission",
+		Text:    "ベンチプレスの記録が消えました",
+		Fields:  []dn.Field{{Label: "Contact ID", Value: "42"}},
+		Context: []dn.Field{
+			{Label: "Email", Value: "user@example.com"},
+			{Label: "IP", Value: "203.0.113.1"},
+		},
+	}
+
+	t.Run("正常系: Block Kit 形式でチャンネルのWebhookに送信する", func(t *testing.T) {
+		t.Parallel()
+
+		srv := notifytest.NewServer(t)
+		client, err := NewClient(configs.SlackConfig{
+			ContactWebhookURL: srv.URLFor("/contact"),
+			ErrorsWebhookURL:  srv.URLFor("/errors"),
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, client.Notify(ctx, n))
+
+		reqs := srv.Requests()
+		require.Len(t, reqs, 1)
+		require.Equal(t, "/contact", reqs[0].Path)
+		require.Equal(t, "application/json", reqs[0].ContentType)
+
+		var got payload
+		reqs[0].Decode(t, &got)
+		require.Contains(t, got.Text, "Contact Form Submission")
+		require.Len(t, got.Blocks, 4)
+
+		require.Equal(t, "header", got.Blocks[0].Type)
+		require.Equal(t, "plain_text", got.Blocks[0].Text.Type)
+
+		require.Equal(t, "section", got.Blocks[1].Type)
+		require.Equal(t, "ベンチプレスの記録が消えました", got.Blocks[1].Text.Text)
+
+		require.Equal(t, "section", got.Blocks[2].Type)
+		require.Equal(t, "*Contact ID*\n42", got.Blocks[2].Fields[0].Text)
+
+		require.Equal(t, "context", got.Blocks[3].Type)
+		require.Len(t, got.Blocks[3].Elements, 2)
+		require.Equal(t, "*Email:* user@example.com", got.Blocks[3].Elements[0].Text)
+	})
+
+	t.Run("正常系: 未設定のチャンネルは Configured が false になる", func(t *testing.T) {
+		t.Parallel()
+
+		client, err := NewClient(configs.SlackConfig{ContactWebhookURL: "https://hooks.example.com/contact"})
+		require.NoError(t, err)
+
+		require.True(t, client.Configured(dn.ChannelContact))
+		require.False(t, client.Configured(dn.ChannelSignups))
+		require.ErrorIs(t, client.Notify(ctx, dn.Notification{Channel: dn.ChannelSignups, Title: "x"}), dn.ErrChannelNotConfigured)
+	})
+
+	t.Run("異常系: Webhookが2xx以外を返した場合はエラー", func(t *testing.T) {
+		t.Parallel()
+
+		srv := notifytest.NewServer(t)
+		srv.RespondWith(http.StatusInternalServerError)
+		client, err := NewClient(configs.SlackConfig{ContactWebhookURL: srv.URLFor("/contact")})
+		require.NoError(t, err)
+
+		err = client.Notify(ctx, n)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "status=500")
+	})
+}
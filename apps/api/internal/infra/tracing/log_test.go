@@ -0,0 +1,47 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestLogHandler(t *testing.T) {
+	t.Parallel()
+
+	newLogger := func() (*slog.Logger, *bytes.Buffer) {
+		var buf bytes.Buffer
+		return slog.New(NewLogHandler(slog.NewTextHandler(&buf, nil))), &buf
+	}
+
+	t.Run("正常系: スパンのあるコンテキストのログにトレースIDとスパンIDを付ける", func(t *testing.T) {
+		t.Parallel()
+
+		tp := sdktrace.NewTracerProvider()
+		t.Cleanup(func() { _ = tp.Shutdown(context.Background()) })
+		ctx, span := tp.Tracer("test").Start(context.Background(), "request")
+		defer span.End()
+
+		logger, buf := newLogger()
+		logger.With("rid", "abc").InfoContext(ctx, "HTTP Request")
+
+		out := buf.String()
+		require.Contains(t, out, "trace_id="+span.SpanContext().TraceID().String())
+		require.Contains(t, out, "span_id="+span.SpanContext().SpanID().String())
+		require.Contains(t, out, "rid=abc")
+	})
+
+	t.Run("正常系: スパンがなければそのまま出力する", func(t *testing.T) {
+		t.Parallel()
+
+		logger, buf := newLogger()
+		logger.InfoContext(context.Background(), "Starting server")
+
+		require.Contains(t, buf.String(), "Starting server")
+		require.NotContains(t, buf.String(), "trace_id")
+	})
+}
@@ -0,0 +1,42 @@
+package webhook
+
+import (
+	"context"
+	"gogym-api/internal/configs"
+	"gogym-api/internal/infra/notify"
+	"net/http"
+	"time"
+
+	dn "gogym-api/internal/domain/entities/notification"
+)
+
+// Client は任意のエンドポイントに通知をそのままJSONで送る汎用Webhook
+// 1つのURLに全チャンネルを送り、受信側は channel で振り分ける
+type Client struct {
+	httpClient *http.Client
+	url        string
+}
+
+func NewClient(wc configs.WebhookConfig) (*Client, error) {
+	return &Client{
+		httpClient: notify.NewHTTPClient(),
+		url:        wc.URL,
+	}, nil
+}
+
+type payload struct {
+	dn.Notification
+	SentAt time.Time `json:"sent_at"`
+}
+
+func (c *Client) Name() string {
+	return "webhook"
+}
+
+func (c *Client) Configured(dn.Channel) bool {
+	return c.url != ""
+}
+
+func (c *Client) Notify(ctx context.Context, n dn.Notification) error {
+	return notify.PostJSON(ctx, c.httpClient, c.url, payload{Notification: n, SentAt: time.Now().UTC()})
+}
@@ -0,0 +1,33 @@
+package middleware
+
+import (
+	"fmt"
+	"log/slog"
+
+	dom "gogym-api/internal/domain/entities"
+
+	"github.com/labstack/echo/v4"
+)
+
+// AdminMiddleware は認証済みユーザーが管理者（ADMIN_USER_IDS）かチェックします
+// AuthMiddleware の後に適用すること
+func AdminMiddleware(adminUserIDs []string) echo.MiddlewareFunc {
+	admins := make(map[string]struct{}, len(adminUserIDs))
+	for _, id := range adminUserIDs {
+		if id != "" {
+			admins[id] = struct{}{}
+		}
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			userID, _ := c.Get("user_id").(string)
+			if _, ok := admins[userID]; !ok || userID == "" {
+				slog.WarnContext(c.Request().Context(), "Admin access denied")
+				return fmt.Errorf("%w: admin privileges required", dom.ErrForbidden)
+			}
+
+			return next(c)
+		}
+	}
+}
@@ -3,9 +3,11 @@ package middleware
 import (
 	"fmt"
 	"log/slog"
-	"net/http"
 	"strings"
 
+	dom "gogym-api/internal/domain/entities"
+	"gogym-api/internal/infra/logging"
+
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/labstack/echo/v4"
 )
@@ -14,45 +16,59 @@ import (
 func AuthMiddleware(jwtSecret string) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
-			// Authorization ヘッダーを取得
-			authHeader := c.Request().Header.Get("Authorization")
-
-			if authHeader == "" {
-				return echo.NewHTTPError(http.StatusUnauthorized, map[string]string{
-					"error": "missing authorization header",
-				})
+			if err := authenticate(c, jwtSecret); err != nil {
+				return err
 			}
+			return next(c)
+		}
+	}
+}
 
-			// Bearer トークンを抽出
-			parts := strings.Split(authHeader, " ")
-			if len(parts) != 2 || parts[0] != "Bearer" {
-				return echo.NewHTTPError(http.StatusUnauthorized, map[string]string{
-					"error": "invalid authorization header format",
-				})
+// OptionalAuthMiddleware はトークンがあればユーザーを認証し、なければ未ログインのまま通します
+// 未ログインでも使えるルート（問い合わせなど）でユーザーを紐付けるために使う
+// トークンが不正・期限切れの場合も拒否せず未ログインとして扱う
+func OptionalAuthMiddleware(jwtSecret string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if c.Request().Header.Get("Authorization") != "" {
+				_ = authenticate(c, jwtSecret)
 			}
+			return next(c)
+		}
+	}
+}
 
-			tokenString := parts[1]
-			if tokenString == "" {
-				return echo.NewHTTPError(http.StatusUnauthorized, map[string]string{
-					"error": "missing token",
-				})
-			}
+// authenticate は Authorization ヘッダーのトークンを検証し、user_id を Context に設定します
+func authenticate(c echo.Context, jwtSecret string) error {
+	// Authorization ヘッダーを取得
+	authHeader := c.Request().Header.Get("Authorization")
 
-			// トークンから user_id を抽出
-			userID, err := extractUserIDFromToken(tokenString, jwtSecret)
-			if err != nil {
-				slog.Error("Authentication failed", "error", err.Error())
-				return echo.NewHTTPError(http.StatusUnauthorized, map[string]string{
-					"error": "invalid or expired token",
-				})
-			}
+	if authHeader == "" {
+		return fmt.Errorf("%w: missing authorization header", dom.ErrUnauthorized)
+	}
 
-			// Context に user_id を設定
-			c.Set("user_id", userID)
+	// Bearer トークンを抽出
+	parts := strings.Split(authHeader, " ")
+	if len(parts) != 2 || parts[0] != "Bearer" {
+		return fmt.Errorf("%w: invalid authorization header format", dom.ErrUnauthorized)
+	}
 
-			return next(c)
-		}
+	tokenString := parts[1]
+	if tokenString == "" {
+		return fmt.Errorf("%w: missing token", dom.ErrUnauthorized)
 	}
+
+	// トークンから user_id を抽出
+	userID, err := extractUserIDFromToken(tokenString, jwtSecret)
+	if err != nil {
+		slog.InfoContext(c.Request().Context(), "Authentication failed", "error", err)
+		return fmt.Errorf("%w: invalid or expired token", dom.ErrUnauthorized)
+	}
+
+	// Context に user_id を設定（以降のログにも付ける）
+	c.Set("user_id", userID)
+	c.SetRequest(c.Request().WithContext(logging.WithAttrs(c.Request().Context(), slog.String("user_id", userID))))
+	return nil
 }
 
 // extractUserIDFromToken は access_token から user_id を抽出します
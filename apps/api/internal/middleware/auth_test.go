@@ -0,0 +1,87 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	dom "gogym-api/internal/domain/entities"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+const testJWTSecret = "test-secret-0123456789"
+
+func signTestToken(t *testing.T, secret string) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"sub": "01FGZ9K6TV3J5ZZZQX6Z9X6K7W",
+		"exp": time.Now().Add(time.Hour).Unix(),
+	}).SignedString([]byte(secret))
+	require.NoError(t, err)
+	return token
+}
+
+// runAuth は mw を通したハンドラが見た user_id を返す
+func runAuth(mw echo.MiddlewareFunc, header string) (string, error) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/contact", nil)
+	if header != "" {
+		req.Header.Set(echo.HeaderAuthorization, header)
+	}
+	c := e.NewContext(req, httptest.NewRecorder())
+
+	var userID string
+	err := mw(func(c echo.Context) error {
+		userID, _ = c.Get("user_id").(string)
+		return nil
+	})(c)
+	return userID, err
+}
+
+func TestAuthMiddleware(t *testing.T) {
+	t.Parallel()
+
+	t.Run("正常系: 有効なトークンのユーザーIDを設定する", func(t *testing.T) {
+		t.Parallel()
+
+		userID, err := runAuth(AuthMiddleware(testJWTSecret), "Bearer "+signTestToken(t, testJWTSecret))
+		require.NoError(t, err)
+		require.Equal(t, "01FGZ9K6TV3J5ZZZQX6Z9X6K7W", userID)
+	})
+
+	t.Run("異常系: トークンがない・不正な場合は401", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := runAuth(AuthMiddleware(testJWTSecret), "")
+		require.ErrorIs(t, err, dom.ErrUnauthorized)
+
+		_, err = runAuth(AuthMiddleware(testJWTSecret), "Bearer "+signTestToken(t, "other-secret-0123456789"))
+		require.ErrorIs(t, err, dom.ErrUnauthorized)
+	})
+}
+
+func TestOptionalAuthMiddleware(t *testing.T) {
+	t.Parallel()
+
+	t.Run("正常系: 有効なトークンがあればユーザーIDを設定する", func(t *testing.T) {
+		t.Parallel()
+
+		userID, err := runAuth(OptionalAuthMiddleware(testJWTSecret), "Bearer "+signTestToken(t, testJWTSecret))
+		require.NoError(t, err)
+		require.Equal(t, "01FGZ9K6TV3J5ZZZQX6Z9X6K7W", userID)
+	})
+
+	t.Run("正常系: トークンがない・不正な場合は未ログインとして通す", func(t *testing.T) {
+		t.Parallel()
+
+		for _, header := range []string{"", "Basic abc", "Bearer " + signTestToken(t, "other-secret-0123456789")} {
+			userID, err := runAuth(OptionalAuthMiddleware(testJWTSecret), header)
+			require.NoError(t, err, header)
+			require.Empty(t, userID, header)
+		}
+	})
+}
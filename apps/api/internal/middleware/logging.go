@@ -0,0 +1,26 @@
+package middleware
+
+import (
+	"log/slog"
+
+	"gogym-api/internal/infra/logging"
+
+	"github.com/labstack/echo/v4"
+)
+
+// RequestContextMiddleware はリクエストID とルートのテンプレートをログのコンテキストに載せます
+// middleware.RequestID の後に適用すること（ユーザーIDは AuthMiddleware が追加する）
+func RequestContextMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			attrs := []slog.Attr{slog.String("request_id", c.Response().Header().Get(echo.HeaderXRequestID))}
+			if route := c.Path(); route != "" {
+				attrs = append(attrs, slog.String("route", route))
+			}
+
+			ctx := logging.WithAttrs(c.Request().Context(), attrs...)
+			c.SetRequest(c.Request().WithContext(ctx))
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"gogym-api/internal/infra/logging"
+
+	"github.com/labstack/echo/v4"
+	echomw "github.com/labstack/echo/v4/middleware"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestContextMiddleware(t *testing.T) {
+	t.Parallel()
+
+	t.Run("正常系: ハンドラのログにリクエストIDとルートが付く", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+		logger := slog.New(logging.NewHandler(&buf, "production"))
+
+		e := echo.New()
+		e.Use(echomw.RequestID())
+		e.Use(RequestContextMiddleware())
+		e.GET("/records/:id", func(c echo.Context) error {
+			logger.InfoContext(c.Request().Context(), "Loaded record")
+			return c.NoContent(http.StatusOK)
+		})
+
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/records/1", nil)
+		req.Header.Set(echo.HeaderXRequestID, "rid-1")
+		e.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var got map[string]any
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &got))
+		require.Equal(t, "rid-1", got["request_id"])
+		require.Equal(t, "/records/:id", got["route"])
+	})
+}
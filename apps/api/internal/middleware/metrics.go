@@ -0,0 +1,68 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"strings"
+	"time"
+
+	dom "gogym-api/internal/domain/entities"
+
+	"github.com/labstack/echo/v4"
+)
+
+// unmatchedRoute はルートに一致しなかったリクエストのラベル（パスをそのまま使うと系列が際限なく増える）
+const unmatchedRoute = "unmatched"
+
+// HTTPMetricsRecorder はリクエスト数とレイテンシを記録する
+type HTTPMetricsRecorder interface {
+	ObserveHTTPRequest(method, route string, status int, elapsed time.Duration)
+}
+
+// MetricsMiddleware はリクエストをルートのテンプレート（/api/v1/workouts/records/:id など）とステータスごとに記録します
+// ハンドラが返したエラーはここでエラーハンドラに渡し、実際に返したステータスで記録する
+func MetricsMiddleware(recorder HTTPMetricsRecorder, skipPaths ...string) echo.MiddlewareFunc {
+	skip := make(map[string]bool, len(skipPaths))
+	for _, p := range skipPaths {
+		skip[p] = true
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if skip[c.Request().URL.Path] {
+				return next(c)
+			}
+
+			start := time.Now()
+			if err := next(c); err != nil {
+				c.Error(err)
+			}
+
+			route := c.Path()
+			if route == "" {
+				route = unmatchedRoute
+			}
+			recorder.ObserveHTTPRequest(c.Request().Method, route, c.Response().Status, time.Since(start))
+			return nil
+		}
+	}
+}
+
+// MetricsTokenMiddleware は /metrics の取得に Authorization: Bearer <token> を要求します
+// token が空の場合は認証しない（ネットワーク側で公開範囲を絞る前提）
+func MetricsTokenMiddleware(token string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if token == "" {
+				return next(c)
+			}
+
+			got, ok := strings.CutPrefix(c.Request().Header.Get(echo.HeaderAuthorization), "Bearer ")
+			if !ok || subtle.ConstantTimeCompare([]byte(got), []byte(token)) != 1 {
+				return fmt.Errorf("%w: invalid metrics token", dom.ErrUnauthorized)
+			}
+
+			return next(c)
+		}
+	}
+}
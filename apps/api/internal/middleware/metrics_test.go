@@ -0,0 +1,124 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	dom "gogym-api/internal/domain/entities"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+type observation struct {
+	method string
+	route  string
+	status int
+}
+
+type stubRecorder struct {
+	mu   sync.Mutex
+	seen []observation
+}
+
+func (r *stubRecorder) ObserveHTTPRequest(method, route string, status int, _ time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.seen = append(r.seen, observation{method: method, route: route, status: status})
+}
+
+func TestMetricsMiddleware(t *testing.T) {
+	t.Parallel()
+
+	newServer := func(recorder HTTPMetricsRecorder) *echo.Echo {
+		e := echo.New()
+		e.Use(MetricsMiddleware(recorder, "/metrics"))
+		e.GET("/records/:id", func(c echo.Context) error {
+			if c.Param("id") == "0" {
+				return echo.ErrNotFound
+			}
+			return c.NoContent(http.StatusOK)
+		})
+		e.GET("/metrics", func(c echo.Context) error {
+			return c.NoContent(http.StatusOK)
+		})
+		return e
+	}
+
+	serve := func(e *echo.Echo, path string) int {
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		return rec.Code
+	}
+
+	t.Run("正常系: パスではなくルートのテンプレートで記録する", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := &stubRecorder{}
+		e := newServer(recorder)
+		require.Equal(t, http.StatusOK, serve(e, "/records/1"))
+		require.Equal(t, http.StatusOK, serve(e, "/records/2"))
+
+		require.Equal(t, []observation{
+			{method: http.MethodGet, route: "/records/:id", status: http.StatusOK},
+			{method: http.MethodGet, route: "/records/:id", status: http.StatusOK},
+		}, recorder.seen)
+	})
+
+	t.Run("正常系: ハンドラのエラーは返したステータスで記録する", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := &stubRecorder{}
+		e := newServer(recorder)
+		require.Equal(t, http.StatusNotFound, serve(e, "/records/0"))
+		require.Equal(t, http.StatusNotFound, serve(e, "/no/such/path"))
+
+		require.Equal(t, []observation{
+			{method: http.MethodGet, route: "/records/:id", status: http.StatusNotFound},
+			{method: http.MethodGet, route: unmatchedRoute, status: http.StatusNotFound},
+		}, recorder.seen)
+	})
+
+	t.Run("正常系: 除外したパスは記録しない", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := &stubRecorder{}
+		require.Equal(t, http.StatusOK, serve(newServer(recorder), "/metrics"))
+		require.Empty(t, recorder.seen)
+	})
+}
+
+func TestMetricsTokenMiddleware(t *testing.T) {
+	t.Parallel()
+
+	run := func(token, header string) error {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+		if header != "" {
+			req.Header.Set(echo.HeaderAuthorization, header)
+		}
+		c := e.NewContext(req, httptest.NewRecorder())
+		return MetricsTokenMiddleware(token)(func(c echo.Context) error {
+			return nil
+		})(c)
+	}
+
+	t.Run("正常系: トークンが一致すれば通す", func(t *testing.T) {
+		t.Parallel()
+		require.NoError(t, run("secret", "Bearer secret"))
+	})
+
+	t.Run("正常系: トークン未設定なら認証しない", func(t *testing.T) {
+		t.Parallel()
+		require.NoError(t, run("", ""))
+	})
+
+	t.Run("異常系: トークンがない・違う場合は401", func(t *testing.T) {
+		t.Parallel()
+		require.ErrorIs(t, run("secret", ""), dom.ErrUnauthorized)
+		require.ErrorIs(t, run("secret", "Bearer wrong"), dom.ErrUnauthorized)
+	})
+}
@@ -0,0 +1,106 @@
+package middleware
+
+import (
+	"context"
+	"log/slog"
+
+	dom "gogym-api/internal/domain/entities"
+	"gogym-api/internal/util"
+
+	"github.com/labstack/echo/v4"
+	"golang.org/x/text/language"
+)
+
+// TimeZoneHeader はクライアントが自身のタイムゾーン（IANA 名）を伝えるヘッダー
+const TimeZoneHeader = "X-Time-Zone"
+
+// PreferencesFinder はユーザーが設定した表示設定を返す（未設定の項目はゼロ値）
+type PreferencesFinder interface {
+	Preferences(ctx context.Context, userID string) (dom.UserPreferences, error)
+}
+
+var localeMatcher = func() language.Matcher {
+	tags := make([]language.Tag, 0, len(dom.SupportedLocales))
+	for _, l := range dom.SupportedLocales {
+		tags = append(tags, language.Make(l))
+	}
+	return language.NewMatcher(tags)
+}()
+
+// PreferencesMiddleware はレスポンスに使う表示設定を決めて Context に設定します
+//   - "locale"（string）: ユーザーの設定 → Accept-Language → デフォルトロケールの順
+//   - "time_zone"（util.TimeZone）: ユーザーの設定 → X-Time-Zone ヘッダー → デフォルト（Asia/Tokyo）の順
+//   - "weight_units"（dom.WeightUnits）: ユーザーの設定、なければ kg・既定の丸め幅（ゼロ値）
+//
+// ユーザーの設定はリクエストごとに 1 回だけ読む
+// AuthMiddleware の後に置くこと（未認証の場合はヘッダーのみを見る）
+func PreferencesMiddleware(finder PreferencesFinder) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			ctx := c.Request().Context()
+
+			var prefs dom.UserPreferences
+			if userID, ok := c.Get("user_id").(string); ok && userID != "" && finder != nil {
+				preferred, err := finder.Preferences(ctx, userID)
+				if err != nil {
+					// 設定が読めなくてもリクエストはヘッダー・デフォルトで続行する
+					slog.WarnContext(ctx, "Failed to load user preferences", "error", err)
+				} else {
+					prefs = preferred
+				}
+			}
+
+			c.Set("locale", resolveLocale(prefs.Locale, c.Request().Header.Get("Accept-Language")))
+			c.Set("time_zone", resolveTimeZone(ctx, prefs.TimeZone, c.Request().Header.Get(TimeZoneHeader)))
+			c.Set("weight_units", resolveWeightUnits(prefs.WeightUnits))
+			return next(c)
+		}
+	}
+}
+
+func resolveLocale(preferred, acceptLanguage string) string {
+	if dom.IsSupportedLocale(preferred) {
+		return preferred
+	}
+	return NegotiateLocale(acceptLanguage)
+}
+
+func resolveTimeZone(ctx context.Context, preferred, header string) util.TimeZone {
+	name := preferred
+	if name == "" {
+		name = header
+	}
+
+	zone, err := util.LoadTimeZone(name)
+	if err != nil {
+		slog.WarnContext(ctx, "Unknown time zone, falling back to default", "timeZone", name, "error", err)
+		zone, _ = util.LoadTimeZone("")
+	}
+	return zone
+}
+
+func resolveWeightUnits(preferred dom.WeightUnits) dom.WeightUnits {
+	if preferred.Validate() != nil {
+		return dom.WeightUnits{}
+	}
+	return preferred
+}
+
+// NegotiateLocale は Accept-Language ヘッダーから対応ロケールを選ぶ
+// ヘッダーがない・対応ロケールが含まれない場合はデフォルトロケールを返す
+func NegotiateLocale(acceptLanguage string) string {
+	if acceptLanguage == "" {
+		return dom.DefaultLocale
+	}
+
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil || len(tags) == 0 {
+		return dom.DefaultLocale
+	}
+
+	_, index, confidence := localeMatcher.Match(tags...)
+	if confidence == language.No {
+		return dom.DefaultLocale
+	}
+	return dom.SupportedLocales[index]
+}
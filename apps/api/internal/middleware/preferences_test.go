@@ -0,0 +1,127 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	dom "gogym-api/internal/domain/entities"
+	"gogym-api/internal/util"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+type stubPreferencesFinder struct {
+	prefs dom.UserPreferences
+	err   error
+	calls *int
+}
+
+func (f stubPreferencesFinder) Preferences(context.Context, string) (dom.UserPreferences, error) {
+	if f.calls != nil {
+		*f.calls++
+	}
+	return f.prefs, f.err
+}
+
+type resolvedPreferences struct {
+	locale   string
+	timeZone string
+	units    dom.WeightUnits
+}
+
+func runPreferences(t *testing.T, finder PreferencesFinder, userID string, headers map[string]string) resolvedPreferences {
+	t.Helper()
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	c := e.NewContext(req, httptest.NewRecorder())
+	if userID != "" {
+		c.Set("user_id", userID)
+	}
+
+	var got resolvedPreferences
+	h := PreferencesMiddleware(finder)(func(c echo.Context) error {
+		var ok bool
+		got.locale, ok = c.Get("locale").(string)
+		require.True(t, ok)
+		zone, ok := c.Get("time_zone").(util.TimeZone)
+		require.True(t, ok)
+		got.timeZone = zone.Name()
+		got.units, ok = c.Get("weight_units").(dom.WeightUnits)
+		require.True(t, ok)
+		return nil
+	})
+	require.NoError(t, h(c))
+	return got
+}
+
+func TestNegotiateLocale(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]string{
+		"":                           "ja",
+		"en-US,en;q=0.9":             "en",
+		"ja-JP,ja;q=0.9,en;q=0.8":    "ja",
+		"fr-FR,en;q=0.5":             "en",
+		"fr-FR":                      "ja",
+		"de;q=0.9,ja;q=0.3,en;q=0.1": "ja",
+		"not a language tag;;;":      "ja",
+	}
+	for header, want := range cases {
+		require.Equal(t, want, NegotiateLocale(header), header)
+	}
+}
+
+func TestPreferencesMiddleware(t *testing.T) {
+	t.Parallel()
+
+	headers := map[string]string{"Accept-Language": "ja", TimeZoneHeader: "Europe/London"}
+
+	t.Run("正常系: ユーザー設定があればヘッダーより優先する", func(t *testing.T) {
+		t.Parallel()
+		units := dom.WeightUnits{Unit: dom.WeightUnitLb, Increment: 2.5}
+		finder := stubPreferencesFinder{prefs: dom.UserPreferences{Locale: "en", TimeZone: "America/New_York", WeightUnits: units}}
+		got := runPreferences(t, finder, "user", headers)
+		require.Equal(t, resolvedPreferences{locale: "en", timeZone: "America/New_York", units: units}, got)
+	})
+
+	t.Run("正常系: ユーザー設定は 1 リクエストにつき 1 回だけ読む", func(t *testing.T) {
+		t.Parallel()
+		calls := 0
+		runPreferences(t, stubPreferencesFinder{calls: &calls}, "user", headers)
+		require.Equal(t, 1, calls)
+	})
+
+	t.Run("正常系: ユーザー設定がなければヘッダー、ヘッダーもなければデフォルトを使う", func(t *testing.T) {
+		t.Parallel()
+		got := runPreferences(t, stubPreferencesFinder{}, "user", map[string]string{"Accept-Language": "en-GB", TimeZoneHeader: "Europe/London"})
+		require.Equal(t, resolvedPreferences{locale: "en", timeZone: "Europe/London"}, got)
+
+		got = runPreferences(t, nil, "", nil)
+		require.Equal(t, resolvedPreferences{locale: dom.DefaultLocale, timeZone: util.DefaultTimeZone}, got)
+		require.Equal(t, dom.WeightUnitKg, got.units.UnitOrDefault())
+	})
+
+	t.Run("正常系: 未認証ならユーザー設定を読まない", func(t *testing.T) {
+		t.Parallel()
+		calls := 0
+		runPreferences(t, stubPreferencesFinder{calls: &calls}, "", headers)
+		require.Zero(t, calls)
+	})
+
+	t.Run("異常系: 解決できないタイムゾーンや設定の取得失敗ではヘッダー・デフォルトで続行する", func(t *testing.T) {
+		t.Parallel()
+		got := runPreferences(t, nil, "", map[string]string{TimeZoneHeader: "Mars/Olympus"})
+		require.Equal(t, util.DefaultTimeZone, got.timeZone)
+
+		got = runPreferences(t, stubPreferencesFinder{err: errors.New("db down")}, "user", map[string]string{"Accept-Language": "en", TimeZoneHeader: "Europe/London"})
+		require.Equal(t, resolvedPreferences{locale: "en", timeZone: "Europe/London"}, got)
+	})
+}
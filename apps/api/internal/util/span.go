@@ -0,0 +1,16 @@
+package util
+
+import (
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// EndSpan はユースケースのスパンを終了する（err があればスパンに記録し、ステータスをエラーにする）
+// 名前付きの戻り値 err と合わせて defer func() { util.EndSpan(span, err) }() で使う
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,54 @@
+package util
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestEndSpan(t *testing.T) {
+	t.Parallel()
+
+	// useCase は名前付きの戻り値 err をスパンに記録するユースケースの書き方
+	useCase := func(tp *sdktrace.TracerProvider, fail error) (err error) {
+		_, span := tp.Tracer("test").Start(context.Background(), "TestUseCase.Run")
+		defer func() { EndSpan(span, err) }()
+
+		return fail
+	}
+
+	t.Run("正常系: 成功したスパンはステータスを設定せずに終了する", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := tracetest.NewSpanRecorder()
+		tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+		require.NoError(t, useCase(tp, nil))
+
+		spans := recorder.Ended()
+		require.Len(t, spans, 1)
+		require.Equal(t, codes.Unset, spans[0].Status().Code)
+		require.Empty(t, spans[0].Events())
+	})
+
+	t.Run("異常系: 返したエラーをスパンに記録しステータスをエラーにする", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := tracetest.NewSpanRecorder()
+		tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+		require.Error(t, useCase(tp, errors.New("record not found")))
+
+		spans := recorder.Ended()
+		require.Len(t, spans, 1)
+		require.Equal(t, codes.Error, spans[0].Status().Code)
+		require.Equal(t, "record not found", spans[0].Status().Description)
+		require.Len(t, spans[0].Events(), 1)
+		require.Equal(t, "exception", spans[0].Events()[0].Name)
+	})
+}
@@ -2,126 +2,176 @@ package util
 
 import (
 	"errors"
+	"fmt"
 	"time"
 )
 
 const (
-	JSTLocation = "Asia/Tokyo"
-	DateLayout  = "2006-01-02"
+	// DefaultTimeZone はユーザーがタイムゾーンを設定していない場合に使うタイムゾーン
+	DefaultTimeZone = "Asia/Tokyo"
+	DateLayout      = "2006-01-02"
+	ClockLayout     = "15:04"
 )
 
-var jstLoc *time.Location
+var defaultLoc *time.Location
 
 func init() {
 	var err error
-	jstLoc, err = time.LoadLocation(JSTLocation)
+	defaultLoc, err = time.LoadLocation(DefaultTimeZone)
 	if err != nil {
-		jstLoc = time.FixedZone("JST", 9*60*60)
+		defaultLoc = time.FixedZone("JST", 9*60*60)
 	}
 }
 
+// TimeZone はユーザーのタイムゾーンで日付・時刻を入出力するための変換をまとめたもの
 //
-// ===== 基本変換 =====
+// 規約:
+//   - 時刻（開始・終了・セット完了など）は UTC のインスタントとして保存し、入出力時にユーザーのタイムゾーンと相互に変換する
+//   - 日付（実施日・開始日など）はタイムゾーンを持たない暦日として UTC 0:00 で表す
 //
+// ゼロ値はデフォルトのタイムゾーン（Asia/Tokyo）として扱う
+type TimeZone struct {
+	loc *time.Location
+}
 
-// UTC → JST
-func ToJST(t time.Time) time.Time {
-	if t.IsZero() {
-		return t
+// LoadTimeZone は IANA のタイムゾーン名から TimeZone を作る（空文字ならデフォルト）
+func LoadTimeZone(name string) (TimeZone, error) {
+	if name == "" {
+		return TimeZone{loc: defaultLoc}, nil
+	}
+	if name == "Local" {
+		return TimeZone{}, fmt.Errorf("unknown time zone %q", name)
 	}
-	return t.In(jstLoc)
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return TimeZone{}, err
+	}
+	return TimeZone{loc: loc}, nil
 }
 
-// JST/Local → UTC
-func ToUTC(t time.Time) time.Time {
-	if t.IsZero() {
-		return t
+// Location はタイムゾーンの *time.Location を返す
+func (z TimeZone) Location() *time.Location {
+	if z.loc == nil {
+		return defaultLoc
 	}
-	return t.UTC()
+	return z.loc
+}
+
+// Name は IANA のタイムゾーン名を返す
+func (z TimeZone) Name() string {
+	return z.Location().String()
 }
 
 //
-// ===== フォーマット（表示専用） =====
+// ===== 日付（暦日） =====
 //
 
-// JSTの YYYY-MM-DD
-func FormatJSTDate(t time.Time) string {
-	if t.IsZero() {
-		return ""
+// ParseDate は YYYY-MM-DD を暦日（UTC 0:00）に変換する
+func (z TimeZone) ParseDate(dateStr string) (time.Time, error) {
+	if dateStr == "" {
+		return time.Time{}, errors.New("date is empty")
 	}
-	return ToJST(t).Format(DateLayout)
+	return time.Parse(DateLayout, dateStr)
 }
 
-// JSTの YYYY-MM-DD HH:mm:ss
-func FormatJSTDateTime(t time.Time) string {
-	if t.IsZero() {
-		return ""
+// ParseDateOrToday は YYYY-MM-DD を暦日に変換する（空ならこのタイムゾーンでの今日）
+func (z TimeZone) ParseDateOrToday(dateStr string) (time.Time, error) {
+	if dateStr == "" {
+		return z.Today(), nil
 	}
-	return ToJST(t).Format("2006-01-02 15:04:05")
+	return z.ParseDate(dateStr)
 }
 
-// JSTの HH:mm
-func FormatJSTTime(t time.Time) string {
-	if t.IsZero() {
+// Today はこのタイムゾーンでの今日の暦日を返す
+func (z TimeZone) Today() time.Time {
+	return z.DateOf(time.Now())
+}
+
+// DateOf はインスタントがこのタイムゾーンで何日にあたるかを暦日で返す
+func (z TimeZone) DateOf(t time.Time) time.Time {
+	return CivilDate(t.In(z.Location()))
+}
+
+// CivilDate は t の年月日を暦日（UTC 0:00）にする（t のロケーションのまま年月日を取る）
+func CivilDate(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// FormatDate は暦日を YYYY-MM-DD にする（タイムゾーンの変換はしない）
+func FormatDate(date time.Time) string {
+	if date.IsZero() {
 		return ""
 	}
-	return ToJST(t).Format("15:04")
+	return date.Format(DateLayout)
 }
 
 //
-// ===== パース（入力専用） =====
+// ===== 時刻（インスタント） =====
 //
 
-// YYYY-MM-DD（JST）→ time.Time（JST 00:00）
-func ParseJSTDate(dateStr string) (time.Time, error) {
-	if dateStr == "" {
-		return time.Time{}, errors.New("date is empty")
-	}
-	t, err := time.ParseInLocation(DateLayout, dateStr, jstLoc)
+// ParseClock は暦日 date のこのタイムゾーンでの時刻 HH:mm を UTC のインスタントに変換する
+func (z TimeZone) ParseClock(date time.Time, hhmm string) (time.Time, error) {
+	clock, err := time.Parse(ClockLayout, hhmm)
 	if err != nil {
 		return time.Time{}, err
 	}
-	return normalizeJSTDate(t), nil
+	local := time.Date(date.Year(), date.Month(), date.Day(), clock.Hour(), clock.Minute(), 0, 0, z.Location())
+	return local.UTC(), nil
 }
 
-// 空なら今日のJST日付を返す
-func ParseJSTDateOrToday(dateStr string) (time.Time, error) {
-	if dateStr == "" {
-		return TodayJST(), nil
+// ParseSpan は暦日 date に始めたセッションの開始・終了時刻（HH:mm）を UTC のインスタントに変換する
+// 終了時刻が開始時刻より前なら日付をまたいだものとして翌日の時刻にする
+func (z TimeZone) ParseSpan(date time.Time, start, end *string) (startedAt, endedAt *time.Time, err error) {
+	if start != nil {
+		t, err := z.ParseClock(date, *start)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid startedAt format: %w", err)
+		}
+		startedAt = &t
 	}
-	return ParseJSTDate(dateStr)
-}
-
-//
-// ===== 正規化（DB向け） =====
-//
-
-// JSTの「日付」を 00:00:00 に正規化
-func normalizeJSTDate(t time.Time) time.Time {
-	y, m, d := t.In(jstLoc).Date()
-	return time.Date(y, m, d, 0, 0, 0, 0, jstLoc)
+	if end != nil {
+		t, err := z.ParseClock(date, *end)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid endedAt format: %w", err)
+		}
+		if startedAt != nil && t.Before(*startedAt) {
+			t, err = z.ParseClock(date.AddDate(0, 0, 1), *end)
+			if err != nil {
+				return nil, nil, fmt.Errorf("invalid endedAt format: %w", err)
+			}
+		}
+		endedAt = &t
+	}
+	return startedAt, endedAt, nil
 }
 
-// DB保存用：JST日付 → UTC
-func NormalizeDateForDB(t time.Time) time.Time {
+// FormatClock はインスタントをこのタイムゾーンの HH:mm にする
+func (z TimeZone) FormatClock(t time.Time) string {
 	if t.IsZero() {
-		return t
+		return ""
 	}
-	return ToUTC(normalizeJSTDate(t))
+	return t.In(z.Location()).Format(ClockLayout)
 }
 
-//
-// ===== 補助 =====
-//
-
-// 今日のJST日付（00:00）
-func TodayJST() time.Time {
-	now := time.Now().In(jstLoc)
-	y, m, d := now.Date()
-	return time.Date(y, m, d, 0, 0, 0, 0, jstLoc)
+// ParseInstant は RFC3339 の日時を UTC のインスタントに変換する
+// オフセットのない日時はこのタイムゾーンの時刻として扱う
+func (z TimeZone) ParseInstant(s string) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		local, lerr := time.ParseInLocation("2006-01-02T15:04:05", s, z.Location())
+		if lerr != nil {
+			return time.Time{}, err
+		}
+		t = local
+	}
+	return t.UTC(), nil
 }
 
-// 現在時刻（JST）
-func NowJST() time.Time {
-	return time.Now().In(jstLoc)
+// FormatInstant はインスタントをこのタイムゾーンのオフセット付き RFC3339 にする
+func (z TimeZone) FormatInstant(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.In(z.Location()).Format(time.RFC3339)
 }
@@ -0,0 +1,98 @@
+package util
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadTimeZone(t *testing.T) {
+	t.Parallel()
+
+	t.Run("正常系: 空文字・ゼロ値はデフォルトのタイムゾーンになる", func(t *testing.T) {
+		t.Parallel()
+
+		zone, err := LoadTimeZone("")
+		require.NoError(t, err)
+		require.Equal(t, DefaultTimeZone, zone.Name())
+		require.Equal(t, DefaultTimeZone, TimeZone{}.Name())
+	})
+
+	t.Run("異常系: 解決できない名前とサーバーのローカル時刻はエラーを返す", func(t *testing.T) {
+		t.Parallel()
+
+		for _, name := range []string{"Mars/Olympus", "Local"} {
+			_, err := LoadTimeZone(name)
+			require.Error(t, err, name)
+		}
+	})
+}
+
+func TestTimeZone_ParseSpan(t *testing.T) {
+	t.Parallel()
+
+	date := time.Date(2025, 3, 8, 0, 0, 0, 0, time.UTC)
+	newYork, err := LoadTimeZone("America/New_York")
+	require.NoError(t, err)
+
+	t.Run("正常系: 時刻をユーザーのタイムゾーンの時刻としてUTCに変換する", func(t *testing.T) {
+		t.Parallel()
+
+		start, end, err := newYork.ParseSpan(date, ptr("07:00"), ptr("08:15"))
+		require.NoError(t, err)
+		require.Equal(t, time.Date(2025, 3, 8, 12, 0, 0, 0, time.UTC), *start)
+		require.Equal(t, time.Date(2025, 3, 8, 13, 15, 0, 0, time.UTC), *end)
+		require.Equal(t, "07:00", newYork.FormatClock(*start))
+	})
+
+	t.Run("正常系: 日付をまたぐ場合は翌日の時刻にする（夏時間の切り替えも考慮する）", func(t *testing.T) {
+		t.Parallel()
+
+		// 2025-03-09 はニューヨークで夏時間が始まる日
+		start, end, err := newYork.ParseSpan(date, ptr("23:00"), ptr("03:30"))
+		require.NoError(t, err)
+		require.Equal(t, time.Date(2025, 3, 9, 4, 0, 0, 0, time.UTC), *start)
+		require.Equal(t, time.Date(2025, 3, 9, 7, 30, 0, 0, time.UTC), *end)
+		require.Equal(t, "03:30", newYork.FormatClock(*end))
+		require.Equal(t, time.Date(2025, 3, 9, 0, 0, 0, 0, time.UTC), newYork.DateOf(*end))
+	})
+
+	t.Run("正常系: 開始時刻がなければ終了時刻はその日の時刻のまま", func(t *testing.T) {
+		t.Parallel()
+
+		start, end, err := TimeZone{}.ParseSpan(date, nil, ptr("01:00"))
+		require.NoError(t, err)
+		require.Nil(t, start)
+		require.Equal(t, time.Date(2025, 3, 7, 16, 0, 0, 0, time.UTC), *end)
+	})
+
+	t.Run("異常系: HH:mm でない時刻はエラーを返す", func(t *testing.T) {
+		t.Parallel()
+
+		_, _, err := newYork.ParseSpan(date, ptr("25:00"), nil)
+		require.Error(t, err)
+	})
+}
+
+func TestTimeZone_Instant(t *testing.T) {
+	t.Parallel()
+
+	london, err := LoadTimeZone("Europe/London")
+	require.NoError(t, err)
+
+	got, err := london.ParseInstant("2025-07-01T18:00:00+09:00")
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2025, 7, 1, 9, 0, 0, 0, time.UTC), got)
+	require.Equal(t, "2025-07-01T10:00:00+01:00", london.FormatInstant(got))
+
+	// オフセットのない日時はユーザーのタイムゾーンの時刻として扱う
+	got, err = london.ParseInstant("2025-07-01T10:00:00")
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2025, 7, 1, 9, 0, 0, 0, time.UTC), got)
+
+	_, err = london.ParseInstant("yesterday")
+	require.Error(t, err)
+}
+
+func ptr[T any](v T) *T { return &v }
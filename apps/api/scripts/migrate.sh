@@ -1,18 +1,11 @@
-#!/bin/bash
+#!/bin/sh
 set -e
 
 # マイグレーション実行スクリプト
 # Render/Fly.ioのデプロイ前コマンドとして実行
-
-# golang-migrateをインストール（まだ入っていない場合）
-if ! command -v migrate &> /dev/null; then
-    echo "Installing golang-migrate..."
-    curl -L https://github.com/golang-migrate/migrate/releases/download/v4.17.0/migrate.linux-amd64.tar.gz | tar xvz
-    mv migrate /usr/local/bin/migrate || mv migrate ./migrate
-    MIGRATE_CMD="./migrate"
-else
-    MIGRATE_CMD="migrate"
-fi
+# マイグレーションはAPIバイナリに埋め込まれているため、外部CLIは不要
+#
+# 使い方: ./scripts/migrate.sh [up | down N | status | force V]（省略時は up）
 
 # 環境変数チェック
 if [ -z "$DB_HOST" ] || [ -z "$DB_USER" ] || [ -z "$DB_PASSWORD" ] || [ -z "$DB_NAME" ]; then
@@ -20,13 +13,18 @@ if [ -z "$DB_HOST" ] || [ -z "$DB_USER" ] || [ -z "$DB_PASSWORD" ] || [ -z "$DB_
     exit 1
 fi
 
-# DB_PORTが設定されていない場合は5432（PostgreSQL）を使用
-DB_PORT=${DB_PORT:-5432}
+# ビルド済みバイナリ（Dockerfile と同じ bin/gogym-api）があればそれを使い、なければソースから実行する
+if [ -x ./bin/gogym-api ]; then
+    MIGRATE_CMD="./bin/gogym-api migrate"
+else
+    MIGRATE_CMD="go run ./cmd migrate"
+fi
 
-# DSNを構築
-DSN="postgres://${DB_USER}:${DB_PASSWORD}@${DB_HOST}:${DB_PORT}/${DB_NAME}?sslmode=disable"
+if [ $# -eq 0 ]; then
+    set -- up
+fi
 
-echo "Running database migrations..."
-$MIGRATE_CMD -path ./internal/infra/db/migrations -database "$DSN" up
+echo "Running database migrations ($*)..."
+$MIGRATE_CMD "$@"
 
 echo "Migrations completed successfully"
@@ -1,19 +1,56 @@
 "use server";
 
+import { getServerAccessToken } from "@/features/auth/server";
+
 const API_BASE = process.env.NEXT_PUBLIC_API_URL;
 
-export async function sendContactMessage(email: string, message: string) {
+// フォーム表示時に取得し、送信時に返送する使い捨てトークン（入力時間の検証・再送信の防止）
+export async function getContactFormToken() {
+  try {
+    if (!API_BASE) {
+      throw new Error("API URL not configured");
+    }
+
+    const res = await fetch(`${API_BASE}/api/v1/contact/token`, {
+      method: "GET",
+      cache: "no-store",
+    });
+
+    if (!res.ok) {
+      throw new Error("Failed to fetch contact form token");
+    }
+
+    const data: { token: string; expires_at: string } = await res.json();
+    return { success: true, token: data.token };
+  } catch (error) {
+    console.error("Contact token action error:", error);
+    return { success: false, error: "Failed to fetch form token" };
+  }
+}
+
+export async function sendContactMessage(
+  email: string,
+  message: string,
+  formToken: string,
+) {
   try {
     if (!API_BASE) {
       throw new Error("API URL not configured");
     }
 
+    // ログイン中ならトークンを付けて問い合わせをユーザーに紐付ける（未ログインでも送信できる）
+    const token = await getServerAccessToken();
+    const headers: Record<string, string> = {
+      "Content-Type": "application/json",
+    };
+    if (token) {
+      headers.Authorization = `Bearer ${token}`;
+    }
+
     const res = await fetch(`${API_BASE}/api/v1/contact`, {
       method: "POST",
-      headers: {
-        "Content-Type": "application/json",
-      },
-      body: JSON.stringify({ email, message }),
+      headers,
+      body: JSON.stringify({ email, message, form_token: formToken }),
       cache: "no-store",
     });
 
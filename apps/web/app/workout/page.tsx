@@ -1,6 +1,6 @@
 import WorkoutContent from "./content";
 import { extractDateParts } from "@/features/workout/lib/utils";
-import { buildEmptyDTO, convertResponseToFormDTO, type WorkoutFormDTO, type WorkoutPartDTO, type WorkoutRecordResponseDTO } from "@/types/workout";
+import { buildEmptyDTO, convertResponseToFormDTO, pickLatestSession, type WorkoutFormDTO, type WorkoutPartDTO, type WorkoutRecordsByDateResponseDTO } from "@/types/workout";
 import { getServerAccessToken } from "@/features/auth/server";
 
 export const dynamic = "force-dynamic";
@@ -46,8 +46,8 @@ const Page = async ({ searchParams }: Props) => {
   // バックエンドから返ってきたレスポンスを変換
   let dto: WorkoutFormDTO;
   if (recordsRes.ok) {
-    const response: WorkoutRecordResponseDTO = await recordsRes.json();
-    dto = convertResponseToFormDTO(response);
+    const response: WorkoutRecordsByDateResponseDTO = await recordsRes.json();
+    dto = convertResponseToFormDTO(pickLatestSession(response));
   } else {
     dto = buildEmptyDTO();
   }
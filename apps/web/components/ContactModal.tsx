@@ -1,9 +1,12 @@
 "use client";
 
-import { useState } from "react";
+import { useCallback, useEffect, useState } from "react";
 import { useTranslation } from "react-i18next";
 import { useBanner } from "@/components/Banner";
-import { sendContactMessage } from "@/app/actions/contact";
+import {
+  getContactFormToken,
+  sendContactMessage,
+} from "@/app/actions/contact";
 
 type Props = {
   isOpen: boolean;
@@ -16,6 +19,22 @@ const ContactModal: React.FC<Props> = ({ isOpen, onClose }) => {
   const [email, setEmail] = useState("");
   const [message, setMessage] = useState("");
   const [isSubmitting, setIsSubmitting] = useState(false);
+  const [formToken, setFormToken] = useState("");
+
+  // トークンは使い捨てのため、フォームを開いたとき・送信に失敗したときに取り直す
+  const refreshFormToken = useCallback(async () => {
+    setFormToken("");
+    const result = await getContactFormToken();
+    if (result.success && result.token) {
+      setFormToken(result.token);
+    }
+  }, []);
+
+  useEffect(() => {
+    if (isOpen) {
+      refreshFormToken();
+    }
+  }, [isOpen, refreshFormToken]);
 
   if (!isOpen) return null;
 
@@ -27,9 +46,15 @@ const ContactModal: React.FC<Props> = ({ isOpen, onClose }) => {
       return;
     }
 
+    if (!formToken) {
+      error(t("contact.errorSending"));
+      refreshFormToken();
+      return;
+    }
+
     setIsSubmitting(true);
     try {
-      const result = await sendContactMessage(email, message);
+      const result = await sendContactMessage(email, message, formToken);
 
       if (result.success) {
         success(t("contact.successMessage"));
@@ -38,9 +63,11 @@ const ContactModal: React.FC<Props> = ({ isOpen, onClose }) => {
         onClose();
       } else {
         error(t("contact.errorSending"));
+        refreshFormToken();
       }
     } catch (err) {
       error(t("contact.errorSending"));
+      refreshFormToken();
     } finally {
       setIsSubmitting(false);
     }
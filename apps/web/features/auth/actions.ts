@@ -1,5 +1,6 @@
 "use server";
 
+import type { ProblemDTO } from "@/types/problem";
 import type { SignUpPayload } from "./schemas";
 
 const API_BASE = process.env.NEXT_PUBLIC_API_URL;
@@ -24,10 +25,10 @@ export const signup = async (payload: SignUpPayload): Promise<ActionResult> => {
     });
 
     if (!res.ok) {
-      const data = await res.json().catch(() => null);
+      const data: ProblemDTO | null = await res.json().catch(() => null);
       return {
         success: false,
-        error: data?.message || "このメールアドレスは既に使用されています",
+        error: data?.errors?.[0]?.message || data?.detail || "このメールアドレスは既に使用されています",
       };
     }
 
@@ -5,9 +5,9 @@ import type {
   WorkoutFormDTO,
   WorkoutPartDTO,
   ExerciseDTO,
-  WorkoutRecordResponseDTO,
+  WorkoutRecordsByDateResponseDTO,
 } from "@/types/workout";
-import { convertResponseToFormDTO } from "@/types/workout";
+import { convertResponseToFormDTO, pickLatestSession } from "@/types/workout";
 
 const API_BASE = process.env.NEXT_PUBLIC_API_URL;
 
@@ -57,8 +57,8 @@ export const getWorkoutRecords = async (params?: {
       return { success: false, error: "Failed to fetch workout records" };
     }
 
-    const response: WorkoutRecordResponseDTO = await res.json();
-    const data = convertResponseToFormDTO(response);
+    const response: WorkoutRecordsByDateResponseDTO = await res.json();
+    const data = convertResponseToFormDTO(pickLatestSession(response));
     return { success: true, data };
   } catch (error) {
     return {
@@ -0,0 +1,17 @@
+// API のエラーレスポンス（RFC 7807 の application/problem+json）
+export type ProblemDTO<E = FieldErrorDTO> = {
+  type: string; // 常に "about:blank"（種類は code で区別する）
+  title: string; // ステータスの説明（例: "Not Found"）
+  status: number;
+  detail?: string; // 500 では省略される
+  instance?: string; // リクエストのパス
+  code?: string; // 機械可読なコード（例: "email_already_exists"）
+  request_id?: string; // X-Request-Id と同じ値
+  errors?: E[]; // 項目・種目ごとの検証エラー
+};
+
+// 項目ごとの検証エラー（400）
+export type FieldErrorDTO = {
+  field: string;
+  message: string;
+};
@@ -1,3 +1,5 @@
+import type { ProblemDTO } from "@/types/problem";
+
 // ==================== API Response Types ====================
 
 export type WorkoutRecord = {
@@ -44,18 +46,43 @@ export type WorkoutSet = {
 
 // ==================== Form Types ====================
 
+// 重量の表示単位（*_kg は常に kg、単位の付かない重量は unit の値）
+export type UnitsDTO = {
+  unit: "kg" | "lb";
+  increment: number; // unit での丸め幅
+};
+
 export type WorkoutPartDTO = {
   id: number;
   key: string;
+  name?: string;
+  source?: "preset" | "custom";
   translations: Array<{
     locale: string;
     name: string;
   }>;
   exercises: Array<{
     id: number;
+    key?: string;
     name: string;
+    translations?: Array<{
+      locale: string;
+      name: string;
+    }>;
     workout_part_id: number | null;
+    source?: "preset" | "custom";
+    primary_muscles?: string[];
+    secondary_muscles?: string[];
+    secondary_parts?: string[];
+    equipment?: string;
+    movement_pattern?: string;
+    unilateral?: boolean;
+    hidden?: boolean;
+    default_rest_sec?: number | null;
+    training_max_kg?: number | null;
+    training_max?: number | null; // training_max_kg の表示単位の値
   }>;
+  units?: UnitsDTO; // training_max の表示単位（レスポンスのみ）
 };
 
 export type GymDTO = {
@@ -67,7 +94,7 @@ export type WorkoutFormDTO = {
   id?: number | null;
   performed_date: string; // "YYYY-MM-DD"
   started_at: string | null; // "HH:mm"
-  ended_at: string | null; // "HH:mm"
+  ended_at: string | null; // "HH:mm"（started_at より前なら翌日の時刻）
   gym_id: number | null; // deprecated
   gym_name: string | null;
   note: string | null;
@@ -110,6 +137,10 @@ export type WorkoutRecordResponseDTO = {
   gym_name?: string | null;
   note?: string | null;
   condition_level?: 1 | 2 | 3 | 4 | 5 | null;
+  template_id?: number | null; // テンプレートから開始したセッション（レスポンスのみ）
+  volume_kg?: number; // ウォームアップを除く総ボリューム（レスポンスのみ）
+  volume?: number; // volume_kg の表示単位の値（レスポンスのみ）
+  units?: UnitsDTO; // 単位の付かない重量の表示単位（レスポンスのみ）
   parts: Array<{
     id: number;
     key: string;
@@ -124,14 +155,66 @@ export type WorkoutRecordResponseDTO = {
       sets: Array<{
         id?: number | null;
         set_number: number;
-        weight_kg?: number | null;
+        kind?: SetKind;
+        weight_kg?: number | null; // 自重では加重、補助付きでは補助重量
+        weight?: number | null; // weight_kg の表示単位の値（リクエストでは weight_kg がなければこちらを使う）
         reps?: number | null;
+        duration_sec?: number | null;
+        distance_m?: number | null;
+        bodyweight_kg?: number | null;
+        bodyweight?: number | null; // bodyweight_kg の表示単位の値
+        type?: SetType;
+        rpe?: number | null;
+        rir?: number | null;
+        superset_group?: string | null;
+        estimated_max?: number | null; // 推定1RM（表示単位、レスポンスのみ）
+        completed_at?: string | null; // セットを終えた時刻（RFC3339、レスポンスはユーザーのタイムゾーンのオフセット付き）
+        rest_sec?: number | null; // 直前のセットからの休憩（レスポンスのみ）
         note?: string | null;
       }>;
     }>;
   }>;
 };
 
+// セッションの保存で種目ごとの検証エラーがあった場合のレスポンス（422、code は "invalid_workout_sets"）
+export type WorkoutValidationErrorResponseDTO = ProblemDTO<{
+  part_index: number; // リクエストの parts の位置
+  exercise_index: number; // parts[part_index].exercises の位置
+  exercise_id?: number;
+  name?: string;
+  set_number?: number; // 種目自体のエラーなら省略
+  message: string;
+}>;
+
+// セットの記録方法（省略時は weight_reps）
+export type SetKind =
+  | "weight_reps"
+  | "bodyweight"
+  | "assisted"
+  | "timed"
+  | "distance";
+
+// セットの区分（省略時は normal）。warmup はボリューム・PRの集計から除かれる
+export type SetType = "normal" | "warmup" | "drop" | "failure";
+
+// 1日分のセッション一覧（GET /workouts/records）
+export type WorkoutRecordsByDateResponseDTO = {
+  performed_date: string;
+  records: WorkoutRecordResponseDTO[];
+};
+
+/**
+ * 1日分のセッションから編集対象（最後のセッション）を取り出す
+ * セッションがない日は日付だけを持つ空のレスポンスを返す
+ */
+export const pickLatestSession = (
+  response: WorkoutRecordsByDateResponseDTO,
+): WorkoutRecordResponseDTO =>
+  response.records[response.records.length - 1] ?? {
+    performed_date: response.performed_date,
+    parts: [],
+  };
+
 /**
  * バックエンドのレスポンスをフロントエンドのフォーム形式に変換
  */
@@ -206,3 +289,154 @@ export const buildEmptyDTO = (): WorkoutFormDTO => ({
     })),
   })),
 });
+
+// 種目別のセット間の休憩（GET /workouts/exercises/rest-summary）
+export type RestSummaryResponseDTO = {
+  from: string;
+  to: string;
+  exercises: Array<{
+    exercise_id: number;
+    name: string;
+    average_rest_sec: number;
+    sample_count: number;
+    default_rest_sec: number | null;
+    over_default: boolean;
+  }>;
+};
+
+// ワークアウトのテンプレート（/workouts/templates）
+export type TemplateExerciseDTO = {
+  exercise_id: number;
+  name?: string; // レスポンスのみ
+  workout_part_id?: number | null; // レスポンスのみ
+  target_sets: number;
+  reps_min: number;
+  reps_max: number;
+  target_weight_kg?: number | null; // 前回の記録がない場合に使う重量
+  target_weight?: number | null; // target_weight_kg の表示単位の値（リクエストでは target_weight_kg がなければこちらを使う）
+  note?: string | null;
+};
+
+export type TemplateDTO = {
+  id: number;
+  name: string;
+  note?: string | null;
+  exercises: TemplateExerciseDTO[];
+  units?: UnitsDTO; // target_weight の表示単位（レスポンスのみ）
+};
+
+export type SaveTemplateRequestDTO = Omit<TemplateDTO, "id" | "units">;
+
+// テンプレートからセッションを開始する（POST /workouts/templates/:id/start）
+export type StartTemplateRequestDTO = {
+  performed_date: string; // "YYYY-MM-DD"
+  started_at?: string | null; // "HH:mm"
+};
+
+// 複数週のプログラム（/workouts/programs）
+export type PrescriptionDTO = {
+  exercise_id: number;
+  name?: string; // レスポンスのみ
+  set_number?: number; // レスポンスのみ（種目ごとに配列の順で振られる）
+  percent_of_max: number; // トレーニングマックスに対する割合（%）
+  reps: number;
+  amrap: boolean;
+};
+
+export type ProgramDayDTO = {
+  week: number;
+  day: number; // 1〜7（開始日と同じ曜日が1）
+  template_id: number;
+  template_name?: string; // レスポンスのみ
+  prescriptions: PrescriptionDTO[];
+};
+
+export type ProgramDTO = {
+  id: number;
+  name: string;
+  note?: string | null;
+  weeks: number;
+  days: ProgramDayDTO[];
+};
+
+export type SaveProgramRequestDTO = Omit<ProgramDTO, "id">;
+
+export type EnrollmentDTO = {
+  id: number;
+  program_id: number;
+  program_name: string;
+  weeks: number;
+  start_date: string; // "YYYY-MM-DD"
+  end_date: string;
+};
+
+// GET /workouts/programs/today
+export type ProgramTodayResponseDTO = {
+  date: string;
+  enrollment: EnrollmentDTO;
+  status: "not_started" | "training" | "rest" | "finished";
+  week: number | null;
+  day: number | null;
+  workout: {
+    template: TemplateDTO;
+    prescriptions: Array<{
+      exercise_id: number;
+      name: string;
+      training_max_kg: number | null;
+      training_max: number | null; // training_max_kg の表示単位の値
+      sets: Array<{
+        set_number: number;
+        percent_of_max: number;
+        reps: number;
+        amrap: boolean;
+        weight_kg: number | null; // トレーニングマックスが未設定なら null
+        weight: number | null; // weight_kg の表示単位の値
+      }>;
+    }>;
+    record_id: number | null;
+  } | null;
+  units: UnitsDTO; // 処方の重量の表示単位と丸め幅
+};
+
+// GET /workouts/programs/adherence
+export type AdherenceResponseDTO = {
+  as_of: string;
+  enrollment: EnrollmentDTO;
+  planned: number;
+  performed: number;
+  rate: number; // 0〜1
+  days: Array<{
+    week: number;
+    day: number;
+    date: string;
+    template_id: number;
+    template_name: string;
+    status: "done" | "missed" | "upcoming";
+    record_id: number | null;
+  }>;
+};
+
+// 次のセッションの重量・回数の提案（GET /workouts/exercises/:id/suggestion）
+export type ProgressionSuggestionResponseDTO = {
+  exercise_id: number;
+  name?: string;
+  strategy: "double_progression" | "linear";
+  rule: "no_history" | "increase_weight" | "add_reps" | "repeat" | "deload";
+  kind?: SetKind;
+  weight_kg: number | null;
+  weight: number | null; // weight_kg の表示単位の値
+  reps: number | null;
+  sets: number | null;
+  increment_kg: number;
+  increment: number; // increment_kg の表示単位の値
+  explanation: string;
+  history: Array<{
+    performed_date: string;
+    weight_kg: number;
+    weight: number; // weight_kg の表示単位の値
+    reps: number[];
+    missed: boolean;
+    reached_top: boolean;
+  }>;
+  units: UnitsDTO;
+};